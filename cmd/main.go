@@ -117,6 +117,7 @@ func startServer(ctx context.Context, app *bootstrap.Application, router *gin.En
 		WriteTimeout: 15 * time.Second,
 		IdleTimeout:  60 * time.Second,
 	}
+	server.RegisterOnShutdown(app.CloseStreams)
 
 	// Start server in goroutine to handle graceful shutdown
 	go func() {
@@ -125,6 +126,9 @@ func startServer(ctx context.Context, app *bootstrap.Application, router *gin.En
 		}
 	}()
 
+	// Start background workers (email outbox)
+	app.StartWorkers(ctx)
+
 	// Wait for interrupt signal for graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
@@ -140,5 +144,7 @@ func startServer(ctx context.Context, app *bootstrap.Application, router *gin.En
 		logger.Error(ctx, "Server forced to shutdown: "+err.Error())
 	}
 
+	app.StopWorkers()
+
 	logger.Info(ctx, "Server exited properly")
 }
@@ -34,6 +34,31 @@ type Config struct {
 	JWTSecret     string
 	RefreshSecret string
 
+	// Two-factor authentication
+	TwoFactorIssuer        string        // Account issuer shown in authenticator apps
+	TwoFactorSecretKey     string        // Key the TOTP secrets are encrypted with at rest
+	TwoFactorChallengeTTL  time.Duration // How long the second login step may take
+	TwoFactorMaxAttempts   int           // Wrong codes before a login challenge is dropped
+	TwoFactorRecoveryCodes int           // Recovery codes generated per user
+
+	// Brute-force protection, a rate limit of 0 disables it
+	RateLimitLogin                int // Login requests per client IP, and per username, within the window
+	RateLimitLoginWindow          time.Duration
+	RateLimitForgotPassword       int // Forgot password requests per client IP, and per email, within the window
+	RateLimitForgotPasswordWindow time.Duration
+	RateLimitContactUs            int // Contact us messages per client IP, and per email, within the window
+	RateLimitContactUsWindow      time.Duration
+	RateLimitTestSendEmail        int // Test emails of templates per client IP within the window
+	RateLimitTestSendEmailWindow  time.Duration
+	LoginMaxAttempts              int           // Wrong passwords in a row before the account is locked
+	LoginLockoutDuration          time.Duration // First lockout, each following lockout lasts twice as long
+	LoginLockoutMaxDuration       time.Duration
+
+	// Personal API keys
+	APIKeyMaxPerUser       int // Active keys a user may have
+	APIKeyDefaultRateLimit int // Requests per minute of a key created without a rate limit
+	APIKeyMaxRateLimit     int
+
 	URL        string
 	URLFEAgent string
 	URLFEAdmin string
@@ -52,6 +77,10 @@ type Config struct {
 	AllowOrigins   string
 	AllowedOrigins []string
 
+	// Proxies whose X-Forwarded-For is trusted for the client IP, comma separated IPs or CIDRs, empty trusts none
+	TrustedProxies    string
+	TrustedProxyCIDRs []string
+
 	DurationCtxTOFast       time.Duration
 	DurationCtxTOSlow       time.Duration
 	DurationCtxTOFile       time.Duration
@@ -60,6 +89,7 @@ type Config struct {
 	DurationRefreshToken    time.Duration
 	DurationMaxAgeCORS      time.Duration
 	DurationLinkExpiration  time.Duration
+	DurationCartHold        time.Duration
 
 	DefaultCancellationPeriod int
 	DefaultCheckInHour        string
@@ -110,7 +140,43 @@ type Config struct {
 	SendTimeout    time.Duration
 	CommandTimeout time.Duration
 
+	// Email outbox workers
+	EmailOutboxWorkers      int
+	EmailOutboxBatchSize    int
+	EmailOutboxPollInterval time.Duration
+	EmailOutboxLease        time.Duration // How long a claimed email is locked before another worker may take it over
+	EmailOutboxMaxAttempts  int           // Attempts before an email moves to dead-letter
+	EmailOutboxBackoff      time.Duration // Delay before the first retry, doubled on every attempt
+	EmailOutboxMaxBackoff   time.Duration
+
+	// Email retry
+	EmailRetryBulkLimit      int  // Failed emails queued by one bulk retry request
+	EmailAutoRetryEnabled    bool // Periodically retries failed emails
+	EmailAutoRetryBudget     int  // Failed emails queued by one auto retry run
+	EmailAutoRetryMaxRetries int  // Retries after which the auto retry job leaves an email alone
+	EmailAutoRetryInterval   time.Duration
+
+	EmailAttachBookingDocuments bool // Attach the invoice and voucher PDFs to the booking confirmed email
+
+	// Notifications
+	NotificationDigestInterval time.Duration // How often due daily digests are looked for
+	CheckInReminderInterval    time.Duration // How often confirmed sub-bookings checking in tomorrow are looked for
+
+	// Inbound email webhook, replies of hotels to booking emails
+	InboundEmailSecret     string        // HMAC key of the webhook signature, the webhook rejects every request when empty
+	InboundEmailTolerance  time.Duration // Maximum age of a signed request
+	InboundEmailAutoStatus bool          // Confirm or reject sub-bookings still waiting approval from the reply
+	InboundEmailAddress    string        // Address hotels reply to, used by the Mailhog poller
+	InboundEmailMailhog    bool          // Poll Mailhog for replies instead of the webhook, outside production only
+	InboundEmailPollPeriod time.Duration
+	URLMailhogAPI          string
+
 	HostIP string
+
+	// Letterhead of invoices and vouchers
+	CompanyName    string
+	CompanyAddress string
+	CompanyPhone   string
 }
 
 func LoadConfig() *Config {
@@ -130,6 +196,28 @@ func LoadConfig() *Config {
 		JWTSecret:     utils.GetStringEnv("JWT_SECRET", "yoursecretkey"),
 		RefreshSecret: utils.GetStringEnv("REFRESH_SECRET", "yourrefreshkey"),
 
+		TwoFactorIssuer:        utils.GetStringEnv("TWO_FACTOR_ISSUER", "The HotelBox"),
+		TwoFactorSecretKey:     utils.GetStringEnv("TWO_FACTOR_SECRET_KEY", "yourtwofactorkey"),
+		TwoFactorChallengeTTL:  utils.GetDurationEnv("TWO_FACTOR_CHALLENGE_TTL", 5*time.Minute),
+		TwoFactorMaxAttempts:   utils.GetIntEnv("TWO_FACTOR_MAX_ATTEMPTS", 5),
+		TwoFactorRecoveryCodes: utils.GetIntEnv("TWO_FACTOR_RECOVERY_CODES", 10),
+
+		RateLimitLogin:                utils.GetIntEnv("RATE_LIMIT_LOGIN", 10),
+		RateLimitLoginWindow:          utils.GetDurationEnv("RATE_LIMIT_LOGIN_WINDOW", time.Minute),
+		RateLimitForgotPassword:       utils.GetIntEnv("RATE_LIMIT_FORGOT_PASSWORD", 5),
+		RateLimitForgotPasswordWindow: utils.GetDurationEnv("RATE_LIMIT_FORGOT_PASSWORD_WINDOW", 15*time.Minute),
+		RateLimitContactUs:            utils.GetIntEnv("RATE_LIMIT_CONTACT_US", 5),
+		RateLimitContactUsWindow:      utils.GetDurationEnv("RATE_LIMIT_CONTACT_US_WINDOW", 10*time.Minute),
+		RateLimitTestSendEmail:        utils.GetIntEnv("RATE_LIMIT_TEST_SEND_EMAIL", 5),
+		RateLimitTestSendEmailWindow:  utils.GetDurationEnv("RATE_LIMIT_TEST_SEND_EMAIL_WINDOW", 10*time.Minute),
+		LoginMaxAttempts:              utils.GetIntEnv("LOGIN_MAX_ATTEMPTS", 5),
+		LoginLockoutDuration:          utils.GetDurationEnv("LOGIN_LOCKOUT_DURATION", 15*time.Minute),
+		LoginLockoutMaxDuration:       utils.GetDurationEnv("LOGIN_LOCKOUT_MAX_DURATION", 24*time.Hour),
+
+		APIKeyMaxPerUser:       utils.GetIntEnv("API_KEY_MAX_PER_USER", 10),
+		APIKeyDefaultRateLimit: utils.GetIntEnv("API_KEY_DEFAULT_RATE_LIMIT", 60),
+		APIKeyMaxRateLimit:     utils.GetIntEnv("API_KEY_MAX_RATE_LIMIT", 600),
+
 		URL:        utils.GetStringEnv("URL", ""),
 		URLFEAgent: utils.GetStringEnv("URL_FE_AGENT", ""),
 		URLFEAdmin: utils.GetStringEnv("URL_FE_ADMIN", ""),
@@ -146,6 +234,8 @@ func LoadConfig() *Config {
 		SecureService: utils.GetBoolEnv("SECURE_SERVICE", true),
 		AllowOrigins:  utils.GetStringEnv("ALLOW_ORIGINS", "*"),
 
+		TrustedProxies: utils.GetStringEnv("TRUSTED_PROXIES", ""),
+
 		DurationCtxTOFast:       utils.GetDurationEnv("CTX_TIMEOUT_FAST", 5*time.Second),
 		DurationCtxTOSlow:       utils.GetDurationEnv("CTX_TIMEOUT_SLOW", 10*time.Second),
 		DurationCtxTOFile:       utils.GetDurationEnv("CTX_TIMEOUT_FILE", 60*time.Second),
@@ -154,6 +244,7 @@ func LoadConfig() *Config {
 		DurationRefreshToken:    utils.GetDurationEnv("EXPIRATION_TIME_REFRESH_TOKEN", 7*24*time.Hour),
 		DurationMaxAgeCORS:      utils.GetDurationEnv("MAX_AGE_CORS", 12*time.Hour),
 		DurationLinkExpiration:  utils.GetDurationEnv("DURATION_LINK_EXPIRATION", 45*time.Minute),
+		DurationCartHold:        utils.GetDurationEnv("CART_HOLD_DURATION", 30*time.Minute),
 
 		DefaultCancellationPeriod: utils.GetIntEnv("DEFAULT_CANCEL_PERIOD", 5),
 		DefaultCheckInHour:        utils.GetStringEnv("DEFAULT_CHECK_IN_HOUR", "14:00"),
@@ -200,8 +291,39 @@ func LoadConfig() *Config {
 		SendTimeout:    utils.GetDurationEnv("SEND_TIMEOUT", 30*time.Second),
 		CommandTimeout: utils.GetDurationEnv("COMMAND_TIMEOUT", 5*time.Second),
 
+		EmailOutboxWorkers:      utils.GetIntEnv("EMAIL_OUTBOX_WORKERS", 4),
+		EmailOutboxBatchSize:    utils.GetIntEnv("EMAIL_OUTBOX_BATCH_SIZE", 20),
+		EmailOutboxPollInterval: utils.GetDurationEnv("EMAIL_OUTBOX_POLL_INTERVAL", 5*time.Second),
+		EmailOutboxLease:        utils.GetDurationEnv("EMAIL_OUTBOX_LEASE", 2*time.Minute),
+		EmailOutboxMaxAttempts:  utils.GetIntEnv("EMAIL_OUTBOX_MAX_ATTEMPTS", 6),
+		EmailOutboxBackoff:      utils.GetDurationEnv("EMAIL_OUTBOX_BACKOFF", 30*time.Second),
+		EmailOutboxMaxBackoff:   utils.GetDurationEnv("EMAIL_OUTBOX_MAX_BACKOFF", time.Hour),
+
+		EmailRetryBulkLimit:      utils.GetIntEnv("EMAIL_RETRY_BULK_LIMIT", 500),
+		EmailAutoRetryEnabled:    utils.GetBoolEnv("EMAIL_AUTO_RETRY_ENABLED", false),
+		EmailAutoRetryInterval:   utils.GetDurationEnv("EMAIL_AUTO_RETRY_INTERVAL", 15*time.Minute),
+		EmailAutoRetryBudget:     utils.GetIntEnv("EMAIL_AUTO_RETRY_BUDGET", 50),
+		EmailAutoRetryMaxRetries: utils.GetIntEnv("EMAIL_AUTO_RETRY_MAX_RETRIES", 3),
+
+		EmailAttachBookingDocuments: utils.GetBoolEnv("EMAIL_ATTACH_BOOKING_DOCUMENTS", false),
+
+		NotificationDigestInterval: utils.GetDurationEnv("NOTIFICATION_DIGEST_INTERVAL", 5*time.Minute),
+		CheckInReminderInterval:    utils.GetDurationEnv("CHECK_IN_REMINDER_INTERVAL", time.Hour),
+
+		InboundEmailSecret:     utils.GetStringEnv("INBOUND_EMAIL_SECRET", ""),
+		InboundEmailTolerance:  utils.GetDurationEnv("INBOUND_EMAIL_TOLERANCE", 5*time.Minute),
+		InboundEmailAutoStatus: utils.GetBoolEnv("INBOUND_EMAIL_AUTO_STATUS", false),
+		InboundEmailAddress:    utils.GetStringEnv("INBOUND_EMAIL_ADDRESS", "reply@wtm.com"),
+		InboundEmailMailhog:    utils.GetBoolEnv("INBOUND_EMAIL_MAILHOG", false),
+		InboundEmailPollPeriod: utils.GetDurationEnv("INBOUND_EMAIL_POLL_PERIOD", 30*time.Second),
+		URLMailhogAPI:          utils.GetStringEnv("URL_MAILHOG_API", "http://localhost:8025"),
+
 		HostIP: utils.GetStringEnv("HOST_IP", "127.0.0.1"),
 
+		CompanyName:    utils.GetStringEnv("COMPANY_NAME", "WTM"),
+		CompanyAddress: utils.GetStringEnv("COMPANY_ADDRESS", ""),
+		CompanyPhone:   utils.GetStringEnv("COMPANY_PHONE", ""),
+
 		AutoMigrate: utils.GetBoolEnv("AUTO_MIGRATE", false),
 
 		AWSConfig: AWSConfig{
@@ -217,6 +339,13 @@ func LoadConfig() *Config {
 	// Process allowed origins list
 	config.AllowedOrigins = strings.Split(config.AllowOrigins, ",")
 
+	// Tanpa proxy terpercaya, X-Forwarded-For diabaikan dan IP klien adalah alamat koneksi
+	for _, proxy := range strings.Split(config.TrustedProxies, ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			config.TrustedProxyCIDRs = append(config.TrustedProxyCIDRs, proxy)
+		}
+	}
+
 	// Optional override for dev
 	if config.AppEnv == "DEV" {
 		config.URL = ""
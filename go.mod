@@ -12,6 +12,7 @@ require (
 	github.com/gin-contrib/timeout v1.0.2
 	github.com/gin-gonic/gin v1.10.1
 	github.com/go-ozzo/ozzo-validation v3.6.0+incompatible
+	github.com/go-pdf/fpdf v0.9.0
 	github.com/golang-jwt/jwt/v5 v5.2.2
 	github.com/google/uuid v1.6.0
 	github.com/jackc/pgx/v5 v5.5.5
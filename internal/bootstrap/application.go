@@ -2,6 +2,9 @@ package bootstrap
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 	"wtm-backend/config"
 	"wtm-backend/internal/infrastructure/cache"
 	"wtm-backend/internal/infrastructure/database"
@@ -21,6 +24,7 @@ import (
 	"wtm-backend/internal/usecase/report_usecase"
 	"wtm-backend/internal/usecase/user_usecase"
 	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
 )
 
 type Application struct {
@@ -31,6 +35,12 @@ type Application struct {
 	redis         *cache.RedisClient
 	storageClient *storage.MultiStorageClient
 	email         *email.SMTPEmailSender
+	emailOutbox   *email.OutboxWorker
+	mailhogInbox  *email.MailhogInbox
+	notifHub      *cache.NotificationHub
+
+	cancelWorkers context.CancelFunc
+	workers       sync.WaitGroup
 }
 
 type AppUsecases struct {
@@ -72,5 +82,158 @@ func NewApplication() *Application {
 		redis:         deps.Redis,
 		storageClient: deps.Storage,
 		email:         deps.EmailSender,
+		emailOutbox:   email.NewOutboxWorker(deps.Config, repos.EmailRepo, deps.EmailSender, deps.Storage.ActiveStorage),
+		mailhogInbox:  email.NewMailhogInbox(deps.Config),
+		notifHub:      deps.NotifHub,
+	}
+}
+
+// StartWorkers starts the background workers, the email outbox, the notification hub, the daily digests, the check in
+// reminders, the optional email auto retry and, outside production, the optional Mailhog inbox standing in for the
+// inbound email webhook
+func (a *Application) StartWorkers(ctx context.Context) {
+	ctx, a.cancelWorkers = context.WithCancel(ctx)
+	a.emailOutbox.Start(ctx)
+
+	a.workers.Add(1)
+	go func() {
+		defer a.workers.Done()
+		a.notifHub.Run(ctx)
+	}()
+
+	a.workers.Add(2)
+	go func() {
+		defer a.workers.Done()
+		a.runNotificationDigest(ctx)
+	}()
+	go func() {
+		defer a.workers.Done()
+		a.runCheckInReminder(ctx)
+	}()
+
+	if a.Config.EmailAutoRetryEnabled {
+		a.workers.Add(1)
+		go func() {
+			defer a.workers.Done()
+			a.runEmailAutoRetry(ctx)
+		}()
+	}
+
+	if a.Config.InboundEmailMailhog && !a.Config.IsProduction() {
+		a.workers.Add(1)
+		go func() {
+			defer a.workers.Done()
+			a.runMailhogInbox(ctx)
+		}()
+	}
+}
+
+// CloseStreams ends the notification streams of the connected clients, which would otherwise hold a graceful shutdown
+func (a *Application) CloseStreams() {
+	a.notifHub.Close()
+}
+
+// StopWorkers stops the background workers and waits for the emails being sent
+func (a *Application) StopWorkers() {
+	if a.cancelWorkers != nil {
+		a.cancelWorkers()
+	}
+	a.emailOutbox.Stop()
+	a.workers.Wait()
+}
+
+// runEmailAutoRetry queues failed emails again every EmailAutoRetryInterval until ctx is done
+func (a *Application) runEmailAutoRetry(ctx context.Context) {
+	ticker := time.NewTicker(a.Config.EmailAutoRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			queued, err := a.Usecases.EmailUsecase.AutoRetryFailedEmails(ctx)
+			if err != nil {
+				logger.Error(ctx, "[email-auto-retry] failed to retry emails", err.Error())
+			}
+			if queued > 0 {
+				logger.Info(ctx, fmt.Sprintf("[email-auto-retry] queued %d failed emails", queued))
+			}
+		}
+	}
+}
+
+// runNotificationDigest sends the daily digests that are due every NotificationDigestInterval until ctx is done
+func (a *Application) runNotificationDigest(ctx context.Context) {
+	ticker := time.NewTicker(a.Config.NotificationDigestInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sent, err := a.Usecases.NotificationUsecase.SendDueDigests(ctx)
+			if err != nil {
+				logger.Error(ctx, "[notification-digest] failed to send digests", err.Error())
+			}
+			if sent > 0 {
+				logger.Info(ctx, fmt.Sprintf("[notification-digest] sent %d digests", sent))
+			}
+		}
+	}
+}
+
+// runCheckInReminder reminds the agents of the sub-bookings checking in tomorrow every CheckInReminderInterval until ctx is done
+func (a *Application) runCheckInReminder(ctx context.Context) {
+	ticker := time.NewTicker(a.Config.CheckInReminderInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reminded, err := a.Usecases.BookingUsecase.RemindCheckIns(ctx)
+			if err != nil {
+				logger.Error(ctx, "[check-in-reminder] failed to remind check ins", err.Error())
+			}
+			if reminded > 0 {
+				logger.Info(ctx, fmt.Sprintf("[check-in-reminder] reminded %d check ins", reminded))
+			}
+		}
+	}
+}
+
+// runMailhogInbox processes the replies waiting in Mailhog every InboundEmailPollPeriod until ctx is done.
+// Processed messages are deleted, messages that failed for another reason than their content are kept for the next poll.
+func (a *Application) runMailhogInbox(ctx context.Context) {
+	ticker := time.NewTicker(a.Config.InboundEmailPollPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			messages, err := a.mailhogInbox.Fetch(ctx)
+			if err != nil {
+				logger.Error(ctx, "[mailhog-inbox] failed to fetch messages", err.Error())
+				continue
+			}
+			for _, message := range messages {
+				resp, err := a.Usecases.BookingUsecase.ProcessInboundEmail(ctx, message.Raw)
+				if err != nil && utils.ParseValidationErrors(err) == nil {
+					logger.Error(ctx, "[mailhog-inbox] failed to process message", err.Error())
+					continue
+				}
+				if resp != nil {
+					logger.Info(ctx, fmt.Sprintf("[mailhog-inbox] message %s is %s", message.ID, resp.Status))
+				}
+				if err := a.mailhogInbox.Delete(ctx, message.ID); err != nil {
+					logger.Error(ctx, "[mailhog-inbox] failed to delete message", err.Error())
+				}
+			}
+		}
 	}
 }
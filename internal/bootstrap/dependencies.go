@@ -4,6 +4,7 @@ import (
 	"wtm-backend/config"
 	"wtm-backend/internal/infrastructure/cache"
 	"wtm-backend/internal/infrastructure/database"
+	"wtm-backend/internal/infrastructure/document"
 	"wtm-backend/internal/infrastructure/email"
 	"wtm-backend/internal/infrastructure/storage"
 	"wtm-backend/internal/middleware"
@@ -25,8 +26,10 @@ type Dependencies struct {
 	Config        *config.Config
 	DB            *database.DBPostgre
 	Redis         *cache.RedisClient
+	NotifHub      *cache.NotificationHub
 	Storage       *storage.MultiStorageClient
 	EmailSender   *email.SMTPEmailSender
+	Document      *document.PDFRenderer
 	Middleware    *middleware.Middleware
 	DBTransaction *driver.DatabaseTransaction
 }
@@ -6,6 +6,7 @@ import (
 	"wtm-backend/config"
 	"wtm-backend/internal/infrastructure/cache"
 	"wtm-backend/internal/infrastructure/database"
+	"wtm-backend/internal/infrastructure/document"
 	"wtm-backend/internal/infrastructure/email"
 	"wtm-backend/internal/infrastructure/storage"
 	"wtm-backend/internal/middleware"
@@ -71,8 +72,10 @@ func initializeDependencies(ctx context.Context) (*Dependencies, error) {
 		Config:        cfg,
 		DB:            db,
 		Redis:         redisClient,
+		NotifHub:      cache.NewNotificationHub(redisClient),
 		Storage:       storageClient,
 		EmailSender:   emailClient,
+		Document:      document.NewPDFRenderer(cfg),
 		Middleware:    newMiddleware,
 		DBTransaction: dbTransaction,
 	}, nil
@@ -89,25 +92,26 @@ func initializeRepositories(deps *Dependencies) *Repositories {
 		BookingRepo:      booking_repository.NewBookingRepository(deps.DB, deps.Redis),
 		EmailRepo:        email_repository.NewEmailRepository(deps.DB),
 		ReportRepo:       report_repository.NewReportRepository(deps.DB),
-		NotificationRepo: notification_repository.NewNotificationRepository(deps.DB),
+		NotificationRepo: notification_repository.NewNotificationRepository(deps.DB, deps.Redis),
 		CurrencyRepo:     currency_repository.NewCurrencyRepository(deps.DB),
 	}
 }
 
 func initializeUsecases(deps *Dependencies, repos *Repositories) AppUsecases {
 	storageActive := deps.Storage.ActiveStorage
+	notificationUsecase := notification_usecase.NewNotificationUsecase(repos.NotificationRepo, repos.UserRepo, repos.AuthRepo, repos.EmailRepo, deps.Middleware, deps.DBTransaction, deps.NotifHub, deps.Config)
 
 	return AppUsecases{
 		AuthUsecase:         auth_usecase.NewAuthUsecase(repos.UserRepo, repos.AuthRepo, deps.Config, storageActive, deps.Middleware, deps.EmailSender, repos.EmailRepo, deps.DBTransaction),
-		UserUsecase:         user_usecase.NewUserUsecase(repos.UserRepo, repos.AuthRepo, repos.PromoGroupRepo, repos.EmailRepo, deps.Config, storageActive, deps.Middleware, deps.DBTransaction, deps.EmailSender),
-		PromoUsecase:        promo_usecase.NewPromoUsecase(repos.PromoRepo, deps.DBTransaction, deps.Middleware),
-		HotelUsecase:        hotel_usecase.NewHotelUsecase(repos.HotelRepo, repos.UserRepo, storageActive, deps.DBTransaction, deps.Config, deps.Middleware),
+		UserUsecase:         user_usecase.NewUserUsecase(repos.UserRepo, repos.AuthRepo, repos.PromoGroupRepo, repos.EmailRepo, deps.Config, storageActive, deps.Middleware, deps.DBTransaction, deps.EmailSender, notificationUsecase),
+		PromoUsecase:        promo_usecase.NewPromoUsecase(repos.PromoRepo, deps.DBTransaction, deps.Middleware, notificationUsecase, deps.Config),
+		HotelUsecase:        hotel_usecase.NewHotelUsecase(repos.HotelRepo, repos.UserRepo, storageActive, deps.DBTransaction, deps.Config, deps.Middleware, repos.CurrencyRepo),
 		BannerUsecase:       banner_usecase.NewBannerUsecase(repos.BannerRepo, deps.DBTransaction, storageActive),
 		PromoGroupUsecase:   promo_group_usecase.NewPromoGroupUsecase(repos.PromoGroupRepo, repos.UserRepo),
-		BookingUsecase:      booking_usecase.NewBookingUsecase(repos.BookingRepo, repos.HotelRepo, repos.PromoRepo, deps.Middleware, deps.DBTransaction, storageActive, deps.Config, repos.EmailRepo, deps.EmailSender, repos.UserRepo, repos.NotificationRepo),
+		BookingUsecase:      booking_usecase.NewBookingUsecase(repos.BookingRepo, repos.HotelRepo, repos.PromoRepo, deps.Middleware, deps.DBTransaction, storageActive, deps.Config, repos.EmailRepo, deps.EmailSender, repos.UserRepo, repos.NotificationRepo, repos.CurrencyRepo, deps.Document, notificationUsecase),
 		ReportUsecase:       report_usecase.NewReportUsecase(repos.ReportRepo),
-		NotificationUsecase: notification_usecase.NewNotificationUsecase(repos.NotificationRepo, deps.Middleware, deps.DBTransaction),
-		EmailUsecase:        email_usecase.NewEmailUsecase(repos.EmailRepo, deps.EmailSender, repos.BookingRepo, storageActive),
+		NotificationUsecase: notificationUsecase,
+		EmailUsecase:        email_usecase.NewEmailUsecase(repos.EmailRepo, deps.EmailSender, repos.BookingRepo, storageActive, deps.DBTransaction, deps.Config, repos.UserRepo, deps.Middleware),
 		FileUsecase:         file_usecase.NewFileUsecase(storageActive),
 		CurrencyUsecase:     currency_usecase.NewCurrencyUsecase(repos.CurrencyRepo),
 	}
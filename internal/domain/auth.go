@@ -3,22 +3,84 @@ package domain
 import (
 	"context"
 	"time"
+	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/authdto"
 )
 
 type AuthUsecase interface {
 	Login(ctx context.Context, request *authdto.LoginRequest) (*authdto.LoginResponse, string, error)
-	RefreshToken(ctx context.Context, token string) (*authdto.LoginResponse, error)
+	// SetupTwoFactorLogin enrolls an authenticator during a login held for a role that requires two-factor.
+	SetupTwoFactorLogin(ctx context.Context, req *authdto.TwoFactorChallengeRequest) (*authdto.TwoFactorSetupResponse, error)
+	// VerifyTwoFactorLogin completes a login held by a two-factor challenge.
+	VerifyTwoFactorLogin(ctx context.Context, req *authdto.VerifyTwoFactorLoginRequest) (*authdto.LoginResponse, string, error)
+	// RefreshToken exchanges a refresh token for an access token and the next refresh token of the session.
+	RefreshToken(ctx context.Context, token string) (*authdto.LoginResponse, string, error)
 	Logout(ctx context.Context) error
 	ForgotPassword(ctx context.Context, request *authdto.ForgotPasswordRequest) (*authdto.ForgotPasswordResponse, error)
 	ValidateTokenResetPassword(ctx context.Context, req *authdto.ValidateTokenResetPasswordRequest) (*authdto.ValidateTokenResetPasswordResponse, error)
 	ResetPassword(ctx context.Context, request *authdto.ResetPasswordRequest) error
+	ListSessions(ctx context.Context) (*authdto.ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, sessionID string) error
+	RevokeOtherSessions(ctx context.Context) error
+	GetTwoFactorStatus(ctx context.Context) (*authdto.TwoFactorStatusResponse, error)
+	SetupTwoFactor(ctx context.Context) (*authdto.TwoFactorSetupResponse, error)
+	VerifyTwoFactorSetup(ctx context.Context, req *authdto.TwoFactorCodeRequest) (*authdto.TwoFactorRecoveryCodesResponse, error)
+	DisableTwoFactor(ctx context.Context, req *authdto.DisableTwoFactorRequest) error
+	RegenerateRecoveryCodes(ctx context.Context, req *authdto.TwoFactorCodeRequest) (*authdto.TwoFactorRecoveryCodesResponse, error)
+	// ListAPIKeys lists the active API keys of the logged in user with the scopes it can grant.
+	ListAPIKeys(ctx context.Context) (*authdto.ListAPIKeysResponse, error)
+	// CreateAPIKey creates a personal API key, the key itself is only returned once.
+	CreateAPIKey(ctx context.Context, req *authdto.CreateAPIKeyRequest) (*authdto.CreateAPIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, keyID string) error
+	// ForceLogout revokes every session of another user.
+	ForceLogout(ctx context.Context, req *authdto.ForceLogoutRequest) error
+	// UnlockUser lifts the lockout of a user after too many failed login attempts.
+	UnlockUser(ctx context.Context, req *authdto.UnlockUserRequest) error
 }
 
 type AuthRepository interface {
-	SetAccessToken(ctx context.Context, userID uint, accessToken string, expiry time.Duration) error
-	ValidateAccessToken(ctx context.Context, userID uint, accessToken string) (bool, error)
-	DeleteAccessToken(ctx context.Context, userID uint) error
+	CreateSession(ctx context.Context, session *entity.UserSession) error
+	// GetActiveSession returns the session when it is neither revoked nor expired, nil otherwise.
+	GetActiveSession(ctx context.Context, sessionID string) (*entity.UserSession, error)
+	// GetActiveSessionsByUserID returns the sessions of the user that are neither revoked nor expired, last seen first.
+	GetActiveSessionsByUserID(ctx context.Context, userID uint) ([]entity.UserSession, error)
+	TouchSession(ctx context.Context, sessionID string, seenAt time.Time) error
+	// RevokeSession revokes one session of the user, false when the user has no such active session.
+	RevokeSession(ctx context.Context, userID uint, sessionID string) (bool, error)
+	// RevokeSessionsByUserID revokes every session of the user but exceptSessionID when set.
+	RevokeSessionsByUserID(ctx context.Context, userID uint, exceptSessionID string) (int64, error)
+	CreateAPIKey(ctx context.Context, apiKey *entity.APIKey) error
+	// GetActiveAPIKeysByUserID returns the keys of the user that are neither revoked nor expired, newest first.
+	GetActiveAPIKeysByUserID(ctx context.Context, userID uint) ([]entity.APIKey, error)
+	// GetActiveAPIKeyByHash returns the key with its owner when it is neither revoked nor expired, nil otherwise.
+	GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (*entity.APIKey, error)
+	TouchAPIKey(ctx context.Context, id uint, usedAt time.Time, ipAddress string) error
+	// RevokeAPIKey revokes one key of the user, false when the user has no such active key.
+	RevokeAPIKey(ctx context.Context, userID uint, keyID string) (bool, error)
+	CreateRefreshToken(ctx context.Context, token *entity.RefreshToken) error
+	// GetRefreshTokenByHash returns the refresh token with the session and user it belongs to, nil when unknown.
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error)
+	// UseRefreshToken marks the refresh token as used, only one caller gets true for the same token.
+	UseRefreshToken(ctx context.Context, id uint) (bool, error)
+	// GetTwoFactor returns the authenticator of the user, enabled or pending, nil when the user has none.
+	GetTwoFactor(ctx context.Context, userID uint) (*entity.UserTwoFactor, error)
+	// SaveTwoFactorSecret replaces the authenticator of the user with a pending one.
+	SaveTwoFactorSecret(ctx context.Context, userID uint, secret string) error
+	// EnableTwoFactor enables the pending authenticator of the user, false when there is none.
+	EnableTwoFactor(ctx context.Context, userID uint, step int64) (bool, error)
+	// UseTwoFactorStep records the period of an accepted code, false when a code of that period or a later one was used.
+	UseTwoFactorStep(ctx context.Context, userID uint, step int64) (bool, error)
+	DeleteTwoFactor(ctx context.Context, userID uint) error
+	ReplaceRecoveryCodes(ctx context.Context, userID uint, codeHashes []string) error
+	// UseRecoveryCode marks the recovery code as used, false when the user has no such unused code.
+	UseRecoveryCode(ctx context.Context, userID uint, codeHash string) (bool, error)
+	CountRecoveryCodes(ctx context.Context, userID uint) (int64, error)
+	SetTwoFactorChallenge(ctx context.Context, token string, challenge *entity.TwoFactorChallenge, ttl time.Duration) error
+	// GetTwoFactorChallenge returns the challenge with its remaining lifetime, nil when it expired.
+	GetTwoFactorChallenge(ctx context.Context, token string) (*entity.TwoFactorChallenge, time.Duration, error)
+	DeleteTwoFactorChallenge(ctx context.Context, token string) error
+	// HitRateLimit counts a request against key, returns the requests within the window and when the window ends.
+	HitRateLimit(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error)
 	CreatePasswordResetToken(ctx context.Context, userID uint, token string, expiry time.Duration) error
 	FindActiveResetTokenByUserID(ctx context.Context, userID uint) (string, error)
 	FindActiveResetTokenByToken(ctx context.Context, token string) (string, error)
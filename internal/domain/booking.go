@@ -2,10 +2,12 @@ package domain
 
 import (
 	"context"
+	"time"
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/bookingdto"
 	"wtm-backend/internal/infrastructure/database/model"
 	"wtm-backend/internal/repository/filter"
+	"wtm-backend/pkg/currency"
 )
 
 type BookingUsecase interface {
@@ -16,6 +18,8 @@ type BookingUsecase interface {
 	// for a specific cart detail (sub-cart) item owned by the authenticated agent.
 	UpdateCartAdditionalNotes(ctx context.Context, req *bookingdto.UpdateCartAdditionalNotesRequest) error
 	CheckOutCart(ctx context.Context) (*bookingdto.CheckOutCartResponse, error)
+	// QuoteBooking prices a stay in the agent's currency with the same engine as cart and checkout.
+	QuoteBooking(ctx context.Context, req *bookingdto.QuoteBookingRequest) (*bookingdto.QuoteBookingResponse, error)
 	ListBookingHistory(ctx context.Context, req *bookingdto.ListBookingHistoryRequest) (*bookingdto.ListBookingHistoryResponse, error)
 	ListBookings(ctx context.Context, req *bookingdto.ListBookingsRequest) (*bookingdto.ListBookingsResponse, error)
 	ListBookingLog(ctx context.Context, req *bookingdto.ListBookingLogRequest) (*bookingdto.ListBookingLogResponse, error)
@@ -31,6 +35,24 @@ type BookingUsecase interface {
 	CancelBooking(ctx context.Context, req *bookingdto.CancelBookingRequest) error
 	// UpdateAdminNotes updates admin_notes for a booking detail identified by sub_booking_id.
 	UpdateAdminNotes(ctx context.Context, req *bookingdto.UpdateAdminNotesRequest) error
+	// AmendBooking changes the dates, room price or quantity of a checked out sub-booking and sends it back for approval.
+	AmendBooking(ctx context.Context, req *bookingdto.AmendBookingRequest) (*bookingdto.AmendBookingResponse, error)
+	// ListBookingRevisions returns the values a sub-booking had before each amendment, latest first.
+	ListBookingRevisions(ctx context.Context, req *bookingdto.ListBookingRevisionsRequest) (*bookingdto.ListBookingRevisionsResponse, error)
+	// RecordPayment adds a payment to the ledger of a booking or sub-booking and updates the payment status.
+	RecordPayment(ctx context.Context, req *bookingdto.RecordPaymentRequest) (*bookingdto.RecordPaymentResponse, error)
+	// ListPayments returns the payments ledger of a booking with the outstanding balance of each sub-booking.
+	ListPayments(ctx context.Context, req *bookingdto.ListPaymentsRequest) (*bookingdto.ListPaymentsResponse, error)
+	// RemovePayment deletes a payment recorded by mistake and updates the payment status.
+	RemovePayment(ctx context.Context, paymentID uint) error
+	// InvoicePDF renders the invoice of a sub-booking as a PDF.
+	InvoicePDF(ctx context.Context, req *bookingdto.BookingDocumentRequest) (*bookingdto.BookingDocumentResponse, error)
+	// VoucherPDF renders the hotel voucher of a confirmed sub-booking as a PDF.
+	VoucherPDF(ctx context.Context, req *bookingdto.BookingDocumentRequest) (*bookingdto.BookingDocumentResponse, error)
+	// ProcessInboundEmail matches a reply of a hotel to its booking and attaches the confirmation number or rejection.
+	ProcessInboundEmail(ctx context.Context, raw []byte) (*bookingdto.ProcessInboundEmailResponse, error)
+	// RemindCheckIns notifies the agents of their confirmed sub-bookings checking in tomorrow and returns how many were reminded.
+	RemindCheckIns(ctx context.Context) (int, error)
 }
 
 type BookingRepository interface {
@@ -46,7 +68,7 @@ type BookingRepository interface {
 	UpdateBookingReceipt(ctx context.Context, bookingDetailID []uint, receiptURL string) error
 	GetBookingByID(ctx context.Context, bookingID uint) (*entity.Booking, error)
 	UpdateBookingDetailStatusBooking(ctx context.Context, bookingDetailID []uint, statusID uint) ([]entity.BookingDetail, []string, error)
-	UpdateBookingDetailStatusPayment(ctx context.Context, bookingDetailID []uint, statusID uint) error
+	UpdateBookingDetailStatusPayment(ctx context.Context, bookingDetailID []uint, statusID uint, manual bool) error
 	GetBookingByCode(ctx context.Context, code string) (*entity.Booking, error)
 	GetSubBookingByCode(ctx context.Context, code string) (*entity.BookingDetail, error)
 	GetBookingIDs(ctx context.Context, agentID uint, filter *filter.DefaultFilter) ([]string, int64, error)
@@ -59,8 +81,23 @@ type BookingRepository interface {
 	GenerateCode(ctx context.Context, keyRedis string, prefixCode string) (string, error)
 	GetBookingDetailIDsByBookingCode(ctx context.Context, bookingCode string) ([]uint, error)
 	GetIDBySubBookingID(ctx context.Context, subBookingID string) (uint, error)
+	// GetBookingDetailsByIDs returns booking details with their booking, room price, hotel and additionals preloaded.
+	GetBookingDetailsByIDs(ctx context.Context, ids []uint) ([]entity.BookingDetail, error)
+	// CreateCartHold holds one unit per cart booking detail on every night of the stay until the returned expiry. The
+	// check against the units available per night (keyed by YYYY-MM-DD) and the hold are one atomic step, it fails
+	// without holding anything when a night has not enough units left.
+	CreateCartHold(ctx context.Context, roomTypeID uint, checkIn, checkOut time.Time, bookingDetailIDs []uint, available map[string]int, ttl time.Duration) (time.Time, error)
+	// ReleaseCartHold drops the hold of a cart booking detail.
+	ReleaseCartHold(ctx context.Context, roomTypeID uint, checkIn, checkOut time.Time, bookingDetailID uint) error
+	// GetCartHoldExpiry returns when the hold of a cart booking detail expires, zero time if it already expired.
+	GetCartHoldExpiry(ctx context.Context, roomTypeID uint, checkIn time.Time, bookingDetailID uint) (time.Time, error)
+	// CountCartHolds counts active holds per night (keyed by YYYY-MM-DD), skipping the given booking details.
+	CountCartHolds(ctx context.Context, roomTypeID uint, checkIn, checkOut time.Time, excludeBookingDetailIDs []uint) (map[string]int, error)
 	GetListBookingLog(ctx context.Context, filter *filter.BookingFilter) ([]entity.BookingDetail, int64, error)
-	UpdateDetailBookingDetail(ctx context.Context, bookingDetailID uint, room *entity.DetailRoom, promo *entity.DetailPromo, price float64, additionals []entity.BookingDetailAdditional) error
+	UpdateDetailBookingDetail(ctx context.Context, bookingDetailID uint, room *entity.DetailRoom, promo *entity.DetailPromo, price currency.Money, additionals []entity.BookingDetailAdditional) error
+	UpdateBookingDetailExchangeRate(ctx context.Context, bookingDetailID uint, exchangeRate, markupPercent float64) error
+	// UpdateBookingDetailCancellation records the penalty charged when the booking detail was cancelled.
+	UpdateBookingDetailCancellation(ctx context.Context, bookingDetailID uint, penalty currency.Money, penaltyPercent float64, policyName string) error
 	GetBookingGuests(ctx context.Context, bookingID uint) ([]model.BookingGuest, error)
 	// DeleteAllGuestsFromBooking deletes all guests from a booking (used after checkout)
 	DeleteAllGuestsFromBooking(ctx context.Context, bookingID uint) error
@@ -69,4 +106,25 @@ type BookingRepository interface {
 	UpdateCartAdditionalNotes(ctx context.Context, agentID uint, bookingDetailID uint, additionalNotes string) error
 	// UpdateAdminNotes updates admin_notes on a booking_detail row identified by sub_booking_id.
 	UpdateAdminNotes(ctx context.Context, subBookingID string, adminNotes string) error
+	// AmendBookingDetail saves the amended stay and pricing of a booking detail and resets it to waiting approval.
+	AmendBookingDetail(ctx context.Context, detail *entity.BookingDetail) error
+	// CreateBookingDetailRevision stores a snapshot of a booking detail with the next revision number.
+	CreateBookingDetailRevision(ctx context.Context, revision *entity.BookingDetailRevision) error
+	// GetBookingDetailRevisions returns the revisions of a booking detail, latest first.
+	GetBookingDetailRevisions(ctx context.Context, bookingDetailID uint) ([]entity.BookingDetailRevision, error)
+	// UpdateInvoiceDetail replaces the detail of the invoice of a booking detail, keeping its invoice code.
+	UpdateInvoiceDetail(ctx context.Context, bookingDetailID uint, detail entity.DetailInvoice) error
+	// GetInvoiceByBookingDetailID returns nil when the booking detail has no invoice yet.
+	GetInvoiceByBookingDetailID(ctx context.Context, bookingDetailID uint) (*entity.Invoice, error)
+	CreatePayment(ctx context.Context, payment *entity.Payment) error
+	// GetPaymentsByBookingID returns the payments of a booking, oldest first.
+	GetPaymentsByBookingID(ctx context.Context, bookingID uint) ([]entity.Payment, error)
+	GetPaymentByID(ctx context.Context, id uint) (*entity.Payment, error)
+	DeletePayment(ctx context.Context, id uint) error
+	// UpdateHotelConfirmationNumber stores the confirmation number a hotel replied with on the booking details.
+	UpdateHotelConfirmationNumber(ctx context.Context, bookingDetailIDs []uint, confirmationNumber string) error
+	// GetCheckInReminderDetailIDs returns the confirmed booking details checking in on the date whose agent was not reminded yet.
+	GetCheckInReminderDetailIDs(ctx context.Context, checkInDate string) ([]uint, error)
+	// ClaimCheckInReminder marks the booking detail as reminded, only one caller gets true for the same booking detail.
+	ClaimCheckInReminder(ctx context.Context, bookingDetailID uint) (bool, error)
 }
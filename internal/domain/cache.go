@@ -13,4 +13,15 @@ type RedisClient interface {
 	IsSuffixUsed(ctx context.Context, dateKey string, suffix string) (bool, error)
 	MarkSuffixUsed(ctx context.Context, dateKey string, suffix string) error
 	TTL(ctx context.Context, key string) (time.Duration, error)
+	// Increment increments the counter key, which expires window after its first increment, and returns it with its remaining lifetime
+	Increment(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error)
+	// AddHoldsWithinLimit adds members to every sorted set of keys in one atomic step, unless a key would then hold more
+	// active members than its limit. It returns the index of the first key without room and its units left, or -1 once held
+	AddHoldsWithinLimit(ctx context.Context, keys []string, limits []int, members []string, expiresAt time.Time) (int, int, error)
+	// RemoveHold removes member from the sorted set key
+	RemoveHold(ctx context.Context, key string, member string) error
+	// GetHoldExpiry returns the expiry of member, zero time if it has no active hold
+	GetHoldExpiry(ctx context.Context, key string, member string) (time.Time, error)
+	// CountActiveHolds prunes expired holds and counts the remaining members not in excludeMembers
+	CountActiveHolds(ctx context.Context, key string, excludeMembers []string) (int, error)
 }
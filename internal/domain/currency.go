@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 	"wtm-backend/internal/domain/entity"
 )
 
@@ -12,6 +13,13 @@ type CurrencyRepository interface {
 	CreateCurrency(ctx context.Context, currency *entity.Currency) (*entity.Currency, error)
 	UpdateCurrency(ctx context.Context, currency *entity.Currency) (*entity.Currency, error)
 	GetActiveCurrencies(ctx context.Context) ([]entity.Currency, error)
+	GetExchangeRates(ctx context.Context, currencyCode string) ([]entity.ExchangeRate, error)
+	GetExchangeRateByID(ctx context.Context, id uint) (*entity.ExchangeRate, error)
+	// GetEffectiveExchangeRate returns the latest rate of the currency effective on date, nil when none is set.
+	GetEffectiveExchangeRate(ctx context.Context, currencyCode string, date time.Time) (*entity.ExchangeRate, error)
+	CreateExchangeRate(ctx context.Context, exchangeRate *entity.ExchangeRate) (*entity.ExchangeRate, error)
+	UpdateExchangeRate(ctx context.Context, exchangeRate *entity.ExchangeRate) (*entity.ExchangeRate, error)
+	DeleteExchangeRate(ctx context.Context, id uint) error
 }
 
 type CurrencyUsecase interface {
@@ -19,4 +27,8 @@ type CurrencyUsecase interface {
 	GetActiveCurrencies(ctx context.Context) ([]entity.Currency, error)
 	CreateCurrency(ctx context.Context, currency *entity.Currency) (*entity.Currency, error)
 	UpdateCurrency(ctx context.Context, currency *entity.Currency) (*entity.Currency, error)
+	ListExchangeRates(ctx context.Context, currencyCode string) ([]entity.ExchangeRate, error)
+	CreateExchangeRate(ctx context.Context, exchangeRate *entity.ExchangeRate) (*entity.ExchangeRate, error)
+	UpdateExchangeRate(ctx context.Context, exchangeRate *entity.ExchangeRate) (*entity.ExchangeRate, error)
+	RemoveExchangeRate(ctx context.Context, id uint) error
 }
@@ -4,4 +4,6 @@ import "context"
 
 type DatabaseTransaction interface {
 	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	// AfterCommit runs fn once the transaction of ctx is committed, right away outside a transaction.
+	AfterCommit(ctx context.Context, fn func(ctx context.Context))
 }
@@ -0,0 +1,12 @@
+package domain
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+)
+
+// DocumentRenderer renders the documents handed to agents and guests
+type DocumentRenderer interface {
+	RenderInvoice(ctx context.Context, invoice entity.Invoice) ([]byte, error)
+	RenderVoucher(ctx context.Context, voucher entity.Voucher) ([]byte, error)
+}
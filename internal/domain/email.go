@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/emaildto"
 	"wtm-backend/internal/repository/filter"
@@ -9,23 +10,68 @@ import (
 )
 
 type EmailSender interface {
-	Send(ctx context.Context, scope constant.Scope, to, subject, bodyHTML, bodyText string) error
+	// Send sends an email through the SMTP route of scope. Attachments must carry their content.
+	Send(ctx context.Context, scope constant.Scope, to, subject, bodyHTML, bodyText string, attachments ...entity.EmailAttachment) error
 }
 
 type EmailRepository interface {
-	GetEmailTemplateByName(ctx context.Context, name string) (*entity.EmailTemplate, error)
+	// GetEmailTemplateByName returns the variant of the template in locale, falling back to the default locale.
+	GetEmailTemplateByName(ctx context.Context, name, locale string) (*entity.EmailTemplate, error)
+	// GetEmailTemplateLocales returns the locales the template has a variant in.
+	GetEmailTemplateLocales(ctx context.Context, name string) ([]string, error)
+	CreateEmailTemplate(ctx context.Context, template *entity.EmailTemplate) error
 	UpdateEmailTemplate(ctx context.Context, template *entity.EmailTemplate) error
+	// CreateEmailTemplateVersion stores version as the next version of its template.
+	CreateEmailTemplateVersion(ctx context.Context, version *entity.EmailTemplateVersion) error
+	// GetEmailTemplateVersions returns the versions of a template, newest first.
+	GetEmailTemplateVersions(ctx context.Context, templateID uint) ([]entity.EmailTemplateVersion, error)
+	GetEmailTemplateVersion(ctx context.Context, templateID uint, version int) (*entity.EmailTemplateVersion, error)
 	CreateEmailLog(ctx context.Context, log *entity.EmailLog) error
 	UpdateStatusEmailLog(ctx context.Context, log *entity.EmailLog) error
 	GetEmailLogs(ctx context.Context, filter filter.EmailLogFilter) ([]entity.EmailLog, int64, error)
 	GetEmailLogByID(ctx context.Context, id uint) (*entity.EmailLog, error)
+	// GetRetryableEmailLogs returns the oldest email logs of the filter that are not waiting in the outbox.
+	GetRetryableEmailLogs(ctx context.Context, filter filter.EmailLogFilter, maxRetries int) ([]entity.EmailLog, error)
+	// MarkEmailLogRetrying moves an email log back to pending and counts the retry while it still has statusID and
+	// retryCount, false when another retry got it first.
+	MarkEmailLogRetrying(ctx context.Context, id uint, statusID uint, retryCount int) (bool, error)
+	// EnqueueEmail writes the email of a log to the outbox through the SMTP route of its scope, to be sent at sendAt or
+	// now when zero, in the transaction of ctx when there is one.
+	EnqueueEmail(ctx context.Context, emailLog entity.EmailLog, sendAt time.Time) error
+	// QueueEmail logs an email as pending and writes it to the outbox with EnqueueEmail. The outbox workers send it and
+	// update the status of the log.
+	QueueEmail(ctx context.Context, emailLog *entity.EmailLog, sendAt time.Time) error
+	// ClaimEmailOutbox locks up to limit due emails for lease, including emails of workers that stopped mid-send. The
+	// claim counts as an attempt, Attempts of the result includes it.
+	ClaimEmailOutbox(ctx context.Context, limit int, lease time.Duration) ([]entity.EmailOutbox, error)
+	// MarkEmailOutboxSent releases a sent email and marks its email log as success. It does nothing when the claim of
+	// lockedAt lost its lease and the email was claimed again.
+	MarkEmailOutboxSent(ctx context.Context, outboxID uint, lockedAt time.Time) error
+	// MarkEmailOutboxFailed schedules the next attempt of an email, or moves it to dead-letter and fails its email log.
+	// It does nothing when the claim of lockedAt lost its lease and the email was claimed again.
+	MarkEmailOutboxFailed(ctx context.Context, outboxID uint, lockedAt time.Time, lastError string, nextAttemptAt time.Time, dead bool) error
+	// CountEmailOutbox returns the number of unsent emails per outbox status.
+	CountEmailOutbox(ctx context.Context) (map[string]int64, error)
+	CreateInboundEmail(ctx context.Context, inbound *entity.InboundEmail) error
+	// GetInboundEmailByMessageID returns nil when the reply was not received yet.
+	GetInboundEmailByMessageID(ctx context.Context, messageID string) (*entity.InboundEmail, error)
+	UpdateInboundEmailStatus(ctx context.Context, id uint, status, note string) error
+	// GetInboundEmails returns the inbound emails of the filter, newest first.
+	GetInboundEmails(ctx context.Context, filter filter.InboundEmailFilter) ([]entity.InboundEmail, int64, error)
 }
 
 type EmailUsecase interface {
 	EmailTemplate(ctx context.Context, req *emaildto.EmailTemplateRequest) (*emaildto.EmailTemplateResponse, error)
 	UpdateEmailTemplate(ctx context.Context, req *emaildto.UpdateEmailTemplateRequest) error
+	ListEmailTemplateVersions(ctx context.Context, req *emaildto.EmailTemplateRequest) ([]emaildto.EmailTemplateVersionResponse, error)
+	RollbackEmailTemplate(ctx context.Context, req *emaildto.RollbackEmailTemplateRequest) (*emaildto.EmailTemplateVersionResponse, error)
+	PreviewEmailTemplate(ctx context.Context, req *emaildto.PreviewEmailTemplateRequest) (*emaildto.PreviewEmailTemplateResponse, error)
+	TestSendEmailTemplate(ctx context.Context, req *emaildto.PreviewEmailTemplateRequest) (*emaildto.TestSendEmailTemplateResponse, error)
 	SendContactUsEmail(ctx context.Context, req *emaildto.SendContactUsEmailRequest) error
 	ListEmailLogs(ctx context.Context, req *emaildto.ListEmailLogsRequest) (*emaildto.ListEmailLogsResponse, error)
 	GetEmailLogDetail(ctx context.Context, id uint) (*emaildto.EmailLogDetailResponse, error)
 	RetryEmail(ctx context.Context, req *emaildto.RetryEmailRequest) (*emaildto.RetryEmailResponse, error)
+	AutoRetryFailedEmails(ctx context.Context) (int, error)
+	// ListInboundEmails returns the replies of hotels received by the inbound email webhook, newest first.
+	ListInboundEmails(ctx context.Context, req *emaildto.ListInboundEmailsRequest) (*emaildto.ListInboundEmailsResponse, error)
 }
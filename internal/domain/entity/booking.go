@@ -2,6 +2,7 @@ package entity
 
 import (
 	"time"
+	"wtm-backend/pkg/currency"
 )
 
 type Booking struct {
@@ -19,6 +20,7 @@ type Booking struct {
 	AgentName        string
 	AgentCompanyName string
 	AgentEmail       string
+	AgentLanguage    string // Preferred language of the agent's emails
 	AgentPhoneNumber string
 	PromoGroupAgent  string
 }
@@ -43,18 +45,25 @@ type BookingDetail struct {
 	PromoID                     *uint
 	DetailPromos                DetailPromo
 	DetailRooms                 DetailRoom
-	Price                       float64
-	Currency                    string // Snapshot of currency at booking time
+	Price                       currency.Money
+	Currency                    string         // Snapshot of currency at booking time
+	ExchangeRate                float64        // Snapshot of the IDR based exchange rate used at checkout, 0 when not used
+	ExchangeRateMarkup          float64        // Snapshot of the exchange rate markup percentage
+	CancellationPenalty         currency.Money // Penalty charged on cancellation, in Currency
+	CancellationPenaltyPercent  float64        // Penalty percentage of the tier that applied
+	CancellationPolicyName      string         // Name of the policy that applied
 	Guest                       string
 	OtherPreferences            string
 	BedType                     string   // Selected bed type (singular)
 	BedTypeNames                []string // Available bed types for selection
 	AdditionalNotes             string   // Optional notes from agent to admin (max 500 characters)
 	AdminNotes                  string   // Optional notes from admin to agent (max 500 characters)
+	HotelConfirmationNumber     string   // Given by the hotel in its reply to the booking email
 	BookingDetailsAdditional    []BookingDetailAdditional
 	RoomPrice                   RoomPrice
 	StatusBookingID             uint
 	StatusPaymentID             uint
+	StatusPaymentManual         bool // Set by an admin by hand, kept by the payments ledger
 	BookingDetailAdditionalName []string
 	BookingStatus               string
 	PaymentStatus               string
@@ -65,6 +74,45 @@ type BookingDetail struct {
 	Promo                       *Promo
 }
 
+// BookingDetailRevision is a snapshot of a booking detail before it was amended
+type BookingDetailRevision struct {
+	ID              uint
+	BookingDetailID uint
+	Revision        int
+	RoomPriceID     uint
+	CheckInDate     time.Time
+	CheckOutDate    time.Time
+	Quantity        int
+	PromoID         *uint
+	DetailPromos    DetailPromo
+	DetailRooms     DetailRoom
+	Price           currency.Money
+	Currency        string
+	StatusBookingID uint
+	Reason          string
+	AmendedBy       uint
+	AmendedByName   string
+	CreatedAt       time.Time
+}
+
+// Payment is an entry of the payments ledger of a booking
+type Payment struct {
+	ID              uint
+	BookingID       uint
+	BookingDetailID *uint  // nil = paid on the whole booking
+	SubBookingID    string // empty when paid on the whole booking
+	Amount          currency.Money
+	Currency        string
+	Method          string
+	Reference       string
+	ReceiptUrl      string
+	Notes           string
+	PaidAt          time.Time
+	RecordedBy      uint
+	RecordedByName  string
+	CreatedAt       time.Time
+}
+
 type DetailPromo struct {
 	Name            string             `json:"name,omitempty"`
 	PromoCode       string             `json:"promo_code,omitempty"`
@@ -84,6 +132,8 @@ type DetailRoom struct {
 	CancelledDate string `json:"cancelled_period,omitempty"`
 	Capacity      int    `json:"capacity,omitempty"`
 	IsAPI         bool   `json:"is_api,omitempty"`
+
+	CancellationPolicy *CancellationPolicy `json:"cancellation_policy,omitempty"` // Policy in effect at checkout
 }
 
 type BookingDetailAdditional struct {
@@ -170,18 +220,47 @@ type DetailInvoice struct {
 	DescriptionInvoice []DescriptionInvoice `json:"description_invoice"`
 	Promo              DetailPromo          `json:"promo"`
 	Description        string               `json:"description"`
-	TotalPrice         float64              `json:"total_price"`
+	TotalPrice         currency.Money       `json:"total_price"`
 	Currency           string               `json:"currency,omitempty"` // Currency code for the invoice (e.g. "IDR", "USD")
+
+	// Exchange rate used to derive prices not entered in Currency, kept so totals are reproducible
+	ExchangeRate       float64 `json:"exchange_rate,omitempty"`        // IDR per 1 unit of Currency
+	ExchangeRateMarkup float64 `json:"exchange_rate_markup,omitempty"` // Markup percentage
+	ExchangeRateDate   string  `json:"exchange_rate_date,omitempty"`   // Effective date of the rate
+}
+
+// Voucher is the hotel voucher of a confirmed sub-booking, presented by the guest at check-in
+type Voucher struct {
+	SubBookingID     string
+	BookingCode      string
+	AgentCompany     string
+	Agent            string
+	HotelName        string
+	HotelAddress     string
+	HotelEmail       string
+	RoomTypeName     string
+	BedType          string
+	Quantity         int
+	Guest            string
+	CheckIn          time.Time
+	CheckOut         time.Time
+	CheckInHour      string // e.g. "14:00"
+	CheckOutHour     string
+	Additionals      []string
+	OtherPreferences string
+	ConfirmedAt      time.Time
 }
 
 type DescriptionInvoice struct {
-	Description      string  `json:"description"`
-	Quantity         int     `json:"quantity"`
-	Unit             string  `json:"unit"`
-	Price            float64 `json:"price"`
-	Total            float64 `json:"total"`
-	TotalBeforePromo float64 `json:"total_before_promo,omitempty"`
-	Category         string  `json:"category,omitempty"`    // "price" or "pax" - only for additional services
-	Pax              *int    `json:"pax,omitempty"`         // nullable, used when category="pax"
-	IsRequired       bool    `json:"is_required,omitempty"` // only for additional services
+	Description      string          `json:"description"`
+	Quantity         int             `json:"quantity"`
+	Unit             string          `json:"unit"`
+	Price            currency.Money  `json:"price"`
+	Total            currency.Money  `json:"total"`
+	TotalBeforePromo *currency.Money `json:"total_before_promo,omitempty"`
+	Category         string          `json:"category,omitempty"`    // "price" or "pax" - only for additional services
+	Pax              *int            `json:"pax,omitempty"`         // nullable, used when category="pax"
+	IsRequired       bool            `json:"is_required,omitempty"` // only for additional services
+
+	NightlyRates []NightlyRate `json:"nightly_rates,omitempty"` // per-night breakdown, only for the room line
 }
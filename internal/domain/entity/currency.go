@@ -1,5 +1,7 @@
 package entity
 
+import "time"
+
 type Currency struct {
 	ID         uint
 	ExternalID string
@@ -8,3 +10,12 @@ type Currency struct {
 	Symbol     string
 	IsActive   bool
 }
+
+type ExchangeRate struct {
+	ID            uint
+	ExternalID    string
+	CurrencyCode  string
+	Rate          float64 // IDR per 1 unit of CurrencyCode
+	MarkupPercent float64
+	EffectiveDate time.Time
+}
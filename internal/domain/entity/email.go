@@ -12,6 +12,44 @@ type EmailLog struct {
 	StatusID        uint              `json:"status_id"`
 	CreatedAt       time.Time         `json:"created_at"`
 	EmailType       string            `json:"email_type"`
+	Scope           string            `json:"scope"`
+	TemplateName    string            `json:"template_name"`
+	RetryCount      int               `json:"retry_count"`
+	Attachments     []EmailAttachment `json:"attachments"`
+}
+
+// EmailOutbox is an email queued for the outbox workers
+type EmailOutbox struct {
+	ID            uint
+	EmailLogID    *uint
+	Scope         string
+	To            string
+	Subject       string
+	BodyHTML      string
+	BodyText      string
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LockedAt      time.Time // Start of the lease of the claim, the outcome is only recorded while it still holds
+	LastError     string
+	Attachments   []EmailAttachment
+}
+
+// EmailAttachment is a file sent with an email. The content is read from storage when the email is sent,
+// so a retry sends the same file. Inline attachments are images referenced from the HTML as cid:<ContentID>.
+type EmailAttachment struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	ContentID   string `json:"content_id,omitempty"`
+	Bucket      string `json:"bucket"`
+	Object      string `json:"object"`
+	Size        int64  `json:"size"`
+	Content     []byte `json:"-"`
+}
+
+// Inline reports whether the attachment is embedded in the HTML body
+func (a EmailAttachment) Inline() bool {
+	return a.ContentID != ""
 }
 
 type MetadataEmailLog struct {
@@ -20,3 +58,22 @@ type MetadataEmailLog struct {
 	Notes       string `json:"notes"`
 	BookingCode string `json:"booking_code,omitempty"`
 }
+
+// InboundEmail is a reply of a hotel to a booking email
+type InboundEmail struct {
+	ID                 uint
+	ExternalID         string
+	MessageID          string
+	From               string
+	To                 string
+	Subject            string
+	Body               string
+	BookingID          *uint
+	BookingCode        string
+	SubBookingIDs      []string
+	Action             string
+	ConfirmationNumber string
+	Status             string
+	Note               string
+	CreatedAt          time.Time
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"time"
+	"wtm-backend/pkg/currency"
 
 	"github.com/lib/pq"
 )
@@ -19,6 +20,7 @@ type Hotel struct {
 	StatusID        uint
 	Rating          int
 	Email           string
+	Language        string // Preferred language of emails, empty for the default
 
 	StatusHotel   string
 	FacilityNames []string
@@ -26,9 +28,12 @@ type Hotel struct {
 	RoomTypes     []RoomType
 	PromoHotel    []Promo
 
-	CancellationPeriod int
-	CheckInHour        *time.Time
-	CheckOutHour       *time.Time
+	CancellationPeriod   int
+	CancellationPolicyID *uint // Overrides CancellationPeriod when set
+	DepositPercent       float64
+	BalanceDueDays       int
+	CheckInHour          *time.Time
+	CheckOutHour         *time.Time
 
 	SocialMedia map[string]string
 }
@@ -39,7 +44,7 @@ type CustomHotel struct {
 	AddrSubDistrict string             `json:"addr_sub_district"`
 	AddrCity        string             `json:"addr_city"`
 	AddrProvince    string             `json:"addr_province"`
-	Photos          pq.StringArray     `gorm:"type:text[] "json:"photos"`
+	Photos          pq.StringArray     `gorm:"type:text[]" json:"photos"`
 	Rating          int                `json:"rating"`
 	MinPrice        float64            `json:"min_price"`          // DEPRECATED: Use Prices instead
 	Prices          map[string]float64 `json:"prices,omitempty"`   // Multi-currency prices {"IDR": 500000, "USD": 200}
@@ -129,6 +134,8 @@ type CustomBreakfastWithID struct {
 	Prices map[string]float64 `json:"prices,omitempty" form:"prices"` // NEW: Multi-currency prices {"IDR": 1600000, "USD": 100}
 	Pax    int                `json:"pax,omitempty" form:"pax"`
 	IsShow bool               `json:"is_show" form:"is_show"`
+
+	NightlyRates []NightlyRate `json:"nightly_rates,omitempty" form:"-"` // Per-night rates in the agent's currency for the requested stay
 }
 
 type RoomUnavailable struct {
@@ -136,6 +143,66 @@ type RoomUnavailable struct {
 	Date       *time.Time
 }
 
+type RoomInventory struct {
+	RoomTypeID uint
+	Date       time.Time
+	TotalUnit  int
+	BookedUnit int
+}
+
+type RoomRestriction struct {
+	RoomTypeID        uint
+	Date              time.Time
+	MinStay           int
+	MaxStay           int
+	ClosedToArrival   bool
+	ClosedToDeparture bool
+	ReleaseDays       int
+}
+
+// IsEmpty reports whether the restriction does not constrain anything.
+func (r RoomRestriction) IsEmpty() bool {
+	return r.MinStay == 0 && r.MaxStay == 0 && !r.ClosedToArrival && !r.ClosedToDeparture && r.ReleaseDays == 0
+}
+
+type RoomRatePlan struct {
+	ID          uint
+	RoomPriceID uint
+	IsBreakfast bool
+	Name        string
+	StartDate   time.Time
+	EndDate     time.Time
+	DaysOfWeek  []int
+	Prices      map[string]float64
+	Priority    int
+	IsActive    bool
+
+	CancellationPolicyID *uint // Overrides the hotel policy for stays arriving on a night of the plan
+}
+
+// CancellationPolicy is a named set of penalty tiers, attached to hotels or rate plans.
+type CancellationPolicy struct {
+	ID          uint               `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Tiers       []CancellationTier `json:"tiers"`
+}
+
+// CancellationTier charges PenaltyPercent of the booking total when cancelling at least
+// DaysBeforeCheckIn days before check-in. The tier with the most days that still applies wins,
+// cancelling after every tier is non-refundable.
+type CancellationTier struct {
+	DaysBeforeCheckIn int     `json:"days_before_check_in"`
+	PenaltyPercent    float64 `json:"penalty_percent"`
+}
+
+type NightlyRate struct {
+	Date         string         `json:"date"` // YYYY-MM-DD
+	Price        currency.Money `json:"price"`
+	RatePlanID   uint           `json:"rate_plan_id,omitempty"`
+	RatePlanName string         `json:"rate_plan_name,omitempty"`
+}
+
 type FilterRangePrice struct {
 	MinPrice float64 `json:"min_price"`
 	MaxPrice float64 `json:"max_price"`
@@ -1,8 +1,11 @@
 package entity
 
+import "time"
+
 type EmailTemplate struct {
 	ID               int64  `json:"id"`
 	Name             string `json:"name"`
+	Locale           string `json:"locale"`
 	Subject          string `json:"subject"`
 	Body             string `json:"body"`
 	IsSignatureImage bool   `json:"is_signature_image"`
@@ -10,6 +13,21 @@ type EmailTemplate struct {
 	ExternalID       string
 }
 
+type EmailTemplateVersion struct {
+	ID               uint      `json:"id"`
+	EmailTemplateID  uint      `json:"email_template_id"`
+	Version          int       `json:"version"`
+	Subject          string    `json:"subject"`
+	Body             string    `json:"body"`
+	IsSignatureImage bool      `json:"is_signature_image"`
+	Signature        string    `json:"signature"`
+	AuthorID         *uint     `json:"author_id"`
+	AuthorName       string    `json:"author_name"`
+	Note             string    `json:"note"`
+	CreatedAt        time.Time `json:"created_at"`
+	ExternalID       string
+}
+
 type Notification struct {
 	ID          uint   `json:"id"`
 	UserID      uint   `json:"user_id"`
@@ -20,3 +38,24 @@ type Notification struct {
 	IsRead      bool   `json:"is_read"`
 	ReadAt      string `json:"read_at"`
 }
+
+// NotificationEvent is pushed to the connected clients of a user when a notification is created or read
+type NotificationEvent struct {
+	UserID       uint          `json:"user_id"`
+	Type         string        `json:"type"`                   // notification or unread_count
+	Notification *Notification `json:"notification,omitempty"` // Set on notification events
+	UnreadCount  int64         `json:"unread_count"`
+}
+
+// NotificationDigestItem is a notification held for the next daily digest of its user
+type NotificationDigestItem struct {
+	ID          uint
+	UserID      uint
+	Title       string
+	Message     string
+	RedirectURL string
+	Type        string
+	Web         bool
+	Email       bool
+	CreatedAt   time.Time
+}
@@ -1,5 +1,7 @@
 package entity
 
+import "time"
+
 type User struct {
 	FullName       string
 	AgentCompanyID *uint
@@ -16,6 +18,11 @@ type User struct {
 	PhotoSelfie    string
 	PhotoIDCard    string
 	Currency       string // Agent currency preference (set by admin)
+	Language       string // Preferred language of emails, empty for the default
+
+	FailedLoginAttempts int
+	LockoutCount        int
+	LockedUntil         *time.Time // Login is refused until then after too many wrong passwords
 
 	//additional fields
 	ID                       uint
@@ -25,6 +32,8 @@ type User struct {
 	Permissions              []string
 	AgentCompanyName         string
 	PromoGroupName           string
+	SessionID                string // Session of the access token, set on the user of the request context
+	TwoFactorRequired        bool   // Policy of the role of the user
 	UserNotificationSettings []UserNotificationSetting
 }
 
@@ -35,12 +44,81 @@ type UserNotificationSetting struct {
 	IsEnabled bool
 }
 
+// UserNotificationPreference is the digest and quiet hours of a user, the zero value sends every notification when it happens
+type UserNotificationPreference struct {
+	UserID            uint
+	DigestMode        string
+	DigestHour        int
+	LastDigestAt      *time.Time
+	QuietHoursEnabled bool
+	QuietHoursStart   string
+	QuietHoursEnd     string
+	Timezone          string
+}
+
 type AgentCompany struct {
 	ID   uint   `json:"id"`
 	Name string `json:"name"`
 }
 
 // UserMin is a minimal representation of a user, typically used for listings or summaries.
+// UserSession is a login of a user on one device
+type UserSession struct {
+	ID         uint
+	SessionID  string
+	UserID     uint
+	Device     string
+	IPAddress  string
+	UserAgent  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+}
+
+// RefreshToken is a refresh token of a session, only its hash is stored
+type RefreshToken struct {
+	ID            uint
+	UserSessionID uint
+	SessionID     string // External ID of the session
+	UserID        uint
+	TokenHash     string
+	ExpiresAt     time.Time
+	UsedAt        *time.Time
+}
+
+// APIKey is a personal credential of a user for system integrations
+type APIKey struct {
+	ID         uint
+	KeyID      string // External ID
+	UserID     uint
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     []string
+	RateLimit  int // Requests per minute
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	LastUsedIP string
+	ExpiresAt  *time.Time
+	User       *User // Owner with the current status and permissions of its role, set by GetActiveAPIKeyByHash
+}
+
+// UserTwoFactor is the TOTP authenticator of a user
+type UserTwoFactor struct {
+	UserID       uint
+	Secret       string // Encrypted
+	EnabledAt    *time.Time
+	LastUsedStep int64
+}
+
+// TwoFactorChallenge is a login waiting for its TOTP code, the client is kept for the session created after it
+type TwoFactorChallenge struct {
+	UserID    uint   `json:"user_id"`
+	Device    string `json:"device"`
+	IPAddress string `json:"ip_address"`
+	UserAgent string `json:"user_agent"`
+}
+
 type UserMin struct {
 	ID          uint     `json:"id"`
 	Username    string   `json:"username"`
@@ -52,9 +130,10 @@ type UserMin struct {
 }
 
 type Role struct {
-	ID          uint
-	Role        string
-	Permissions []Permission
+	ID                uint
+	Role              string
+	TwoFactorRequired bool
+	Permissions       []Permission
 }
 
 type Permission struct {
@@ -18,7 +18,7 @@ type HotelUsecase interface {
 	ListAdditionalRooms(ctx context.Context, req *hoteldto.ListAdditionalRoomsRequest) (*hoteldto.ListAdditionalRoomsResponse, error)
 	ListAllBedTypes(ctx context.Context, req *hoteldto.ListAllBedTypesRequest) (*hoteldto.ListAllBedTypesResponse, error)
 	DetailHotel(ctx context.Context, hotelID uint) (*hoteldto.DetailHotelResponse, error)
-	DetailHotelForAgent(ctx context.Context, hotelID uint) (*hoteldto.DetailHotelForAgentResponse, error)
+	DetailHotelForAgent(ctx context.Context, req *hoteldto.DetailHotelForAgentRequest) (*hoteldto.DetailHotelForAgentResponse, error)
 	RemoveHotel(ctx context.Context, hotelID uint) error
 	RemoveRoomType(ctx context.Context, roomTypeID uint) error
 	ListRoomAvailable(ctx context.Context, req *hoteldto.ListRoomAvailableRequest) (*hoteldto.ListRoomAvailableResponse, error)
@@ -30,6 +30,14 @@ type HotelUsecase interface {
 	UpdateHotel(ctx context.Context, req *hoteldto.UpdateHotelRequest) error
 	UpdateRoomType(ctx context.Context, req *hoteldto.UpdateRoomTypeRequest) error
 	UploadHotel(ctx context.Context, req *hoteldto.UploadHotelRequest) (bool, error)
+	ListRoomRatePlans(ctx context.Context, req *hoteldto.ListRoomRatePlanRequest) (*hoteldto.ListRoomRatePlanResponse, error)
+	UpsertRoomRatePlan(ctx context.Context, req *hoteldto.UpsertRoomRatePlanRequest, ratePlanID uint) error
+	RemoveRoomRatePlan(ctx context.Context, ratePlanID uint) error
+	ListCancellationPolicies(ctx context.Context) (*hoteldto.ListCancellationPoliciesResponse, error)
+	UpsertCancellationPolicy(ctx context.Context, req *hoteldto.UpsertCancellationPolicyRequest, policyID uint) error
+	RemoveCancellationPolicy(ctx context.Context, policyID uint) error
+	UpdateHotelCancellationPolicy(ctx context.Context, req *hoteldto.UpdateHotelCancellationPolicyRequest) error
+	UpdateHotelDepositRule(ctx context.Context, req *hoteldto.UpdateHotelDepositRuleRequest) error
 }
 
 type HotelRepository interface {
@@ -60,6 +68,10 @@ type HotelRepository interface {
 	GetRoomUnavailableByRoomTypeIDs(ctx context.Context, roomTypeIDs []uint, month time.Time) ([]entity.RoomUnavailable, error)
 	DeleteRoomUnavailable(ctx context.Context, roomTypeID uint, month time.Time) error
 	InsertRoomUnavailable(ctx context.Context, roomTypeID uint, unavailableDates []time.Time) error
+	// GetRoomRestrictions returns the restrictions set on dates in [startDate, endDate).
+	GetRoomRestrictions(ctx context.Context, roomTypeIDs []uint, startDate, endDate time.Time) ([]entity.RoomRestriction, error)
+	DeleteRoomRestrictions(ctx context.Context, roomTypeID uint, month time.Time) error
+	InsertRoomRestrictions(ctx context.Context, restrictions []entity.RoomRestriction) error
 	GetProvinces(ctx context.Context, filter *filter.DefaultFilter) ([]string, int64, error)
 	GetRoomPriceByID(ctx context.Context, id uint) (*entity.RoomPrice, error)
 	GetRoomTypeAdditionalsByIDs(ctx context.Context, ids []uint) ([]entity.RoomTypeAdditional, error)
@@ -70,4 +82,31 @@ type HotelRepository interface {
 	AttachRoomPreferences(ctx context.Context, roomTypeID uint, preferenceNames []string) error
 	UpdateRoomPreferences(ctx context.Context, roomTypeID uint, unchangedPreferenceIDs []uint, newPreferenceNames []string) error
 	GetRoomTypePreferencesByIDs(ctx context.Context, ids []uint) ([]entity.RoomTypePreference, error)
+	// GetRoomInventories returns the per-night ledger rows in [startDate, endDate). A night without a row is read from
+	// RoomType.TotalUnit and its active sub-bookings, rows are only created when units are reserved.
+	GetRoomInventories(ctx context.Context, roomTypeIDs []uint, startDate, endDate time.Time) ([]entity.RoomInventory, error)
+	// ReserveRoomInventory takes quantity units for every night of the stay, failing when any night would be oversold.
+	ReserveRoomInventory(ctx context.Context, roomTypeID uint, checkIn, checkOut time.Time, quantity int) error
+	// ReleaseRoomInventory gives back quantity units for every night of the stay.
+	ReleaseRoomInventory(ctx context.Context, roomTypeID uint, checkIn, checkOut time.Time, quantity int) error
+	// UpdateRoomInventoryTotalUnit applies a new RoomType.TotalUnit to the ledger rows from today onwards. It returns the
+	// first night with more units booked than totalUnit, which keeps its total, or nil when every night took it.
+	UpdateRoomInventoryTotalUnit(ctx context.Context, roomTypeID uint, totalUnit int) (*entity.RoomInventory, error)
+	// GetRoomRatePlans returns the active rate plans of the room prices overlapping [startDate, endDate).
+	GetRoomRatePlans(ctx context.Context, roomPriceIDs []uint, startDate, endDate time.Time) ([]entity.RoomRatePlan, error)
+	GetRoomRatePlansByRoomTypeID(ctx context.Context, roomTypeID uint) ([]entity.RoomRatePlan, error)
+	GetRoomRatePlanByID(ctx context.Context, id uint) (*entity.RoomRatePlan, error)
+	CreateRoomRatePlan(ctx context.Context, ratePlan *entity.RoomRatePlan) error
+	UpdateRoomRatePlan(ctx context.Context, ratePlan *entity.RoomRatePlan) error
+	DeleteRoomRatePlan(ctx context.Context, id uint) error
+	GetCancellationPolicies(ctx context.Context) ([]entity.CancellationPolicy, error)
+	GetCancellationPolicyByID(ctx context.Context, id uint) (*entity.CancellationPolicy, error)
+	CreateCancellationPolicy(ctx context.Context, policy *entity.CancellationPolicy) error
+	UpdateCancellationPolicy(ctx context.Context, policy *entity.CancellationPolicy) error
+	// DeleteCancellationPolicy deletes the policy and detaches it from hotels and rate plans.
+	DeleteCancellationPolicy(ctx context.Context, id uint) error
+	// UpdateHotelCancellationPolicy attaches a policy to the hotel, nil detaches it.
+	UpdateHotelCancellationPolicy(ctx context.Context, hotelID uint, policyID *uint) error
+	// UpdateHotelDepositRule sets the deposit percentage and the days before check-in the balance is due.
+	UpdateHotelDepositRule(ctx context.Context, hotelID uint, depositPercent float64, balanceDueDays int) error
 }
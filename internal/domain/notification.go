@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/notifdto"
 	"wtm-backend/internal/repository/filter"
@@ -11,12 +12,47 @@ type NotificationRepository interface {
 	CreateNotification(ctx context.Context, notification *entity.Notification) error
 	ReadNotification(ctx context.Context, ids []uint) error
 	GetNotificationsByUserID(ctx context.Context, filter filter.NotifFilter) ([]entity.Notification, int64, error)
-	DisableNotificationSettingsByChannel(ctx context.Context, userID uint, channel string) error
-	EnableNotificationSettings(ctx context.Context, userID uint, channel string, types []string) error
+	// UpsertNotificationSettings enables or disables each channel and type of the settings, creating the missing ones.
+	UpsertNotificationSettings(ctx context.Context, userID uint, settings []entity.UserNotificationSetting) error
+	// GetNotificationPreference returns nil when the user never saved its digest nor quiet hours.
+	GetNotificationPreference(ctx context.Context, userID uint) (*entity.UserNotificationPreference, error)
+	UpsertNotificationPreference(ctx context.Context, preference *entity.UserNotificationPreference) error
+	CreateDigestItem(ctx context.Context, item *entity.NotificationDigestItem) error
+	// GetDigestPreferences returns the preferences of the users in daily digest mode.
+	GetDigestPreferences(ctx context.Context) ([]entity.UserNotificationPreference, error)
+	// ClaimDigest marks the digest of the user sent at now when its previous one is before scheduled, false when another replica claimed it.
+	ClaimDigest(ctx context.Context, userID uint, scheduled, now time.Time) (bool, error)
+	GetPendingDigestItems(ctx context.Context, userID uint) ([]entity.NotificationDigestItem, error)
+	MarkDigestItemsDigested(ctx context.Context, ids []uint, digestedAt time.Time) error
+	CountUnreadNotifications(ctx context.Context, userID uint) (int64, error)
+	// PublishNotificationEvent pushes the unread count of the user, with the notification when one was created, to its connected clients.
+	PublishNotificationEvent(ctx context.Context, userID uint, notification *entity.Notification) error
+}
+
+// NotificationStream delivers the notification events published by every replica to the clients connected to this one
+type NotificationStream interface {
+	// Subscribe returns the events of the user until unsubscribe is called or the stream is closed.
+	Subscribe(userID uint) (events <-chan entity.NotificationEvent, unsubscribe func())
+}
+
+// Notifier delivers notifications on the channels their recipients enabled for the type of the notification,
+// batched into the daily digest of the recipient or with the emails held until the end of its quiet hours
+type Notifier interface {
+	// NotifyUser delivers the notification to its UserID in the transaction of ctx. email replaces the generic notification email when set.
+	NotifyUser(ctx context.Context, notification entity.Notification, email *entity.EmailLog) error
+	// NotifyUsers delivers the notification to each user in the transaction of ctx, its UserID is set per recipient.
+	NotifyUsers(ctx context.Context, userIDs []uint, notification entity.Notification) error
+	// NotifyPermission delivers the notification in the transaction of ctx to the users whose role holds the permission.
+	NotifyPermission(ctx context.Context, permission string, notification entity.Notification) error
 }
 
 type NotificationUsecase interface {
 	ListNotifications(ctx context.Context, req *notifdto.ListNotificationsRequest) (*notifdto.ListNotificationsResponse, error)
 	ReadNotification(ctx context.Context, req *notifdto.ReadNotificationRequest) error
+	GetNotificationSettings(ctx context.Context) (*notifdto.NotificationSettingsResponse, error)
 	UpdateNotificationSetting(ctx context.Context, req *notifdto.UpdateNotificationSettingRequest) error
+	// SendDueDigests sends the daily digests that are due, returning how many were sent.
+	SendDueDigests(ctx context.Context) (int, error)
+	// StreamNotifications subscribes the user of ctx to its notification events, the caller must close the stream.
+	StreamNotifications(ctx context.Context) (*notifdto.NotificationStream, error)
 }
@@ -27,4 +27,5 @@ type PromoRepository interface {
 	UpdatePromoStatus(ctx context.Context, promoID uint, isActive bool) error
 	DeletePromo(ctx context.Context, promoID uint) error
 	UpdatePromo(ctx context.Context, promo *entity.Promo) error
+	GetPromoAgentIDs(ctx context.Context, promoID uint) ([]uint, error)
 }
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/userdto"
 	"wtm-backend/internal/repository/filter"
@@ -20,6 +21,8 @@ type UserUsecase interface {
 	ListUsers(ctx context.Context, req *userdto.ListUsersRequest) (*userdto.ListUsersResponse, error)
 	UpdateUserByAdmin(ctx context.Context, req *userdto.UpdateUserByAdminRequest) error
 	ListRoleAccess(ctx context.Context) ([]userdto.ListRoleAccessResponse, error)
+	ListTwoFactorPolicies(ctx context.Context) ([]userdto.TwoFactorPolicyResponse, error)
+	UpdateTwoFactorPolicy(ctx context.Context, req *userdto.UpdateTwoFactorPolicyRequest) error
 	UpdateRoleAccess(ctx context.Context, req *userdto.UpdateRoleAccessRequest) error
 	ListStatusUsers(ctx context.Context, req *userdto.ListStatusUsersRequest) (*userdto.ListStatusUsersResponse, int64, error)
 	UpdateStatusUser(ctx context.Context, req *userdto.UpdateStatusUserRequest) error
@@ -27,6 +30,8 @@ type UserUsecase interface {
 
 type UserRepository interface {
 	GetUserByUsername(ctx context.Context, username string) (*entity.User, error)
+	// GetUserWithPermissionsByID returns the user with its status, role and the current permissions of its role.
+	GetUserWithPermissionsByID(ctx context.Context, userID uint) (*entity.User, error)
 	GetUserByID(ctx context.Context, userID uint) (*entity.User, error)
 	CreateUser(ctx context.Context, user *entity.User) (*entity.User, error)
 	UpdateUser(ctx context.Context, user *entity.User) (*entity.User, error)
@@ -34,8 +39,16 @@ type UserRepository interface {
 	GetAgentCompanies(ctx context.Context, search string, limit, page int) ([]entity.AgentCompany, int64, error)
 	GetUsersByAgentCompany(ctx context.Context, agentCompanyID uint, search string, limit, page int) ([]entity.User, int64, error)
 	GetUserByRole(ctx context.Context, roleID uint, search string, limit, page int) ([]entity.User, int64, error)
+	// GetUsersByPermission returns the active users whose role holds the permission, with their notification settings.
+	GetUsersByPermission(ctx context.Context, permission string) ([]entity.User, error)
 	GetUsers(ctx context.Context, filter filter.UserFilter) ([]entity.User, int64, error)
 	BulkUpdatePromoGroupMember(ctx context.Context, memberIDs []uint, promoGroupID uint) error
+	// IncrementFailedLogin counts a wrong password of the user and returns the wrong passwords since the last reset.
+	IncrementFailedLogin(ctx context.Context, userID uint) (int, error)
+	LockUser(ctx context.Context, userID uint, until time.Time) error
+	ResetFailedLogins(ctx context.Context, userID uint) error
+	GetRolesByIDs(ctx context.Context, roleIDs []uint) ([]entity.Role, error)
+	UpdateRoleTwoFactorRequired(ctx context.Context, roleID uint, required bool) error
 	GetAllRolesWithPermissions(ctx context.Context) ([]entity.Role, error)
 	GetAllPermissions(ctx context.Context) ([]entity.Permission, error)
 	GetPermissionByPageAction(ctx context.Context, page, action string) (*entity.Permission, error)
@@ -0,0 +1,41 @@
+package authdto
+
+import validation "github.com/go-ozzo/ozzo-validation"
+
+type CreateAPIKeyRequest struct {
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`          // Permission strings, e.g. booking:view, see available_scopes of the list
+	RateLimit     int      `json:"rate_limit"`      // Requests per minute, the default when empty
+	ExpiresInDays int      `json:"expires_in_days"` // Empty for a key that does not expire
+}
+
+func (r *CreateAPIKeyRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Name, validation.Required.Error("Name is required"), validation.Length(1, 100).Error("Name must be at most 100 characters")),
+		validation.Field(&r.Scopes, validation.Required.Error("At least one scope is required")),
+		validation.Field(&r.RateLimit, validation.Min(0).Error("Rate limit must not be negative")),
+		validation.Field(&r.ExpiresInDays, validation.Min(0).Error("Expires in days must not be negative")),
+	)
+}
+
+type APIKeyResponse struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Prefix     string   `json:"prefix"` // Start of the key, to recognize it
+	Scopes     []string `json:"scopes"`
+	RateLimit  int      `json:"rate_limit"`
+	CreatedAt  string   `json:"created_at"`
+	LastUsedAt string   `json:"last_used_at,omitempty"`
+	LastUsedIP string   `json:"last_used_ip,omitempty"`
+	ExpiresAt  string   `json:"expires_at,omitempty"`
+}
+
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key"` // Shown once, only its hash is stored
+}
+
+type ListAPIKeysResponse struct {
+	APIKeys         []APIKeyResponse `json:"api_keys"`
+	AvailableScopes []string         `json:"available_scopes"` // Scopes the logged in user can grant to a key
+}
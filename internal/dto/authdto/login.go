@@ -9,11 +9,24 @@ import (
 type LoginRequest struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
+
+	// Client of the session, set by the handler from the request
+	Device    string `json:"-"`
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
 }
 
 type LoginResponse struct {
-	Token string   `json:"token"`
-	User  DataUser `json:"user"`
+	Token string    `json:"token,omitempty"`
+	User  *DataUser `json:"user,omitempty"`
+
+	// Set instead of the token when the login needs a TOTP code, see VerifyTwoFactorLoginRequest
+	TwoFactorRequired      bool   `json:"two_factor_required,omitempty"`
+	TwoFactorSetupRequired bool   `json:"two_factor_setup_required,omitempty"` // The role requires two-factor but the user has no authenticator yet
+	ChallengeToken         string `json:"challenge_token,omitempty"`
+
+	// Returned once when the authenticator was enrolled during the login
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
 }
 
 type DataUser struct {
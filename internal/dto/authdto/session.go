@@ -0,0 +1,29 @@
+package authdto
+
+import validation "github.com/go-ozzo/ozzo-validation"
+
+type SessionResponse struct {
+	ID         string `json:"id"`
+	Device     string `json:"device"`
+	IPAddress  string `json:"ip_address"`
+	UserAgent  string `json:"user_agent"`
+	CreatedAt  string `json:"created_at"`
+	LastSeenAt string `json:"last_seen_at"`
+	ExpiresAt  string `json:"expires_at"`
+	IsCurrent  bool   `json:"is_current"` // Session of the request
+}
+
+type ListSessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+// ForceLogoutRequest revokes every session of a user
+type ForceLogoutRequest struct {
+	UserID uint `json:"user_id" form:"user_id"`
+}
+
+func (r *ForceLogoutRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.UserID, validation.Required.Error("User Id is required")),
+	)
+}
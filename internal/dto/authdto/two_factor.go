@@ -0,0 +1,87 @@
+package authdto
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+	"wtm-backend/pkg/totp"
+)
+
+// TwoFactorChallengeRequest identifies a login waiting for its second step
+type TwoFactorChallengeRequest struct {
+	ChallengeToken string `json:"challenge_token"`
+}
+
+func (r *TwoFactorChallengeRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.ChallengeToken, validation.Required.Error("Challenge token is required")),
+	)
+}
+
+// VerifyTwoFactorLoginRequest completes a login with a TOTP code, or a recovery code when the authenticator is lost
+type VerifyTwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challenge_token"`
+	Code           string `json:"code"`
+	RecoveryCode   string `json:"recovery_code"`
+}
+
+func (r *VerifyTwoFactorLoginRequest) Validate() error {
+	var codeRules []validation.Rule
+	if r.RecoveryCode == "" {
+		codeRules = append(codeRules, validation.Required.Error("Code or recovery code is required"))
+	}
+	codeRules = append(codeRules,
+		validation.Length(totp.Digits, totp.Digits).Error("Code must be 6 digits"),
+		is.Digit.Error("Code must be 6 digits"),
+	)
+
+	return validation.ValidateStruct(r,
+		validation.Field(&r.ChallengeToken, validation.Required.Error("Challenge token is required")),
+		validation.Field(&r.Code, codeRules...),
+	)
+}
+
+type TwoFactorSetupResponse struct {
+	Secret          string `json:"secret"`           // Base32 secret for authenticator apps without a camera
+	ProvisioningURI string `json:"provisioning_uri"` // otpauth:// URI to render as QR code
+}
+
+type TwoFactorStatusResponse struct {
+	Enabled                bool  `json:"enabled"`
+	Required               bool  `json:"required"` // The role of the user requires two-factor, it cannot be disabled
+	RecoveryCodesRemaining int64 `json:"recovery_codes_remaining"`
+}
+
+// TwoFactorCodeRequest confirms a two-factor change with a code of the authenticator
+type TwoFactorCodeRequest struct {
+	Code string `json:"code"`
+}
+
+func (r *TwoFactorCodeRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Code,
+			validation.Required.Error("Code is required"),
+			validation.Length(totp.Digits, totp.Digits).Error("Code must be 6 digits"),
+			is.Digit.Error("Code must be 6 digits"),
+		),
+	)
+}
+
+type DisableTwoFactorRequest struct {
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
+
+func (r *DisableTwoFactorRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Password, validation.Required.Error("Password is required")),
+		validation.Field(&r.Code,
+			validation.Required.Error("Code is required"),
+			validation.Length(totp.Digits, totp.Digits).Error("Code must be 6 digits"),
+			is.Digit.Error("Code must be 6 digits"),
+		),
+	)
+}
+
+type TwoFactorRecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"` // Shown once, only their hashes are stored
+}
@@ -0,0 +1,14 @@
+package authdto
+
+import validation "github.com/go-ozzo/ozzo-validation"
+
+// UnlockUserRequest lifts the lockout of a user after too many failed login attempts
+type UnlockUserRequest struct {
+	UserID uint `json:"user_id" form:"user_id"`
+}
+
+func (r *UnlockUserRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.UserID, validation.Required.Error("User Id is required")),
+	)
+}
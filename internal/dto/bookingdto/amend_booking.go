@@ -0,0 +1,54 @@
+package bookingdto
+
+import (
+	"fmt"
+	"time"
+	"wtm-backend/pkg/currency"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// AmendBookingRequest changes a checked out sub-booking, fields left empty keep their current value
+type AmendBookingRequest struct {
+	SubBookingID string `json:"sub_booking_id" uri:"sub_booking_id"`
+	RoomPriceID  uint   `json:"room_price_id"` // Must be a room price of the same hotel
+	CheckInDate  string `json:"check_in_date"`
+	CheckOutDate string `json:"check_out_date"`
+	Quantity     int    `json:"quantity"`
+	Reason       string `json:"reason"` // Optional reason sent to the hotel (max 500 characters)
+}
+
+func (r *AmendBookingRequest) Validate() error {
+	if err := validation.ValidateStruct(r,
+		validation.Field(&r.SubBookingID, validation.Required.Error("Sub Booking ID is required")),
+		validation.Field(&r.CheckInDate, validation.Date(time.DateOnly).Error("Check In Date must be in YYYY-MM-DD format")),
+		validation.Field(&r.CheckOutDate, validation.Date(time.DateOnly).Error("Check Out Date must be in YYYY-MM-DD format")),
+		validation.Field(&r.Quantity, validation.Min(0).Error("Quantity must be at least 1")),
+		validation.Field(&r.Reason, validation.RuneLength(0, 500).Error("Reason must not exceed 500 characters")),
+	); err != nil {
+		return err
+	}
+
+	if r.RoomPriceID == 0 && r.CheckInDate == "" && r.CheckOutDate == "" && r.Quantity == 0 {
+		return validation.Errors{
+			"sub_booking_id": validation.NewInternalError(fmt.Errorf("at least one of room price, check in date, check out date or quantity must be changed")),
+		}
+	}
+
+	return nil
+}
+
+// AmendBookingResponse is the sub-booking after the amendment, amounts in the booking currency
+type AmendBookingResponse struct {
+	SubBookingID  string         `json:"sub_booking_id"`
+	Revision      int            `json:"revision"` // Revision holding the values before this amendment
+	HotelName     string         `json:"hotel_name"`
+	RoomTypeName  string         `json:"room_type_name"`
+	CheckInDate   string         `json:"check_in_date"`
+	CheckOutDate  string         `json:"check_out_date"`
+	Quantity      int            `json:"quantity"`
+	Currency      string         `json:"currency"`
+	Price         currency.Money `json:"price"`       // Room total per room after promo
+	TotalPrice    currency.Money `json:"total_price"` // Rooms and additional services for every room
+	StatusBooking string         `json:"status_booking"`
+}
@@ -0,0 +1,20 @@
+package bookingdto
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type BookingDocumentRequest struct {
+	// The route shares its wildcard with /bookings/:booking_id/sub-ids, gin needs the same name
+	SubBookingID string `json:"sub_booking_id" uri:"booking_id"`
+}
+
+func (r *BookingDocumentRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.SubBookingID, validation.Required.Error("Sub Booking ID is required")))
+}
+
+type BookingDocumentResponse struct {
+	Filename string
+	Content  []byte
+}
@@ -1,6 +1,9 @@
 package bookingdto
 
-import "wtm-backend/internal/dto"
+import (
+	"wtm-backend/internal/dto"
+	"wtm-backend/pkg/currency"
+)
 
 type ListBookingHistoryRequest struct {
 	dto.PaginationRequest `json:",inline"`
@@ -32,8 +35,8 @@ type DetailBookingHistory struct {
 	RoomTypeName       string                     `json:"room_type_name,omitempty"`      // Room type selected
 	IsBreakfast        bool                       `json:"is_breakfast"`                  // Whether breakfast is included
 	BedType            string                     `json:"bed_type,omitempty"`            // Selected bed type
-	RoomPrice          float64                    `json:"room_price"`                    // Room price per night (after promo if any)
-	TotalPrice         float64                    `json:"total_price"`                   // Total price including room and additional services
+	RoomPrice          currency.Money             `json:"room_price"`                    // Room price per night (after promo if any)
+	TotalPrice         currency.Money             `json:"total_price"`                   // Total price including room and additional services
 	Currency           string                     `json:"currency,omitempty"`            // Currency code for prices
 	CheckInDate        string                     `json:"check_in_date,omitempty"`       // Check-in date
 	CheckOutDate       string                     `json:"check_out_date,omitempty"`      // Check-out date
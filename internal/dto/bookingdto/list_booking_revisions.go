@@ -0,0 +1,39 @@
+package bookingdto
+
+import (
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/currency"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type ListBookingRevisionsRequest struct {
+	SubBookingID string `json:"sub_booking_id" uri:"sub_booking_id"`
+}
+
+func (r *ListBookingRevisionsRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.SubBookingID, validation.Required.Error("Sub Booking ID is required")))
+}
+
+type ListBookingRevisionsResponse struct {
+	Revisions []BookingRevision `json:"revisions"`
+}
+
+// BookingRevision holds the values a sub-booking had before an amendment
+type BookingRevision struct {
+	Revision      int                `json:"revision"`
+	RoomPriceID   uint               `json:"room_price_id"`
+	HotelName     string             `json:"hotel_name"`
+	RoomTypeName  string             `json:"room_type_name"`
+	CheckInDate   string             `json:"check_in_date"`
+	CheckOutDate  string             `json:"check_out_date"`
+	Quantity      int                `json:"quantity"`
+	Promo         entity.DetailPromo `json:"promo"`
+	Price         currency.Money     `json:"price"`
+	Currency      string             `json:"currency"`
+	StatusBooking string             `json:"status_booking"`
+	Reason        string             `json:"reason"`
+	AmendedBy     string             `json:"amended_by"`
+	AmendedAt     string             `json:"amended_at"`
+}
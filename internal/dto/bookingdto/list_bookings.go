@@ -3,6 +3,7 @@ package bookingdto
 import (
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto"
+	"wtm-backend/pkg/currency"
 )
 
 type ListBookingsRequest struct {
@@ -34,14 +35,14 @@ type DetailBooking struct {
 	GuestName string `json:"guest_name"`
 	HotelName string `json:"hotel_name"`
 	// Room information (aligned with DetailBookingHistory / SubBookingDetail)
-	RoomTypeName string  `json:"room_type_name,omitempty"` // Room type selected
-	IsBreakfast  bool    `json:"is_breakfast"`             // Whether breakfast is included
-	BedType      string  `json:"bed_type,omitempty"`       // Selected bed type
-	RoomPrice    float64 `json:"room_price,omitempty"`     // Room price per night (after promo if any)
-	TotalPrice   float64 `json:"total_price,omitempty"`    // Total price including room and services
-	Currency     string  `json:"currency,omitempty"`       // Currency code for prices
-	CheckInDate  string  `json:"check_in_date,omitempty"`  // Check-in date
-	CheckOutDate string  `json:"check_out_date,omitempty"` // Check-out date
+	RoomTypeName string          `json:"room_type_name,omitempty"` // Room type selected
+	IsBreakfast  bool            `json:"is_breakfast"`             // Whether breakfast is included
+	BedType      string          `json:"bed_type,omitempty"`       // Selected bed type
+	RoomPrice    *currency.Money `json:"room_price,omitempty"`     // Room price per night (after promo if any)
+	TotalPrice   *currency.Money `json:"total_price,omitempty"`    // Total price including room and services
+	Currency     string          `json:"currency,omitempty"`       // Currency code for prices
+	CheckInDate  string          `json:"check_in_date,omitempty"`  // Check-in date
+	CheckOutDate string          `json:"check_out_date,omitempty"` // Check-out date
 
 	// Additional services & preferences
 	Additional         []string                   `json:"additional"`                    // Deprecated: use AdditionalServices for detailed info
@@ -61,4 +62,7 @@ type DetailBooking struct {
 	AdditionalNotes string      `json:"additional_notes,omitempty"` // Notes from agent to admin
 	AdminNotes      string      `json:"admin_notes,omitempty"`      // Notes from admin to agent
 	Invoice         DataInvoice `json:"invoice,omitempty"`
+
+	HotelConfirmationNumber string `json:"hotel_confirmation_number,omitempty"` // From the reply of the hotel to the booking email
+
 }
@@ -2,14 +2,15 @@ package bookingdto
 
 import (
 	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/currency"
 )
 
 type ListCartResponse struct {
-	ID         uint         `json:"id"`
-	Detail     []CartDetail `json:"detail"`
-	Guest      []CartGuest  `json:"guest"`
-	GrandTotal float64      `json:"grand_total"`
-	Currency   string       `json:"currency,omitempty"` // Currency code for the cart (from first detail or agent's currency)
+	ID         uint           `json:"id"`
+	Detail     []CartDetail   `json:"detail"`
+	Guest      []CartGuest    `json:"guest"`
+	GrandTotal currency.Money `json:"grand_total"`
+	Currency   string         `json:"currency,omitempty"` // Currency code for the cart (from first detail or agent's currency)
 }
 
 type CartGuest struct {
@@ -20,28 +21,31 @@ type CartGuest struct {
 }
 
 type CartDetail struct {
-	ID                   uint                   `json:"id"`
-	Photo                string                 `json:"photo"`
-	HotelName            string                 `json:"hotel_name"`
-	HotelRating          int                    `json:"hotel_rating"`
-	CheckInDate          string                 `json:"check_in_date"`
-	CheckOutDate         string                 `json:"check_out_date"`
-	RoomTypeName         string                 `json:"room_type_name"`
-	IsBreakfast          bool                   `json:"is_breakfast"`
-	Guest                string                 `json:"guest"`
-	BedType              string                 `json:"bed_type,omitempty"`  // Selected bed type (singular) - REQUIRED
-	BedTypes             []string               `json:"bed_types,omitempty"` // Available bed types for reference (plural) - OPTIONAL
-	OtherPreferences     []string               `json:"other_preferences"`
-	Additional           []CartDetailAdditional `json:"additional"`
-	AdditionalNotes      string                 `json:"additional_notes,omitempty"` // Notes from agent to admin
-	AdminNotes           string                 `json:"admin_notes,omitempty"`      // Notes from admin to agent
-	Promo                entity.DetailPromo     `json:"promo"`
-	CancellationDate     string                 `json:"cancellation_date,omitempty"`
-	Price                float64                `json:"price"`
-	PriceBeforePromo     float64                `json:"price_before_promo"`
-	TotalAdditionalPrice float64                `json:"total_additional_price"`
-	TotalPrice           float64                `json:"total_price"`
-	Currency             string                 `json:"currency,omitempty"` // Currency code for this cart item (snapshot at booking time)
+	ID                   uint                       `json:"id"`
+	Photo                string                     `json:"photo"`
+	HotelName            string                     `json:"hotel_name"`
+	HotelRating          int                        `json:"hotel_rating"`
+	CheckInDate          string                     `json:"check_in_date"`
+	CheckOutDate         string                     `json:"check_out_date"`
+	RoomTypeName         string                     `json:"room_type_name"`
+	IsBreakfast          bool                       `json:"is_breakfast"`
+	Guest                string                     `json:"guest"`
+	BedType              string                     `json:"bed_type,omitempty"`  // Selected bed type (singular) - REQUIRED
+	BedTypes             []string                   `json:"bed_types,omitempty"` // Available bed types for reference (plural) - OPTIONAL
+	OtherPreferences     []string                   `json:"other_preferences"`
+	Additional           []CartDetailAdditional     `json:"additional"`
+	AdditionalNotes      string                     `json:"additional_notes,omitempty"` // Notes from agent to admin
+	AdminNotes           string                     `json:"admin_notes,omitempty"`      // Notes from admin to agent
+	Promo                entity.DetailPromo         `json:"promo"`
+	CancellationDate     string                     `json:"cancellation_date,omitempty"` // Last date of free cancellation, empty when non-refundable
+	CancellationPolicy   *entity.CancellationPolicy `json:"cancellation_policy,omitempty"`
+	Price                currency.Money             `json:"price"`
+	PriceBeforePromo     currency.Money             `json:"price_before_promo"`
+	TotalAdditionalPrice currency.Money             `json:"total_additional_price"`
+	TotalPrice           currency.Money             `json:"total_price"`
+	Currency             string                     `json:"currency,omitempty"`        // Currency code for this cart item (snapshot at booking time)
+	HoldExpiresAt        string                     `json:"hold_expires_at,omitempty"` // When the room hold expires (RFC3339), empty once expired
+	IsHoldExpired        bool                       `json:"is_hold_expired"`           // Availability is re-validated on checkout when true
 }
 
 type CartDetailAdditional struct {
@@ -0,0 +1,54 @@
+package bookingdto
+
+import (
+	"fmt"
+	"strings"
+	"wtm-backend/pkg/currency"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type ListPaymentsRequest struct {
+	BookingID    string `json:"booking_id" form:"booking_id"`
+	SubBookingID string `json:"sub_booking_id" form:"sub_booking_id"`
+}
+
+func (r *ListPaymentsRequest) Validate() error {
+	if strings.TrimSpace(r.BookingID) == "" && strings.TrimSpace(r.SubBookingID) == "" {
+		return validation.Errors{
+			"booking_id":     validation.NewInternalError(fmt.Errorf("either booking_id or sub_booking_id must be provided")),
+			"sub_booking_id": validation.NewInternalError(fmt.Errorf("either booking_id or sub_booking_id must be provided")),
+		}
+	}
+	return nil
+}
+
+type ListPaymentsResponse struct {
+	Payments []Payment      `json:"payments"`
+	Balance  BookingBalance `json:"balance"`
+}
+
+// BookingBalance is the payment position of a booking, the amounts are in the booking currency
+type BookingBalance struct {
+	BookingID          string              `json:"booking_id"`
+	Currency           string              `json:"currency"`
+	Due                currency.Money      `json:"due"`
+	Paid               currency.Money      `json:"paid"`
+	Outstanding        currency.Money      `json:"outstanding"`
+	Credit             currency.Money      `json:"credit"` // Paid on the booking beyond what is due
+	StatusPayment      string              `json:"status_payment"`
+	SubBookingBalances []SubBookingBalance `json:"sub_booking_balances"`
+}
+
+// SubBookingBalance is the payment position of a sub-booking
+type SubBookingBalance struct {
+	SubBookingID        string         `json:"sub_booking_id"`
+	Due                 currency.Money `json:"due"` // Booking total, the penalty once cancelled, zero once rejected
+	Paid                currency.Money `json:"paid"`
+	Outstanding         currency.Money `json:"outstanding"`
+	Deposit             currency.Money `json:"deposit"`
+	DepositOutstanding  currency.Money `json:"deposit_outstanding"`
+	BalanceDueDate      string         `json:"balance_due_date"`
+	StatusPayment       string         `json:"status_payment"`
+	StatusPaymentManual bool           `json:"status_payment_manual"` // Set by an admin by hand, not from the payments
+}
@@ -0,0 +1,12 @@
+package bookingdto
+
+// ProcessInboundEmailResponse is what the webhook did with a reply
+type ProcessInboundEmailResponse struct {
+	ID                 string   `json:"id"`
+	Status             string   `json:"status"` // unmatched, received, attached or applied
+	Action             string   `json:"action"` // confirm, reject or none
+	BookingCode        string   `json:"booking_code,omitempty"`
+	SubBookingIDs      []string `json:"sub_booking_ids,omitempty"`
+	ConfirmationNumber string   `json:"confirmation_number,omitempty"`
+	Duplicate          bool     `json:"duplicate"` // The reply was already received, nothing was done
+}
@@ -0,0 +1,44 @@
+package bookingdto
+
+import (
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/currency"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type QuoteBookingRequest struct {
+	RoomPriceID           uint   `json:"room_price_id"`
+	CheckInDate           string `json:"check_in_date"`
+	CheckOutDate          string `json:"check_out_date"`
+	Quantity              int    `json:"quantity"`
+	PromoID               uint   `json:"promo_id"`
+	RoomTypeAdditionalIDs []uint `json:"room_type_additional_ids"`
+}
+
+func (r *QuoteBookingRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.RoomPriceID, validation.Required.Error("Room Price Id is required")),
+		validation.Field(&r.CheckInDate, validation.Required.Error("Check In Date is required"), validation.Date(time.DateOnly).Error("Check In Date must be in YYYY-MM-DD format")),
+		validation.Field(&r.CheckOutDate, validation.Required.Error("Check Out Date is required"), validation.Date(time.DateOnly).Error("Check Out Date must be in YYYY-MM-DD format")),
+		validation.Field(&r.Quantity, validation.Required.Error("Quantity is required"), validation.Min(1).Error("Quantity must be at least 1")),
+	)
+}
+
+// QuoteBookingResponse is the price breakdown in the agent's currency, amounts are per room unless stated otherwise
+type QuoteBookingResponse struct {
+	Currency             string                      `json:"currency"`
+	Nights               int                         `json:"nights"`
+	Quantity             int                         `json:"quantity"`
+	Items                []entity.DescriptionInvoice `json:"items"` // invoice lines of one room, the room line first
+	Promo                entity.DetailPromo          `json:"promo"`
+	RoomTotalBeforePromo currency.Money              `json:"room_total_before_promo"`
+	RoomTotal            currency.Money              `json:"room_total"`
+	AdditionalTotal      currency.Money              `json:"additional_total"`
+	TotalPerRoom         currency.Money              `json:"total_per_room"`
+	GrandTotal           currency.Money              `json:"grand_total"`                    // total for every room of quantity
+	ExchangeRate         float64                     `json:"exchange_rate,omitempty"`        // IDR per 1 unit of Currency
+	ExchangeRateMarkup   float64                     `json:"exchange_rate_markup,omitempty"` // Markup percentage
+	ExchangeRateDate     string                      `json:"exchange_rate_date,omitempty"`   // Effective date of the rate
+}
@@ -0,0 +1,76 @@
+package bookingdto
+
+import (
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"strings"
+	"time"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/currency"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type RecordPaymentRequest struct {
+	BookingID    string                `json:"booking_id" form:"booking_id"`         // Booking code, for a payment on the whole booking
+	SubBookingID string                `json:"sub_booking_id" form:"sub_booking_id"` // Sub booking code, for a payment on a single sub-booking
+	Amount       float64               `json:"amount" form:"amount"`                 // Negative for refunds
+	Currency     string                `json:"currency" form:"currency"`             // Defaults to the booking currency
+	Method       string                `json:"method" form:"method"`
+	Reference    string                `json:"reference" form:"reference"`
+	PaidAt       string                `json:"paid_at" form:"paid_at"` // YYYY-MM-DD, defaults to today
+	Notes        string                `json:"notes" form:"notes"`
+	Receipt      *multipart.FileHeader `json:"receipt" form:"receipt"`
+}
+
+func (r *RecordPaymentRequest) Validate() error {
+	if err := validation.ValidateStruct(r,
+		validation.Field(&r.Amount, validation.Required.Error("Amount is required"), validation.By(validMoneyAmount)),
+		validation.Field(&r.Currency, validation.Length(3, 3).Error("Currency must be a 3 letter code")),
+		validation.Field(&r.Method, validation.Required.Error("Method is required"),
+			validation.In(constant.PaymentMethodBankTransfer, constant.PaymentMethodCreditCard, constant.PaymentMethodCash, constant.PaymentMethodOther).Error(fmt.Sprintf("Method must be one of: %s", strings.Join(constant.PaymentMethods, ", ")))),
+		validation.Field(&r.Reference, validation.Length(0, 255)),
+		validation.Field(&r.PaidAt, validation.Date(time.DateOnly).Error("Paid at must be in YYYY-MM-DD format")),
+	); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(r.BookingID) == "" && strings.TrimSpace(r.SubBookingID) == "" {
+		return validation.Errors{
+			"booking_id":     validation.NewInternalError(fmt.Errorf("either booking_id or sub_booking_id must be provided")),
+			"sub_booking_id": validation.NewInternalError(fmt.Errorf("either booking_id or sub_booking_id must be provided")),
+		}
+	}
+
+	return nil
+}
+
+// validMoneyAmount rejects amounts that can't be held as money, such as NaN or infinite
+func validMoneyAmount(value interface{}) error {
+	amount, _ := value.(float64)
+	if _, err := currency.NewMoney(amount, ""); err != nil {
+		return errors.New("Amount is not a valid amount")
+	}
+	return nil
+}
+
+type RecordPaymentResponse struct {
+	Payment Payment        `json:"payment"`
+	Balance BookingBalance `json:"balance"`
+}
+
+// Payment is an entry of the payments ledger
+type Payment struct {
+	ID           uint           `json:"id"`
+	SubBookingID string         `json:"sub_booking_id"` // Empty when paid on the whole booking
+	Amount       currency.Money `json:"amount"`
+	Currency     string         `json:"currency"`
+	Method       string         `json:"method"`
+	Reference    string         `json:"reference"`
+	ReceiptUrl   string         `json:"receipt_url"`
+	Notes        string         `json:"notes"`
+	PaidAt       string         `json:"paid_at"`
+	RecordedBy   string         `json:"recorded_by"`
+	RecordedAt   string         `json:"recorded_at"`
+}
@@ -0,0 +1,58 @@
+package currencydto
+
+import (
+	"fmt"
+	"time"
+	"wtm-backend/internal/domain/entity"
+)
+
+type ListExchangeRatesRequest struct {
+	CurrencyCode string `form:"currency_code"`
+}
+
+type ExchangeRateResponse struct {
+	ID            uint    `json:"id"`
+	ExternalID    string  `json:"external_id"`
+	CurrencyCode  string  `json:"currency_code"`
+	Rate          float64 `json:"rate"`           // IDR per 1 unit of currency
+	MarkupPercent float64 `json:"markup_percent"` // Added on top of the converted price
+	EffectiveDate string  `json:"effective_date"` // YYYY-MM-DD
+}
+
+type CreateExchangeRateRequest struct {
+	CurrencyCode  string  `json:"currency_code" binding:"required,min=3,max=3"`
+	Rate          float64 `json:"rate" binding:"required,gt=0"`
+	MarkupPercent float64 `json:"markup_percent" binding:"gte=0,lte=100"`
+	EffectiveDate string  `json:"effective_date" binding:"required"` // YYYY-MM-DD
+}
+
+type UpdateExchangeRateRequest struct {
+	Rate          float64 `json:"rate" binding:"required,gt=0"`
+	MarkupPercent float64 `json:"markup_percent" binding:"gte=0,lte=100"`
+	EffectiveDate string  `json:"effective_date" binding:"required"` // YYYY-MM-DD
+}
+
+func ToExchangeRateResponse(exchangeRate *entity.ExchangeRate) ExchangeRateResponse {
+	return ExchangeRateResponse{
+		ID:            exchangeRate.ID,
+		ExternalID:    exchangeRate.ExternalID,
+		CurrencyCode:  exchangeRate.CurrencyCode,
+		Rate:          exchangeRate.Rate,
+		MarkupPercent: exchangeRate.MarkupPercent,
+		EffectiveDate: exchangeRate.EffectiveDate.Format(time.DateOnly),
+	}
+}
+
+func ToExchangeRateEntity(req *CreateExchangeRateRequest) (*entity.ExchangeRate, error) {
+	effectiveDate, err := time.Parse(time.DateOnly, req.EffectiveDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid effective date, must be YYYY-MM-DD")
+	}
+
+	return &entity.ExchangeRate{
+		CurrencyCode:  req.CurrencyCode,
+		Rate:          req.Rate,
+		MarkupPercent: req.MarkupPercent,
+		EffectiveDate: effectiveDate,
+	}, nil
+}
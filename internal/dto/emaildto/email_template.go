@@ -1,19 +1,39 @@
 package emaildto
 
-import validation "github.com/go-ozzo/ozzo-validation"
+import (
+	"fmt"
+	"strings"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/utils"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
 
 type EmailTemplateRequest struct {
-	Type string `json:"type" form:"type"`
+	Type   string `json:"type" form:"type"`
+	Locale string `json:"locale" form:"locale"` // Language variant, the default locale when empty
 }
 
 func (e *EmailTemplateRequest) Validate() error {
 	return validation.ValidateStruct(e,
-		validation.Field(&e.Type, validation.In("", "confirm", "cancel")),
+		validation.Field(&e.Type, templateTypeRule()),
+		validation.Field(&e.Locale, utils.IsLocale()),
 	)
 }
 
+// templateTypeRule accepts the template types of constant.EmailTemplateTypes, empty means hotel booking request
+func templateTypeRule() validation.Rule {
+	types := make([]interface{}, 0, len(constant.EmailTemplateTypes))
+	for _, t := range constant.EmailTemplateTypes {
+		types = append(types, t)
+	}
+	return validation.In(types...).Error(fmt.Sprintf("Type must be one of: %s", strings.Join(constant.EmailTemplateTypes, ", ")))
+}
+
 type EmailTemplateResponse struct {
-	Subject   string `json:"subject"`
-	Body      string `json:"body"`
-	Signature string `json:"signature"`
+	Locale    string   `json:"locale"` // Language of the variant returned, the default locale when the requested one has no variant
+	Subject   string   `json:"subject"`
+	Body      string   `json:"body"`
+	Signature string   `json:"signature"`
+	Locales   []string `json:"locales"` // Languages the template has a variant in
 }
@@ -0,0 +1,64 @@
+package emaildto
+
+import (
+	"wtm-backend/pkg/utils"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// EmailTemplateVersionResponse represents a saved version of an email template
+type EmailTemplateVersionResponse struct {
+	Version          int    `json:"version"`
+	Subject          string `json:"subject"`
+	Body             string `json:"body"`
+	IsSignatureImage bool   `json:"is_signature_image"`
+	Signature        string `json:"signature"`
+	AuthorName       string `json:"author_name"`
+	Note             string `json:"note"`
+	CreatedAt        string `json:"created_at"`
+}
+
+// RollbackEmailTemplateRequest restores a version of a template, saved as a new version
+type RollbackEmailTemplateRequest struct {
+	Type    string `json:"type"`
+	Locale  string `json:"locale"`
+	Version int    `json:"version"`
+}
+
+func (r *RollbackEmailTemplateRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Type, templateTypeRule()),
+		validation.Field(&r.Locale, utils.IsLocale()),
+		validation.Field(&r.Version, validation.Required.Error("Version is required"), validation.Min(1)),
+	)
+}
+
+// PreviewEmailTemplateRequest selects the template version to render, the current template when version is 0
+type PreviewEmailTemplateRequest struct {
+	Type    string `json:"type"`
+	Locale  string `json:"locale"`
+	Version int    `json:"version"`
+}
+
+func (r *PreviewEmailTemplateRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Type, templateTypeRule()),
+		validation.Field(&r.Locale, utils.IsLocale()),
+		validation.Field(&r.Version, validation.Min(0)),
+	)
+}
+
+// PreviewEmailTemplateResponse is a template rendered against the sample data of its type
+type PreviewEmailTemplateResponse struct {
+	Name    string `json:"name"`
+	Locale  string `json:"locale"`  // Language of the variant rendered
+	Version int    `json:"version"` // 0 when the template has no versions yet
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// TestSendEmailTemplateResponse tells where the test email was sent
+type TestSendEmailTemplateResponse struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+}
@@ -1,7 +1,12 @@
 package emaildto
 
 import (
+	"fmt"
+	"time"
 	"wtm-backend/internal/dto"
+	"wtm-backend/pkg/constant"
+
+	validation "github.com/go-ozzo/ozzo-validation"
 )
 
 type ListEmailLogsRequest struct {
@@ -42,11 +47,49 @@ type EmailLogDetailResponse struct {
 	Notes     string `json:"notes"`
 }
 
+// RetryEmailRequest retries a single email by ID, or every email matching the filter
 type RetryEmailRequest struct {
-	ID uint `json:"id" binding:"required"`
+	ID     uint              `json:"id"`
+	Filter *RetryEmailFilter `json:"filter"`
+}
+
+// RetryEmailFilter selects the emails of a bulk retry, with the same fields as the email log list
+type RetryEmailFilter struct {
+	Status      []string `json:"status"`     // Failed (default) or Pending
+	EmailType   []string `json:"email_type"` // Template names, e.g. hotel_booking_request
+	HotelName   string   `json:"hotel_name"`
+	BookingCode string   `json:"booking_code"`
+	DateFrom    string   `json:"date_from"`
+	DateTo      string   `json:"date_to"`
+}
+
+func (r *RetryEmailRequest) Validate() error {
+	if r.ID == 0 && r.Filter == nil {
+		return validation.Errors{
+			"id":     validation.NewInternalError(fmt.Errorf("either id or filter must be provided")),
+			"filter": validation.NewInternalError(fmt.Errorf("either id or filter must be provided")),
+		}
+	}
+	if r.Filter == nil {
+		return nil
+	}
+
+	for _, status := range r.Filter.Status {
+		if status != constant.StatusEmailFailed && status != constant.StatusEmailPending {
+			return validation.Errors{
+				"status": validation.NewInternalError(fmt.Errorf("status must be one of: %s, %s", constant.StatusEmailFailed, constant.StatusEmailPending)),
+			}
+		}
+	}
+
+	return validation.ValidateStruct(r.Filter,
+		validation.Field(&r.Filter.DateFrom, validation.Date(time.DateOnly).Error("Date from must be in YYYY-MM-DD format")),
+		validation.Field(&r.Filter.DateTo, validation.Date(time.DateOnly).Error("Date to must be in YYYY-MM-DD format")),
+	)
 }
 
 type RetryEmailResponse struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
+	Queued  int    `json:"queued"`
 }
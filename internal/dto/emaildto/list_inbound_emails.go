@@ -0,0 +1,39 @@
+package emaildto
+
+import (
+	"wtm-backend/internal/dto"
+	"wtm-backend/pkg/constant"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type ListInboundEmailsRequest struct {
+	dto.PaginationRequest `json:",inline"`
+	BookingCode           string `form:"booking_code" json:"booking_code"`
+	Status                string `form:"status" json:"status"`
+}
+
+func (r *ListInboundEmailsRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Status, validation.In(constant.InboundEmailUnmatched, constant.InboundEmailReceived, constant.InboundEmailAttached, constant.InboundEmailApplied).Error("Invalid status")))
+}
+
+type ListInboundEmailsResponse struct {
+	InboundEmails []InboundEmailResponse `json:"inbound_emails"`
+	Total         int64                  `json:"total"`
+}
+
+// InboundEmailResponse is a reply of a hotel to a booking email
+type InboundEmailResponse struct {
+	ID                 string   `json:"id"`
+	DateTime           string   `json:"date_time"`
+	From               string   `json:"from"`
+	Subject            string   `json:"subject"`
+	Body               string   `json:"body"`
+	BookingCode        string   `json:"booking_code,omitempty"`
+	SubBookingIDs      []string `json:"sub_booking_ids,omitempty"`
+	Action             string   `json:"action"`
+	ConfirmationNumber string   `json:"confirmation_number,omitempty"`
+	Status             string   `json:"status"`
+	Note               string   `json:"note,omitempty"`
+}
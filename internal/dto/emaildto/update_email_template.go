@@ -2,6 +2,7 @@ package emaildto
 
 import (
 	"mime/multipart"
+	"wtm-backend/pkg/utils"
 
 	validation "github.com/go-ozzo/ozzo-validation"
 )
@@ -9,15 +10,19 @@ import (
 // UpdateEmailTemplateRequest represents the request to update an email template
 type UpdateEmailTemplateRequest struct {
 	Type           string                `json:"type" form:"type"`
+	Locale         string                `json:"locale" form:"locale"` // Language variant, created from the default locale when missing
 	Subject        string                `json:"subject" form:"subject"`
 	Body           string                `json:"body" form:"body"`
 	SignatureText  string                `json:"signature_text" form:"signature_text"`
 	SignatureImage *multipart.FileHeader `json:"signature_image" form:"signature_image"`
+	Note           string                `json:"note" form:"note"` // Optional change note kept with the version
 }
 
 func (r *UpdateEmailTemplateRequest) Validate() error {
 
 	return validation.ValidateStruct(r,
+		validation.Field(&r.Type, templateTypeRule()),
+		validation.Field(&r.Locale, utils.IsLocale()),
 		validation.Field(&r.Body, validation.Required.Error("Template body is required")),
 	)
 }
@@ -25,7 +25,7 @@ type AddRoomTypeRequest struct {
 	OtherPreferences       string                  `json:"other_preferences" form:"other_preferences"`
 	Description            string                  `json:"description" form:"description"`
 	BookingLimitPerBooking *int                    `json:"booking_limit_per_booking,omitempty" form:"booking_limit_per_booking"` // Maximum number of rooms that can be booked per booking (nil = no limit)
-	//TotalUnit        int                     `json:"total_unit" form:"total_unit"`
+	TotalUnit              int                     `json:"total_unit" form:"total_unit"`                                         // Number of physical rooms of this type (defaults to 1)
 }
 
 func (r *AddRoomTypeRequest) Validate() error {
@@ -36,6 +36,7 @@ func (r *AddRoomTypeRequest) Validate() error {
 		validation.Field(&r.RoomSize, validation.Required.Error("Room Size is required")),
 		validation.Field(&r.MaxOccupancy, validation.Required.Error("Max Occupancy is required")),
 		validation.Field(&r.BedTypes, validation.Required.Error("Bed Types is required")),
+		validation.Field(&r.TotalUnit, validation.Min(0).Error("Total Unit must not be negative")),
 	); err != nil {
 		return err
 	}
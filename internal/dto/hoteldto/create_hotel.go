@@ -14,6 +14,7 @@ type CreateHotelRequest struct {
 	SubDistrict  string                  `json:"sub_district" form:"sub_district"`
 	District     string                  `json:"district" form:"district"`
 	Email        string                  `json:"email" form:"email"`
+	Language     string                  `json:"language" form:"language"` // Preferred language of emails: en, id or ko
 	Province     string                  `json:"province" form:"province"`
 	Description  string                  `json:"description" form:"description"`
 	Rating       int                     `json:"rating" form:"rating"`
@@ -46,6 +47,7 @@ func (r *CreateHotelRequest) Validate() error {
 		validation.Field(&r.SubDistrict, validation.Required.Error("Sub-district is required"), utils.NotEmptyAfterTrim("Sub-Cities")),
 		validation.Field(&r.District, validation.Required.Error("Cities is required"), utils.NotEmptyAfterTrim("Cities")),
 		validation.Field(&r.Province, validation.Required.Error("Province is required"), utils.NotEmptyAfterTrim("Province")),
+		validation.Field(&r.Language, utils.IsLocale()),
 	); err != nil {
 		return err
 	}
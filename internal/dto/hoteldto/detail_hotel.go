@@ -15,14 +15,18 @@ type DetailHotelResponse struct {
 	Photos      []string             `json:"photos"`
 	Rating      int                  `json:"rating"`
 	Email       string               `json:"email"`
+	Language    string               `json:"language"`
 	Facilities  []string             `json:"facilities"`
 	NearbyPlace []entity.NearbyPlace `json:"nearby_place"`
 	SocialMedia []SocialMedia        `json:"social_media"`
 	RoomType    []DetailRoomType     `json:"room_type"`
 
-	CancellationPeriod int    `json:"cancellation_period"`
-	CheckInHour        string `json:"check_in_hour"`
-	CheckOutHour       string `json:"check_out_hour"`
+	CancellationPeriod   int     `json:"cancellation_period"`
+	CancellationPolicyID *uint   `json:"cancellation_policy_id"` // Overrides cancellation_period when set
+	DepositPercent       float64 `json:"deposit_percent"`
+	BalanceDueDays       int     `json:"balance_due_days"`
+	CheckInHour          string  `json:"check_in_hour"`
+	CheckOutHour         string  `json:"check_out_hour"`
 }
 
 type DetailRoomType struct {
@@ -39,5 +43,5 @@ type DetailRoomType struct {
 	Description            string                               `json:"description"`
 	Photos                 []string                             `json:"photos"`
 	BookingLimitPerBooking *int                                 `json:"booking_limit_per_booking,omitempty"` // Maximum number of rooms that can be booked per booking (nil = no limit)
-	//TotalUnit        int                           `json:"total_unit"`
+	TotalUnit              int                                  `json:"total_unit"`
 }
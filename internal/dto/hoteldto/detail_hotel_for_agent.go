@@ -2,6 +2,12 @@ package hoteldto
 
 import "wtm-backend/internal/domain/entity"
 
+type DetailHotelForAgentRequest struct {
+	HotelID  uint   `json:"-" form:"-"`
+	DateFrom string `json:"from" form:"from"` // Check-in date (YYYY-MM-DD), prices use the effective rate when set with to
+	DateTo   string `json:"to" form:"to"`     // Check-out date (YYYY-MM-DD)
+}
+
 type DetailHotelForAgentResponse struct {
 	ID          uint                     `json:"id"`
 	Name        string                   `json:"name"`
@@ -17,9 +23,12 @@ type DetailHotelForAgentResponse struct {
 	SocialMedia []SocialMedia            `json:"social_media"`
 	RoomType    []DetailRoomTypeForAgent `json:"room_type"`
 
-	CancellationPeriod int    `json:"cancellation_period"`
-	CheckInHour        string `json:"check_in_hour"`
-	CheckOutHour       string `json:"check_out_hour"`
+	CancellationPeriod int                        `json:"cancellation_period"`
+	CancellationPolicy *entity.CancellationPolicy `json:"cancellation_policy,omitempty"` // Hotel policy, rate plans may override it
+	DepositPercent     float64                    `json:"deposit_percent"`               // Share of the booking total due as deposit, 0 = full payment
+	BalanceDueDays     int                        `json:"balance_due_days"`              // Days before check-in the balance is due
+	CheckInHour        string                     `json:"check_in_hour"`
+	CheckOutHour       string                     `json:"check_out_hour"`
 }
 
 type NearbyPlaceForAgent struct {
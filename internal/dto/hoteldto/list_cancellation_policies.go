@@ -0,0 +1,7 @@
+package hoteldto
+
+import "wtm-backend/internal/domain/entity"
+
+type ListCancellationPoliciesResponse struct {
+	CancellationPolicies []entity.CancellationPolicy `json:"cancellation_policies"`
+}
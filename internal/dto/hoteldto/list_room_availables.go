@@ -18,12 +18,21 @@ type ListRoomAvailableResponse struct {
 type RoomAvailable struct {
 	RoomTypeID   uint            `json:"room_type_id"`
 	RoomTypeName string          `json:"room_type_name"`
+	TotalUnit    int             `json:"total_unit"`
 	Data         []DataAvailable `json:"available"`
 }
 
 type DataAvailable struct {
-	Day       int  `json:"day"`
-	Available bool `json:"available"`
+	Day           int  `json:"day"`
+	Available     bool `json:"available"`
+	RemainingUnit int  `json:"remaining_unit"` // Units left to sell for the night, ignored on update
+
+	// Stay restrictions of the date, zero values mean unrestricted
+	MinStay           int  `json:"min_stay"`            // Minimum nights for stays arriving on this date
+	MaxStay           int  `json:"max_stay"`            // Maximum nights for stays arriving on this date
+	ClosedToArrival   bool `json:"closed_to_arrival"`   // No check-in on this date
+	ClosedToDeparture bool `json:"closed_to_departure"` // No check-out on this date
+	ReleaseDays       int  `json:"release_days"`        // Stays arriving on this date must be booked at least this many days ahead
 }
 
 func (r *ListRoomAvailableRequest) Validate() error {
@@ -0,0 +1,30 @@
+package hoteldto
+
+import validation "github.com/go-ozzo/ozzo-validation"
+
+type ListRoomRatePlanRequest struct {
+	RoomTypeID uint `json:"room_type_id" form:"room_type_id"`
+}
+
+type ListRoomRatePlanResponse struct {
+	RatePlans []RoomRatePlan `json:"rate_plans"`
+}
+
+type RoomRatePlan struct {
+	ID          uint               `json:"id"`
+	RoomPriceID uint               `json:"room_price_id"`
+	IsBreakfast bool               `json:"is_breakfast"`
+	Name        string             `json:"name"`
+	StartDate   string             `json:"start_date"` // YYYY-MM-DD
+	EndDate     string             `json:"end_date"`   // YYYY-MM-DD, inclusive
+	DaysOfWeek  []int              `json:"days_of_week"`
+	Prices      map[string]float64 `json:"prices"`
+	Priority    int                `json:"priority"`
+	IsActive    bool               `json:"is_active"`
+
+	CancellationPolicyID *uint `json:"cancellation_policy_id"`
+}
+
+func (r *ListRoomRatePlanRequest) Validate() error {
+	return validation.ValidateStruct(r, validation.Field(&r.RoomTypeID, validation.Required.Error("Room type Id is required")))
+}
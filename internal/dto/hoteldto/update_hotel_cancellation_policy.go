@@ -0,0 +1,14 @@
+package hoteldto
+
+import validation "github.com/go-ozzo/ozzo-validation"
+
+type UpdateHotelCancellationPolicyRequest struct {
+	HotelID              uint  `json:"hotel_id"`
+	CancellationPolicyID *uint `json:"cancellation_policy_id"` // null falls back to the hotel cancellation period
+}
+
+func (r *UpdateHotelCancellationPolicyRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.HotelID, validation.Required.Error("Hotel ID is required")),
+	)
+}
@@ -0,0 +1,17 @@
+package hoteldto
+
+import validation "github.com/go-ozzo/ozzo-validation"
+
+type UpdateHotelDepositRuleRequest struct {
+	HotelID        uint    `json:"hotel_id"`
+	DepositPercent float64 `json:"deposit_percent"`  // Share of the booking total due as deposit, 0 = full payment up front
+	BalanceDueDays int     `json:"balance_due_days"` // Days before check-in the remaining balance is due
+}
+
+func (r *UpdateHotelDepositRuleRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.HotelID, validation.Required.Error("Hotel ID is required")),
+		validation.Field(&r.DepositPercent, validation.Min(0.0).Error("Deposit percent must be between 0 and 100"), validation.Max(100.0).Error("Deposit percent must be between 0 and 100")),
+		validation.Field(&r.BalanceDueDays, validation.Min(0).Error("Balance due days must not be negative")),
+	)
+}
@@ -40,6 +40,19 @@ func (r *UpdateRoomAvailableRequest) Validate() error {
 				"room_available": validation.NewInternalError(fmt.Errorf("at least one available date is required for room type %d", data.RoomTypeID)),
 			}
 		}
+
+		for _, day := range data.RoomAvailable {
+			if day.MinStay < 0 || day.MaxStay < 0 || day.ReleaseDays < 0 {
+				return validation.Errors{
+					"room_available": validation.NewInternalError(fmt.Errorf("min stay, max stay and release days cannot be negative (room type %d, day %d)", data.RoomTypeID, day.Day)),
+				}
+			}
+			if day.MinStay > 0 && day.MaxStay > 0 && day.MaxStay < day.MinStay {
+				return validation.Errors{
+					"room_available": validation.NewInternalError(fmt.Errorf("max stay cannot be less than min stay (room type %d, day %d)", data.RoomTypeID, day.Day)),
+				}
+			}
+		}
 	}
 
 	return nil
@@ -0,0 +1,49 @@
+package hoteldto
+
+import (
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type UpsertCancellationPolicyRequest struct {
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	Tiers       []CancellationPolicyTier `json:"tiers"` // e.g. 7 days 0%, 2 days 50%, non-refundable after the last tier
+}
+
+type CancellationPolicyTier struct {
+	DaysBeforeCheckIn int     `json:"days_before_check_in"` // Tier applies when cancelling at least this many days before check-in
+	PenaltyPercent    float64 `json:"penalty_percent"`      // Percentage of the booking total charged, 0 = free
+}
+
+func (r *UpsertCancellationPolicyRequest) Validate() error {
+	if err := validation.ValidateStruct(r,
+		validation.Field(&r.Name, validation.Required.Error("Name is required")),
+		validation.Field(&r.Tiers, validation.Required.Error("Tiers is required")),
+	); err != nil {
+		return err
+	}
+
+	seen := make(map[int]bool, len(r.Tiers))
+	for _, tier := range r.Tiers {
+		if tier.DaysBeforeCheckIn < 0 {
+			return validation.Errors{
+				"tiers": validation.NewInternalError(fmt.Errorf("days before check-in must not be negative")),
+			}
+		}
+		if tier.PenaltyPercent < 0 || tier.PenaltyPercent > 100 {
+			return validation.Errors{
+				"tiers": validation.NewInternalError(fmt.Errorf("penalty percent must be between 0 and 100")),
+			}
+		}
+		if seen[tier.DaysBeforeCheckIn] {
+			return validation.Errors{
+				"tiers": validation.NewInternalError(fmt.Errorf("days before check-in must be unique per tier")),
+			}
+		}
+		seen[tier.DaysBeforeCheckIn] = true
+	}
+
+	return nil
+}
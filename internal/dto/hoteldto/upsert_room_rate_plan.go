@@ -0,0 +1,55 @@
+package hoteldto
+
+import (
+	"fmt"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type UpsertRoomRatePlanRequest struct {
+	RoomPriceID uint               `json:"room_price_id"` // Base price (with or without breakfast) the plan is layered over
+	Name        string             `json:"name"`
+	StartDate   string             `json:"start_date"`   // YYYY-MM-DD
+	EndDate     string             `json:"end_date"`     // YYYY-MM-DD, inclusive
+	DaysOfWeek  []int              `json:"days_of_week"` // 0 = Sunday ... 6 = Saturday, empty = every day
+	Prices      map[string]float64 `json:"prices"`       // Nightly prices, must contain IDR
+	Priority    int                `json:"priority"`     // Higher wins when plans overlap
+	IsActive    bool               `json:"is_active"`
+
+	CancellationPolicyID *uint `json:"cancellation_policy_id"` // Overrides the hotel policy, null keeps the hotel policy
+}
+
+func (r *UpsertRoomRatePlanRequest) Validate() error {
+	if err := validation.ValidateStruct(r,
+		validation.Field(&r.RoomPriceID, validation.Required.Error("Room price Id is required")),
+		validation.Field(&r.Name, validation.Required.Error("Name is required")),
+		validation.Field(&r.StartDate,
+			validation.Required.Error("Start date is required"),
+			validation.Date(time.DateOnly).Error("Start date must be in YYYY-MM-DD format"),
+		),
+		validation.Field(&r.EndDate,
+			validation.Required.Error("End date is required"),
+			validation.Date(time.DateOnly).Error("End date must be in YYYY-MM-DD format"),
+		),
+		validation.Field(&r.Prices, validation.Required.Error("Prices is required")),
+	); err != nil {
+		return err
+	}
+
+	if r.EndDate < r.StartDate {
+		return validation.Errors{
+			"end_date": validation.NewInternalError(fmt.Errorf("end date must not be before start date")),
+		}
+	}
+
+	for _, day := range r.DaysOfWeek {
+		if day < 0 || day > 6 {
+			return validation.Errors{
+				"days_of_week": validation.NewInternalError(fmt.Errorf("days of week must be between 0 (Sunday) and 6 (Saturday)")),
+			}
+		}
+	}
+
+	return nil
+}
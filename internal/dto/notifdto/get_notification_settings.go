@@ -0,0 +1,24 @@
+package notifdto
+
+// NotificationSettingsResponse is the settings matrix of the notification types of the role of the user, with its digest and quiet hours
+type NotificationSettingsResponse struct {
+	Channels   []string              `json:"channels"`
+	Types      []string              `json:"types"`
+	Settings   []NotificationSetting `json:"settings"`
+	DigestMode string                `json:"digest_mode"`
+	DigestHour int                   `json:"digest_hour"`
+	QuietHours QuietHours            `json:"quiet_hours"`
+	Timezone   string                `json:"timezone"`
+}
+
+type NotificationSetting struct {
+	Channel   string `json:"channel"`
+	Type      string `json:"type"`
+	IsEnabled bool   `json:"is_enabled"`
+}
+
+type QuietHours struct {
+	Enabled bool   `json:"enabled"`
+	Start   string `json:"start"`
+	End     string `json:"end"`
+}
@@ -0,0 +1,15 @@
+package notifdto
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+)
+
+// NotificationStream is the subscription of a client to the notification events of its user
+type NotificationStream struct {
+	UnreadCount int64 // Unread count when the client connected
+	Events      <-chan entity.NotificationEvent
+	Close       func()
+	// SessionActive reports whether the session that opened the stream is still active, checked on every heartbeat
+	SessionActive func(ctx context.Context) bool
+}
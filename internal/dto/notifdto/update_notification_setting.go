@@ -1,24 +1,109 @@
 package notifdto
 
-import validation "github.com/go-ozzo/ozzo-validation"
+import (
+	"errors"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/notifpref"
 
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// UpdateNotificationSettingRequest updates any of the settings matrix, the digest, the quiet hours and the timezone,
+// the fields left out are kept. Channel, Type and IsEnable are the former single channel update, kept for older clients.
 type UpdateNotificationSettingRequest struct {
 	Channel  string
 	Type     string
 	IsEnable bool
+
+	Settings   []NotificationSettingRequest `json:"settings" form:"settings"`
+	DigestMode *string                      `json:"digest_mode" form:"digest_mode"`
+	DigestHour *int                         `json:"digest_hour" form:"digest_hour"`
+	QuietHours *QuietHoursRequest           `json:"quiet_hours" form:"quiet_hours"`
+	Timezone   *string                      `json:"timezone" form:"timezone"`
+}
+
+type NotificationSettingRequest struct {
+	Channel   string `json:"channel"`
+	Type      string `json:"type"`
+	IsEnabled bool   `json:"is_enabled"`
+}
+
+type QuietHoursRequest struct {
+	Enabled bool   `json:"enabled"`
+	Start   string `json:"start"` // "22:00" in the timezone of the user
+	End     string `json:"end"`   // "07:00", before the start when the quiet hours cross midnight
 }
 
 func (r *UpdateNotificationSettingRequest) Validate() error {
-	// Add validation logic if needed
-	if r.IsEnable {
-		switch r.Type {
-		case "booking", "reject", "all":
-			// valid type
-		default:
-			return validation.Validate(r.Type, validation.In("booking", "reject", "all"))
+	if r.Channel == "" && len(r.Settings) == 0 && r.DigestMode == nil && r.DigestHour == nil && r.QuietHours == nil && r.Timezone == nil {
+		return validation.Errors{"settings": errors.New("nothing to update")}
+	}
+
+	if r.Channel != "" {
+		if r.IsEnable {
+			// booking and reject are the former names of booking_confirmed and booking_rejected
+			types := []interface{}{constant.ConstBooking, constant.ConstReject, constant.ConstAll}
+			if err := validation.Validate(r.Type, validation.In(append(types, notificationTypes()...)...)); err != nil {
+				return validation.Errors{"type": err}
+			}
+		}
+		if err := validation.Validate(r.Channel, validation.In(constant.ConstEmail, constant.ConstWeb)); err != nil {
+			return validation.Errors{"channel": err}
 		}
 	}
+
 	return validation.ValidateStruct(r,
-		validation.Field(&r.Channel, validation.Required, validation.In("email", "web")),
+		validation.Field(&r.Settings, validation.Each(validation.By(validateSetting))),
+		validation.Field(&r.DigestMode, validation.NilOrNotEmpty, validation.In(constant.DigestModeOff, constant.DigestModeDaily)),
+		validation.Field(&r.DigestHour, validation.Min(0), validation.Max(23)),
+		validation.Field(&r.QuietHours, validation.By(validateQuietHours)),
+		validation.Field(&r.Timezone, validation.NilOrNotEmpty, validation.In(timezones()...)),
 	)
 }
+
+func validateSetting(value interface{}) error {
+	setting, _ := value.(NotificationSettingRequest)
+	if err := validation.Validate(setting.Channel, validation.Required, validation.In(constant.ConstEmail, constant.ConstWeb)); err != nil {
+		return errors.New("channel must be email or web")
+	}
+	if err := validation.Validate(setting.Type, validation.Required, validation.In(notificationTypes()...)); err != nil {
+		return errors.New("unknown notification type " + setting.Type)
+	}
+	return nil
+}
+
+func validateQuietHours(value interface{}) error {
+	quietHours, _ := value.(*QuietHoursRequest)
+	if quietHours == nil || !quietHours.Enabled {
+		return nil
+	}
+	if _, err := notifpref.ParseClock(quietHours.Start); err != nil {
+		return err
+	}
+	if _, err := notifpref.ParseClock(quietHours.End); err != nil {
+		return err
+	}
+	if quietHours.Start == quietHours.End {
+		return errors.New("start and end must differ")
+	}
+	return nil
+}
+
+func notificationTypes() []interface{} {
+	var types []interface{}
+	for _, t := range constant.AgentNotificationTypes {
+		types = append(types, t)
+	}
+	for _, t := range constant.AdminNotificationTypes {
+		types = append(types, t)
+	}
+	return types
+}
+
+func timezones() []interface{} {
+	var result []interface{}
+	for _, timezone := range constant.Timezones {
+		result = append(result, timezone)
+	}
+	return result
+}
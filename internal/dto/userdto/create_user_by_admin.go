@@ -18,6 +18,7 @@ type CreateUserByAdminRequest struct {
 	Role         string                `json:"role" form:"role"` // e.g., "admin", "suppor", "agent", "super_admin"
 	KakaoTalkID  string                `form:"kakao_talk_id" json:"kakao_talk_id"`
 	Currency     string                `json:"currency" form:"currency"`
+	Language     string                `json:"language" form:"language"` // Preferred language of emails: en, id or ko
 	PhotoSelfie  *multipart.FileHeader `form:"photo_selfie" json:"photo_selfie"`
 	PhotoIDCard  *multipart.FileHeader `form:"photo_id_card" json:"photo_id_card"`
 	Certificate  *multipart.FileHeader `form:"certificate" json:"certificate"`
@@ -29,6 +30,7 @@ func (r *CreateUserByAdminRequest) Validate() error {
 		validation.Field(&r.FullName, validation.Required.Error("Full name is required"), utils.NotEmptyAfterTrim("Full Name")),
 		validation.Field(&r.Email, validation.Required, is.Email.Error("Invalid email format"), utils.NotEmptyAfterTrim("Email")),
 		validation.Field(&r.Phone, validation.Required, is.E164.Error("Phone number must use country code")),
+		validation.Field(&r.Language, utils.IsLocale()),
 		validation.Field(&r.Role, validation.Required, validation.In("admin", "support", "agent", "super_admin").Error("Role must be one of: admin, support, agent, super_admin")),
 	)
 }
@@ -49,4 +49,6 @@ type ListUserData struct {
 	NameCard         string `json:"name_card,omitempty"`
 	IdCard           string `json:"id_card,omitempty"`
 	Currency         string `json:"currency"`
+	Language         string `json:"language,omitempty"`
+	LockedUntil      string `json:"locked_until,omitempty"` // Set while the user is locked after too many failed login attempts
 }
@@ -15,6 +15,7 @@ type ProfileResponse struct {
 	Status              string                `json:"status,omitempty"`
 	AgentCompany        string                `json:"agent_company,omitempty"`
 	Currency            string                `json:"currency,omitempty"`
+	Language            string                `json:"language,omitempty"`
 	NotificationSetting []NotificationSetting `json:"notification_settings,omitempty"`
 }
 
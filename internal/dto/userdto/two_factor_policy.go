@@ -0,0 +1,23 @@
+package userdto
+
+import (
+	"wtm-backend/pkg/constant"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type TwoFactorPolicyResponse struct {
+	Role     string `json:"role"`
+	Required bool   `json:"required"` // Users of the role must log in with a TOTP code
+}
+
+type UpdateTwoFactorPolicyRequest struct {
+	Role     string `json:"role"`
+	Required bool   `json:"required"`
+}
+
+func (r *UpdateTwoFactorPolicyRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Role, validation.Required, validation.In(constant.RoleSuperAdmin, constant.RoleAdmin).Error("Role must be one of: super_admin, admin")),
+	)
+}
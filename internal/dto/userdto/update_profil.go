@@ -11,6 +11,7 @@ type UpdateProfileRequest struct {
 	Email       string `json:"email"`
 	Phone       string `json:"phone"`
 	KakaoTalkID string `json:"kakao_talk_id"`
+	Language    string `json:"language"` // Preferred language of emails: en, id or ko
 }
 
 type UpdateProfileResponse struct {
@@ -21,5 +22,6 @@ func (r *UpdateProfileRequest) Validate() error {
 		validation.Field(&r.FullName, validation.Required.Error("Full name is required"), utils.NotEmptyAfterTrim("Full Name")),
 		validation.Field(&r.Email, validation.Required, is.Email.Error("Invalid email format"), utils.NotEmptyAfterTrim("Email")),
 		validation.Field(&r.Phone, validation.Required, is.E164.Error("Phone number must use country code")),
+		validation.Field(&r.Language, utils.IsLocale()),
 	)
 }
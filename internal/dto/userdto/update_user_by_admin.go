@@ -18,5 +18,6 @@ func (r *UpdateUserByAdminRequest) Validate() error {
 		validation.Field(&r.FullName, validation.Required.Error("Full name is required"), utils.NotEmptyAfterTrim("Full Name")),
 		validation.Field(&r.Email, validation.Required, is.Email.Error("Invalid email format"), utils.NotEmptyAfterTrim("Email")),
 		validation.Field(&r.Phone, validation.Required, is.E164.Error("Phone number must use country code")),
+		validation.Field(&r.Language, utils.IsLocale()),
 	)
 }
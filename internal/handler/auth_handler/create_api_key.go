@@ -0,0 +1,56 @@
+package auth_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// CreateAPIKey godoc
+// @Summary Create an API key
+// @Description Create a personal API key limited to the given scopes. Send it in the X-API-Key header or as a Bearer token. The key is only shown in this response.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body authdto.CreateAPIKeyRequest true "Name, scopes, rate limit and expiry of the key"
+// @Success 200 {object} response.ResponseWithData{data=authdto.CreateAPIKeyResponse} "Successfully created api key"
+// @Security BearerAuth
+// @Router /profile/api-keys [post]
+func (ah *AuthHandler) CreateAPIKey(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req authdto.CreateAPIKeyRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Error validating request:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+
+		logger.Error(ctx, "Unexpected validation error", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := ah.authUsecase.CreateAPIKey(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Error creating api key:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to create api key")
+		return
+	}
+
+	response.Success(c, resp, "Successfully created api key")
+}
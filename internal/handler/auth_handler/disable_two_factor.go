@@ -0,0 +1,55 @@
+package auth_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// DisableTwoFactor godoc
+// @Summary Disable two-factor
+// @Description Remove the authenticator and recovery codes of the logged in user. Not allowed when the role requires two-factor.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body authdto.DisableTwoFactorRequest true "Password and code of the authenticator"
+// @Success 200 {object} response.Response "Successfully disabled two-factor"
+// @Security BearerAuth
+// @Router /profile/2fa/disable [post]
+func (ah *AuthHandler) DisableTwoFactor(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req authdto.DisableTwoFactorRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Error validating request:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+
+		logger.Error(ctx, "Unexpected validation error", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	if err := ah.authUsecase.DisableTwoFactor(ctx, &req); err != nil {
+		logger.Error(ctx, "Error disabling two-factor:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to disable two-factor")
+		return
+	}
+
+	response.Success(c, nil, "Successfully disabled two-factor")
+}
@@ -0,0 +1,55 @@
+package auth_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// ForceLogout godoc
+// @Summary Force logout a user
+// @Description Log out every session of a user, on all of its devices.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body authdto.ForceLogoutRequest true "User to log out"
+// @Success 200 {object} response.Response "Successfully logged out user"
+// @Security BearerAuth
+// @Router /users/force-logout [post]
+func (ah *AuthHandler) ForceLogout(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req authdto.ForceLogoutRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Error validating request:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+
+		logger.Error(ctx, "Unexpected validation error", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	if err := ah.authUsecase.ForceLogout(ctx, &req); err != nil {
+		logger.Error(ctx, "Error forcing logout:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to log out user")
+		return
+	}
+
+	response.Success(c, nil, "Successfully logged out user")
+}
@@ -0,0 +1,29 @@
+package auth_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+)
+
+// GetTwoFactorStatus godoc
+// @Summary Get two-factor status
+// @Description Retrieve whether two-factor is enabled for the logged in user, whether the role requires it and how many recovery codes are left.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} response.ResponseWithData{data=authdto.TwoFactorStatusResponse} "Successfully retrieved two-factor status"
+// @Security BearerAuth
+// @Router /profile/2fa [get]
+func (ah *AuthHandler) GetTwoFactorStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	resp, err := ah.authUsecase.GetTwoFactorStatus(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error getting two-factor status:", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to retrieve two-factor status")
+		return
+	}
+
+	response.Success(c, resp, "Successfully retrieved two-factor status")
+}
@@ -0,0 +1,29 @@
+package auth_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+)
+
+// ListAPIKeys godoc
+// @Summary List API keys
+// @Description List the active personal API keys of the logged in user and the scopes it can grant to a new key.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} response.ResponseWithData{data=authdto.ListAPIKeysResponse} "Successfully retrieved api keys"
+// @Security BearerAuth
+// @Router /profile/api-keys [get]
+func (ah *AuthHandler) ListAPIKeys(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	resp, err := ah.authUsecase.ListAPIKeys(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error listing api keys:", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to retrieve api keys")
+		return
+	}
+
+	response.Success(c, resp, "Successfully retrieved api keys")
+}
@@ -0,0 +1,29 @@
+package auth_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+)
+
+// ListSessions godoc
+// @Summary List sessions
+// @Description List the active sessions of the logged in user, one per device it logged in on.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} response.ResponseWithData{data=authdto.ListSessionsResponse} "Successfully retrieved sessions"
+// @Security BearerAuth
+// @Router /profile/sessions [get]
+func (ah *AuthHandler) ListSessions(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	resp, err := ah.authUsecase.ListSessions(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error listing sessions:", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to retrieve sessions")
+		return
+	}
+
+	response.Success(c, resp, "Successfully retrieved sessions")
+}
@@ -11,10 +11,12 @@ import (
 
 // Login logs in a user with the provided credentials.
 // @Summary User Login
-// @Description Logs in a user with username and password
+// @Description Logs in a user with username and password. When two-factor is enabled for the user, or required by
+// @Description its role, the response only has a challenge token to complete the login at /login/2fa.
 // @Tags Auth
 // @Produce json
 // @Param request body authdto.LoginRequest true "Login Request"
+// @Param X-Device-Name header string false "Name of the device shown in the sessions, derived from the User-Agent when empty"
 // @Success 200 {object} response.ResponseWithData{data=authdto.LoginResponse} "Login successful"
 // @Router /login [post]
 func (ah *AuthHandler) Login(c *gin.Context) {
@@ -40,6 +42,10 @@ func (ah *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
+	req.Device = c.GetHeader("X-Device-Name")
+	req.IPAddress = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
+
 	respLogin, refreshToken, err := ah.authUsecase.Login(ctx, &req)
 	if err != nil {
 		logger.Warn(ctx, "Login failed:", err.Error())
@@ -47,6 +53,12 @@ func (ah *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
+	// Login dengan 2FA belum mendapat token, cookie di-set setelah /login/2fa
+	if refreshToken == "" {
+		response.Success(c, respLogin, "Two-factor code required")
+		return
+	}
+
 	utils.SetRefreshCookie(c, refreshToken, ah.config.URL, int(ah.config.DurationRefreshToken.Seconds()), ah.config.SecureService)
 
 	response.Success(c, respLogin, "Login successful")
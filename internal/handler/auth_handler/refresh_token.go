@@ -10,7 +10,8 @@ import (
 
 // RefreshToken refreshes the user's access token using the refresh token stored in a cookie.
 // @Summary Refresh User Token
-// @Description Refreshes the user's access token using the refresh token stored in a cookie
+// @Description Refreshes the user's access token using the refresh token stored in a cookie. The refresh token is single-use,
+// @Description the cookie is replaced with the next one and a refresh token used twice logs out its session
 // @Tags Auth
 // @Produce json
 // @Success 200 {object} response.ResponseWithData{data=authdto.LoginResponse} "Token refreshed successfully"
@@ -24,7 +25,7 @@ func (ah *AuthHandler) RefreshToken(c *gin.Context) {
 		return
 	}
 
-	resp, err := ah.authUsecase.RefreshToken(c.Request.Context(), refreshToken)
+	resp, nextRefreshToken, err := ah.authUsecase.RefreshToken(c.Request.Context(), refreshToken)
 	if err != nil {
 		logger.Error(ctx, "Error refreshing token:", err.Error())
 		utils.ClearRefreshCookie(c, ah.config.URL, ah.config.SecureService)
@@ -32,5 +33,8 @@ func (ah *AuthHandler) RefreshToken(c *gin.Context) {
 		return
 	}
 
+	// Refresh token hanya sekali pakai, cookie diganti dengan token berikutnya
+	utils.SetRefreshCookie(c, nextRefreshToken, ah.config.URL, int(ah.config.DurationRefreshToken.Seconds()), ah.config.SecureService)
+
 	response.Success(c, resp, "Token refreshed successfully")
 }
@@ -0,0 +1,56 @@
+package auth_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// RegenerateRecoveryCodes godoc
+// @Summary Regenerate recovery codes
+// @Description Replace every recovery code of the logged in user. The new codes are only shown in this response.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body authdto.TwoFactorCodeRequest true "Code of the authenticator"
+// @Success 200 {object} response.ResponseWithData{data=authdto.TwoFactorRecoveryCodesResponse} "Successfully regenerated recovery codes"
+// @Security BearerAuth
+// @Router /profile/2fa/recovery-codes [post]
+func (ah *AuthHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req authdto.TwoFactorCodeRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Error validating request:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+
+		logger.Error(ctx, "Unexpected validation error", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := ah.authUsecase.RegenerateRecoveryCodes(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Error regenerating recovery codes:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to regenerate recovery codes")
+		return
+	}
+
+	response.Success(c, resp, "Successfully regenerated recovery codes")
+}
@@ -0,0 +1,34 @@
+package auth_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// RevokeAPIKey godoc
+// @Summary Revoke an API key
+// @Description Revoke one personal API key of the logged in user, requests with it are refused right away.
+// @Tags Auth
+// @Produce json
+// @Param id path string true "API key ID"
+// @Success 200 {object} response.Response "Successfully revoked api key"
+// @Security BearerAuth
+// @Router /profile/api-keys/{id} [delete]
+func (ah *AuthHandler) RevokeAPIKey(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	if err := ah.authUsecase.RevokeAPIKey(ctx, c.Param("id")); err != nil {
+		logger.Error(ctx, "Error revoking api key:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to revoke api key")
+		return
+	}
+
+	response.Success(c, nil, "Successfully revoked api key")
+}
@@ -0,0 +1,28 @@
+package auth_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+)
+
+// RevokeOtherSessions godoc
+// @Summary Revoke other sessions
+// @Description Log out every session of the logged in user but the one of the request.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} response.Response "Successfully revoked other sessions"
+// @Security BearerAuth
+// @Router /profile/sessions [delete]
+func (ah *AuthHandler) RevokeOtherSessions(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	if err := ah.authUsecase.RevokeOtherSessions(ctx); err != nil {
+		logger.Error(ctx, "Error revoking other sessions:", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to revoke other sessions")
+		return
+	}
+
+	response.Success(c, nil, "Successfully revoked other sessions")
+}
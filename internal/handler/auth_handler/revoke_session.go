@@ -0,0 +1,34 @@
+package auth_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// RevokeSession godoc
+// @Summary Revoke a session
+// @Description Log out one session of the logged in user, e.g. a lost phone.
+// @Tags Auth
+// @Produce json
+// @Param id path string true "Session ID"
+// @Success 200 {object} response.Response "Successfully revoked session"
+// @Security BearerAuth
+// @Router /profile/sessions/{id} [delete]
+func (ah *AuthHandler) RevokeSession(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	if err := ah.authUsecase.RevokeSession(ctx, c.Param("id")); err != nil {
+		logger.Error(ctx, "Error revoking session:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to revoke session")
+		return
+	}
+
+	response.Success(c, nil, "Successfully revoked session")
+}
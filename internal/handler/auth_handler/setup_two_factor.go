@@ -0,0 +1,34 @@
+package auth_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// SetupTwoFactor godoc
+// @Summary Set up two-factor
+// @Description Start the enrollment of an authenticator for the logged in user. Scan the provisioning URI, then enable it with the first code at /profile/2fa/verify.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} response.ResponseWithData{data=authdto.TwoFactorSetupResponse} "Successfully set up two-factor"
+// @Security BearerAuth
+// @Router /profile/2fa/setup [post]
+func (ah *AuthHandler) SetupTwoFactor(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	resp, err := ah.authUsecase.SetupTwoFactor(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error setting up two-factor:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to set up two-factor")
+		return
+	}
+
+	response.Success(c, resp, "Successfully set up two-factor")
+}
@@ -0,0 +1,55 @@
+package auth_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// SetupTwoFactorLogin godoc
+// @Summary Set up two-factor during login
+// @Description Enroll an authenticator during a login of a user whose role requires two-factor, when the login response has two_factor_setup_required. Scan the provisioning URI, then complete the login with the first code at /login/2fa.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body authdto.TwoFactorChallengeRequest true "Challenge token of the login"
+// @Success 200 {object} response.ResponseWithData{data=authdto.TwoFactorSetupResponse} "Successfully set up two-factor"
+// @Router /login/2fa/setup [post]
+func (ah *AuthHandler) SetupTwoFactorLogin(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req authdto.TwoFactorChallengeRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Error validating request:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+
+		logger.Error(ctx, "Unexpected validation error", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := ah.authUsecase.SetupTwoFactorLogin(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Error setting up two-factor during login:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to set up two-factor")
+		return
+	}
+
+	response.Success(c, resp, "Successfully set up two-factor")
+}
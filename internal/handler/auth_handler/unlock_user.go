@@ -0,0 +1,55 @@
+package auth_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// UnlockUser godoc
+// @Summary Unlock a user
+// @Description Let a user that was locked after too many failed login attempts log in again right away.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body authdto.UnlockUserRequest true "User to unlock"
+// @Success 200 {object} response.Response "Successfully unlocked user"
+// @Security BearerAuth
+// @Router /users/unlock [post]
+func (ah *AuthHandler) UnlockUser(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req authdto.UnlockUserRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Error validating request:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+
+		logger.Error(ctx, "Unexpected validation error", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	if err := ah.authUsecase.UnlockUser(ctx, &req); err != nil {
+		logger.Error(ctx, "Error unlocking user:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to unlock user")
+		return
+	}
+
+	response.Success(c, nil, "Successfully unlocked user")
+}
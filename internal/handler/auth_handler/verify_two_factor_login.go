@@ -0,0 +1,57 @@
+package auth_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// VerifyTwoFactorLogin godoc
+// @Summary Verify two-factor login
+// @Description Complete a login that returned two_factor_required with a code of the authenticator, or with a recovery code when the authenticator is lost. After too many invalid codes the login has to start over.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body authdto.VerifyTwoFactorLoginRequest true "Challenge token of the login and code"
+// @Success 200 {object} response.ResponseWithData{data=authdto.LoginResponse} "Login successful"
+// @Router /login/2fa [post]
+func (ah *AuthHandler) VerifyTwoFactorLogin(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req authdto.VerifyTwoFactorLoginRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Error validating request:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+
+		logger.Error(ctx, "Unexpected validation error", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	respLogin, refreshToken, err := ah.authUsecase.VerifyTwoFactorLogin(ctx, &req)
+	if err != nil {
+		logger.Warn(ctx, "Two-factor login failed:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	utils.SetRefreshCookie(c, refreshToken, ah.config.URL, int(ah.config.DurationRefreshToken.Seconds()), ah.config.SecureService)
+
+	response.Success(c, respLogin, "Login successful")
+}
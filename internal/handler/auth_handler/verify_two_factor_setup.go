@@ -0,0 +1,56 @@
+package auth_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// VerifyTwoFactorSetup godoc
+// @Summary Enable two-factor
+// @Description Enable the authenticator being set up with its first code. The recovery codes are only shown in this response.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body authdto.TwoFactorCodeRequest true "Code of the authenticator"
+// @Success 200 {object} response.ResponseWithData{data=authdto.TwoFactorRecoveryCodesResponse} "Successfully enabled two-factor"
+// @Security BearerAuth
+// @Router /profile/2fa/verify [post]
+func (ah *AuthHandler) VerifyTwoFactorSetup(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req authdto.TwoFactorCodeRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Error validating request:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+
+		logger.Error(ctx, "Unexpected validation error", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := ah.authUsecase.VerifyTwoFactorSetup(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Error enabling two-factor:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to enable two-factor")
+		return
+	}
+
+	response.Success(c, resp, "Successfully enabled two-factor")
+}
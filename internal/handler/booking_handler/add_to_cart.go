@@ -43,6 +43,10 @@ func (bh *BookingHandler) AddToCart(c *gin.Context) {
 
 	if err := bh.bookingUsecase.AddToCart(ctx, &req); err != nil {
 		logger.Error(ctx, "Failed to add to cart", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed to add to cart")
 		return
 	}
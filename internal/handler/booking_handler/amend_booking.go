@@ -0,0 +1,57 @@
+package booking_handler
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// AmendBooking godoc
+// @Summary      Amend Booking
+// @Description  Change the dates, room price or quantity of a checked out sub-booking. The stay is repriced, the previous values are kept as a revision and the sub-booking goes back to Waiting Approval.
+// @Tags         Booking
+// @Accept       json
+// @Produce      json
+// @Param        sub_booking_id  path      string                          true  "Sub Booking ID"
+// @Param        request         body      bookingdto.AmendBookingRequest  true  "Amend booking request"
+// @Success      200  {object}  response.Response{data=bookingdto.AmendBookingResponse}  "Successfully amended booking"
+// @Security     BearerAuth
+// @Router       /bookings/{sub_booking_id}/amend [post]
+func (bh *BookingHandler) AmendBooking(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req bookingdto.AmendBookingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error(ctx, "Failed to bind json parameters:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	if err := c.ShouldBindUri(&req); err != nil {
+		logger.Error(ctx, "Failed to bind uri parameters:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Validation error:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := bh.bookingUsecase.AmendBooking(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Error amending booking:", err.Error())
+		response.Error(c, http.StatusInternalServerError, fmt.Sprintf("Failed to amend booking: %s", err.Error()))
+		return
+	}
+
+	response.Success(c, resp, "Successfully amended booking")
+}
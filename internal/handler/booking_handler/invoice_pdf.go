@@ -0,0 +1,58 @@
+package booking_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// InvoicePDF godoc
+// @Summary      Download Invoice PDF
+// @Description  Download the invoice of a sub-booking as a PDF
+// @Tags         Booking
+// @Produce      application/pdf
+// @Param        sub_booking_id  path  string  true  "Sub Booking ID"
+// @Success      200  {file}  binary  "Successfully rendered invoice"
+// @Security     BearerAuth
+// @Router       /bookings/{sub_booking_id}/invoice.pdf [get]
+func (bh *BookingHandler) InvoicePDF(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req bookingdto.BookingDocumentRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		logger.Error(ctx, "Failed to bind uri parameters:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Validation error:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := bh.bookingUsecase.InvoicePDF(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Error rendering invoice:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to render invoice")
+		return
+	}
+
+	if resp == nil {
+		response.Error(c, http.StatusNotFound, "Invoice not found")
+		return
+	}
+
+	writePDF(c, resp)
+}
@@ -0,0 +1,49 @@
+package booking_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// ListBookingRevisions godoc
+// @Summary      List Booking Revisions
+// @Description  List the values a sub-booking had before each amendment, latest first
+// @Tags         Booking
+// @Produce      json
+// @Param        sub_booking_id  path  string  true  "Sub Booking ID"
+// @Success      200  {object}  response.Response{data=bookingdto.ListBookingRevisionsResponse}  "Successfully retrieved booking revisions"
+// @Security     BearerAuth
+// @Router       /bookings/revisions/{sub_booking_id} [get]
+func (bh *BookingHandler) ListBookingRevisions(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req bookingdto.ListBookingRevisionsRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		logger.Error(ctx, "Failed to bind uri parameters:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Validation error:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := bh.bookingUsecase.ListBookingRevisions(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Error listing booking revisions:", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to list booking revisions")
+		return
+	}
+
+	response.Success(c, resp, "Successfully retrieved booking revisions")
+}
@@ -0,0 +1,50 @@
+package booking_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// ListPayments godoc
+// @Summary      List Payments
+// @Description  List the payments ledger of a booking with the outstanding balance of each sub-booking
+// @Tags         Booking
+// @Produce      json
+// @Param        booking_id query string false "Booking ID"
+// @Param        sub_booking_id query string false "Sub Booking ID, lists the ledger of its booking"
+// @Success      200 {object} response.Response{data=bookingdto.ListPaymentsResponse} "Successfully retrieved payments"
+// @Security     BearerAuth
+// @Router       /bookings/payments [get]
+func (bh *BookingHandler) ListPayments(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req bookingdto.ListPaymentsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(ctx, "Failed to bind query parameters:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Validation error:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := bh.bookingUsecase.ListPayments(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Error listing payments:", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to list payments")
+		return
+	}
+
+	response.Success(c, resp, "Successfully retrieved payments")
+}
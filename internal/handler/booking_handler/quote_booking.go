@@ -0,0 +1,60 @@
+package booking_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// QuoteBooking godoc
+// @Summary      Quote a booking
+// @Description  Price a stay in the agent's currency with nightly rates, promo and additional services, without adding it to the cart
+// @Tags         Booking
+// @Accept       json
+// @Produce      json
+// @Param        request body bookingdto.QuoteBookingRequest true "Quote booking request"
+// @Success 200 {object} response.Response{data=bookingdto.QuoteBookingResponse} "Successfully quoted booking"
+// @Security BearerAuth
+// @Router       /bookings/quote [post]
+func (bh *BookingHandler) QuoteBooking(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req bookingdto.QuoteBookingRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Validation error", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := bh.bookingUsecase.QuoteBooking(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Failed to quote booking", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to quote booking")
+		return
+	}
+
+	if resp == nil {
+		response.Error(c, http.StatusNotFound, "Room price not found")
+		return
+	}
+
+	response.Success(c, resp, "Successfully quoted booking")
+}
@@ -0,0 +1,48 @@
+package booking_handler
+
+import (
+	"io"
+	"net/http"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReceiveInboundEmail godoc
+// @Summary      Receive Inbound Email
+// @Description  Webhook of the mail provider for replies of hotels to booking emails. The body is the raw MIME message, signed with
+// @Description  X-Inbound-Signature, the hex HMAC-SHA256 of "<X-Inbound-Timestamp>.<body>" with the inbound email secret.
+// @Description  The reply is matched to a booking by the codes in its subject, a confirmation number or rejection is attached to the booking.
+// @Tags         Booking
+// @Accept       plain
+// @Produce      json
+// @Param        X-Inbound-Timestamp  header  string  true  "Unix seconds"
+// @Param        X-Inbound-Signature  header  string  true  "Hex HMAC-SHA256 signature"
+// @Param        message  body  string  true  "Raw MIME message"
+// @Success      200  {object}  response.Response{data=bookingdto.ProcessInboundEmailResponse}  "Successfully received inbound email"
+// @Router       /bookings/inbound-email [post]
+func (bh *BookingHandler) ReceiveInboundEmail(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	raw, err := io.ReadAll(c.Request.Body)
+	if err != nil || len(raw) == 0 {
+		logger.Error(ctx, "Failed to read inbound email")
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	resp, err := bh.bookingUsecase.ProcessInboundEmail(ctx, raw)
+	if err != nil {
+		logger.Error(ctx, "Error processing inbound email:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to process inbound email")
+		return
+	}
+
+	response.Success(c, resp, "Successfully received inbound email")
+}
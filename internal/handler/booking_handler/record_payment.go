@@ -0,0 +1,58 @@
+package booking_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// RecordPayment godoc
+// @Summary      Record Payment
+// @Description  Record a payment on a booking or a sub-booking and update its payment status
+// @Tags         Booking
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        booking_id formData string false "Booking ID, for a payment on the whole booking"
+// @Param        sub_booking_id formData string false "Sub Booking ID, for a payment on a single sub-booking"
+// @Param        amount formData number true "Amount, negative for refunds"
+// @Param        currency formData string false "Currency, must be the booking currency"
+// @Param        method formData string true "Method: bank_transfer, credit_card, cash or other"
+// @Param        reference formData string false "Reference"
+// @Param        paid_at formData string false "Paid at (YYYY-MM-DD), defaults to today"
+// @Param        notes formData string false "Notes"
+// @Param        receipt formData file false "Receipt File, defaults to the receipt uploaded by the agent"
+// @Success      200 {object} response.Response{data=bookingdto.RecordPaymentResponse} "Successfully recorded payment"
+// @Security     BearerAuth
+// @Router       /bookings/payments [post]
+func (bh *BookingHandler) RecordPayment(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req bookingdto.RecordPaymentRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Error(ctx, "Failed to bind form data:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Failed to bind form data")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Error validating request:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := bh.bookingUsecase.RecordPayment(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Failed to record payment", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to record payment")
+		return
+	}
+
+	response.Success(c, resp, "Successfully recorded payment")
+}
@@ -0,0 +1,37 @@
+package booking_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// RemovePayment godoc
+// @Summary      Remove Payment
+// @Description  Remove a payment recorded by mistake and update the payment status
+// @Tags         Booking
+// @Produce      json
+// @Param        id path string true "Payment Id"
+// @Success      200 {object} response.Response "Successfully removed payment"
+// @Security     BearerAuth
+// @Router       /bookings/payments/{id} [delete]
+func (bh *BookingHandler) RemovePayment(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	paymentID, err := utils.StringToUint(c.Param("id"))
+	if err != nil {
+		logger.Error(ctx, "Invalid payment Id", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid payment Id")
+		return
+	}
+
+	if err := bh.bookingUsecase.RemovePayment(ctx, paymentID); err != nil {
+		logger.Error(ctx, "Failed to remove payment", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to remove payment")
+		return
+	}
+
+	response.Success(c, nil, "Successfully removed payment")
+}
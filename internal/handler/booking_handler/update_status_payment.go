@@ -12,7 +12,7 @@ import (
 
 // UpdateStatusPayment godoc
 // @Summary      Update payment status
-// @Description  Update the status of a payment
+// @Description  Update the status of a payment, the status is kept over the payments ledger until a payment of the sub-booking is recorded or removed
 // @Tags         Booking
 // @Accept       json
 // @Produce      json
@@ -0,0 +1,64 @@
+package booking_handler
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// VoucherPDF godoc
+// @Summary      Download Voucher PDF
+// @Description  Download the hotel voucher of a confirmed sub-booking as a PDF
+// @Tags         Booking
+// @Produce      application/pdf
+// @Param        sub_booking_id  path  string  true  "Sub Booking ID"
+// @Success      200  {file}  binary  "Successfully rendered voucher"
+// @Security     BearerAuth
+// @Router       /bookings/{sub_booking_id}/voucher.pdf [get]
+func (bh *BookingHandler) VoucherPDF(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req bookingdto.BookingDocumentRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		logger.Error(ctx, "Failed to bind uri parameters:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Validation error:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := bh.bookingUsecase.VoucherPDF(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Error rendering voucher:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to render voucher")
+		return
+	}
+
+	if resp == nil {
+		response.Error(c, http.StatusNotFound, "Voucher not found")
+		return
+	}
+
+	writePDF(c, resp)
+}
+
+func writePDF(c *gin.Context, doc *bookingdto.BookingDocumentResponse) {
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", doc.Filename))
+	c.Data(http.StatusOK, "application/pdf", doc.Content)
+}
@@ -0,0 +1,52 @@
+package currency_handler
+
+import (
+	"net/http"
+	"wtm-backend/internal/dto/currencydto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateExchangeRate godoc
+// @Summary Create Exchange Rate
+// @Description Create an IDR based exchange rate for a currency, effective from the given date
+// @Tags Currency
+// @Accept json
+// @Produce json
+// @Param request body currencydto.CreateExchangeRateRequest true "Create Exchange Rate Request"
+// @Security BearerAuth
+// @Success 200 {object} response.Response{data=currencydto.ExchangeRateResponse} "Successfully created exchange rate"
+// @Router /currencies/exchange-rates [post]
+func (ch *CurrencyHandler) CreateExchangeRate(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req currencydto.CreateExchangeRateRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Error(ctx, "Error binding request", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	exchangeRate, err := currencydto.ToExchangeRateEntity(&req)
+	if err != nil {
+		logger.Error(ctx, "Error parsing exchange rate", err.Error())
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	created, err := ch.currencyUsecase.CreateExchangeRate(ctx, exchangeRate)
+	if err != nil {
+		logger.Error(ctx, "Error creating exchange rate", err.Error())
+		if utils.ParseValidationErrors(err) != nil {
+			response.ValidationError(c, utils.ParseValidationErrors(err))
+			return
+		}
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.Success(c, currencydto.ToExchangeRateResponse(created), "Successfully created exchange rate")
+}
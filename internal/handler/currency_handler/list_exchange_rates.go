@@ -0,0 +1,45 @@
+package currency_handler
+
+import (
+	"net/http"
+	"wtm-backend/internal/dto/currencydto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListExchangeRates godoc
+// @Summary List Exchange Rates
+// @Description Retrieve IDR based exchange rates, newest effective date first
+// @Tags Currency
+// @Accept json
+// @Produce json
+// @Param currency_code query string false "Filter by currency code"
+// @Security BearerAuth
+// @Success 200 {object} response.Response{data=[]currencydto.ExchangeRateResponse} "Successfully retrieved exchange rates"
+// @Router /currencies/exchange-rates [get]
+func (ch *CurrencyHandler) ListExchangeRates(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req currencydto.ListExchangeRatesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(ctx, "Error binding request", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	exchangeRates, err := ch.currencyUsecase.ListExchangeRates(ctx, req.CurrencyCode)
+	if err != nil {
+		logger.Error(ctx, "Error getting exchange rates", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to get exchange rates")
+		return
+	}
+
+	exchangeRateResponses := make([]currencydto.ExchangeRateResponse, 0, len(exchangeRates))
+	for _, exchangeRate := range exchangeRates {
+		exchangeRateResponses = append(exchangeRateResponses, currencydto.ToExchangeRateResponse(&exchangeRate))
+	}
+
+	response.Success(c, exchangeRateResponses, "Successfully retrieved exchange rates")
+}
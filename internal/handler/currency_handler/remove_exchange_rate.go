@@ -0,0 +1,40 @@
+package currency_handler
+
+import (
+	"net/http"
+	"strconv"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RemoveExchangeRate godoc
+// @Summary Remove Exchange Rate
+// @Description Remove an exchange rate, bookings keep the rate they were priced with
+// @Tags Currency
+// @Accept json
+// @Produce json
+// @Param id path int true "Exchange Rate ID"
+// @Security BearerAuth
+// @Success 200 {object} response.Response "Successfully removed exchange rate"
+// @Router /currencies/exchange-rates/{id} [delete]
+func (ch *CurrencyHandler) RemoveExchangeRate(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		logger.Error(ctx, "Invalid exchange rate ID", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid exchange rate ID")
+		return
+	}
+
+	if err := ch.currencyUsecase.RemoveExchangeRate(ctx, uint(id)); err != nil {
+		logger.Error(ctx, "Error removing exchange rate", err.Error())
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.Success(c, nil, "Successfully removed exchange rate")
+}
@@ -0,0 +1,71 @@
+package currency_handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/dto/currencydto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateExchangeRate godoc
+// @Summary Update Exchange Rate
+// @Description Update an existing exchange rate (currency cannot be changed)
+// @Tags Currency
+// @Accept json
+// @Produce json
+// @Param id path int true "Exchange Rate ID"
+// @Param request body currencydto.UpdateExchangeRateRequest true "Update Exchange Rate Request"
+// @Security BearerAuth
+// @Success 200 {object} response.Response{data=currencydto.ExchangeRateResponse} "Successfully updated exchange rate"
+// @Router /currencies/exchange-rates/{id} [put]
+func (ch *CurrencyHandler) UpdateExchangeRate(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		logger.Error(ctx, "Invalid exchange rate ID", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid exchange rate ID")
+		return
+	}
+
+	var req currencydto.UpdateExchangeRateRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Error(ctx, "Error binding request", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	effectiveDate, err := time.Parse(time.DateOnly, req.EffectiveDate)
+	if err != nil {
+		logger.Error(ctx, "Invalid effective date", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid effective date, must be YYYY-MM-DD")
+		return
+	}
+
+	exchangeRate := &entity.ExchangeRate{
+		ID:            uint(id),
+		Rate:          req.Rate,
+		MarkupPercent: req.MarkupPercent,
+		EffectiveDate: effectiveDate,
+	}
+
+	updated, err := ch.currencyUsecase.UpdateExchangeRate(ctx, exchangeRate)
+	if err != nil {
+		logger.Error(ctx, "Error updating exchange rate", err.Error())
+		if utils.ParseValidationErrors(err) != nil {
+			response.ValidationError(c, utils.ParseValidationErrors(err))
+			return
+		}
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.Success(c, currencydto.ToExchangeRateResponse(updated), "Successfully updated exchange rate")
+}
@@ -15,7 +15,8 @@ import (
 // @Description Retrieve a list of email templates
 // @Tags Email
 // @Produce json
-// @Param type query string false "Type of email template (option: 'confirm', 'cancel')"
+// @Param type query string false "Type of email template (option: 'confirm', 'cancel', 'amend' or a template name, e.g. 'forgot_password')"
+// @Param locale query string false "Language variant (option: 'en', 'id', 'ko'), falls back to 'en' when the template has no variant in it"
 // @Success 200 {object} response.Response{data=[]emaildto.EmailTemplateResponse} "Successfully retrieved email templates"
 // @Router /email/template [get]
 // @Security BearerAuth
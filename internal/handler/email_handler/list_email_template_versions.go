@@ -0,0 +1,51 @@
+package email_handler
+
+import (
+	"net/http"
+	"wtm-backend/internal/dto/emaildto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListEmailTemplateVersions godoc
+// @Summary List Email Template Versions
+// @Description Retrieve the saved versions of an email template, newest first
+// @Tags Email
+// @Produce json
+// @Param type query string false "Type of email template (option: 'confirm', 'cancel', 'amend' or a template name, e.g. 'forgot_password')"
+// @Param locale query string false "Language variant (option: 'en', 'id', 'ko'), falls back to 'en' when the template has no variant in it"
+// @Success 200 {object} response.Response{data=[]emaildto.EmailTemplateVersionResponse} "Successfully retrieved email template versions"
+// @Router /email/template/versions [get]
+// @Security BearerAuth
+func (eh *EmailHandler) ListEmailTemplateVersions(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req emaildto.EmailTemplateRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Error(ctx, "Failed to bind request payload", err)
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Validation error", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	versions, err := eh.emailUsecase.ListEmailTemplateVersions(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Error getting email template versions:", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to get email template versions")
+		return
+	}
+
+	response.Success(c, versions, "Successfully retrieved email template versions")
+}
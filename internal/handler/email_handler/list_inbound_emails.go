@@ -0,0 +1,67 @@
+package email_handler
+
+import (
+	"net/http"
+	"wtm-backend/internal/dto/emaildto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListInboundEmails godoc
+// @Summary List Inbound Emails
+// @Description Retrieve a paginated list of the replies of hotels to booking emails, newest first.
+// @Tags Email
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number for pagination (default: 1)"
+// @Param limit query int false "Number of items per page"
+// @Param search query string false "Search by sender or subject"
+// @Param booking_code query string false "Booking code the reply was matched to"
+// @Param status query string false "unmatched, received, attached or applied"
+// @Security BearerAuth
+// @Success 200 {object} response.ResponseWithPagination{data=[]emaildto.InboundEmailResponse} "Successfully retrieved list of inbound emails"
+// @Router /email/inbound [get]
+func (eh *EmailHandler) ListInboundEmails(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req emaildto.ListInboundEmailsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Validation error:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := eh.emailUsecase.ListInboundEmails(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Error fetching inbound emails:", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to get list of inbound emails")
+		return
+	}
+
+	pagination := &response.Pagination{}
+	message := "Successfully retrieved list of inbound emails"
+
+	var inboundEmails []emaildto.InboundEmailResponse
+	if resp != nil {
+		inboundEmails = resp.InboundEmails
+		if len(resp.InboundEmails) == 0 {
+			message = "No inbound emails found"
+		}
+		pagination = response.NewPagination(req.Limit, req.Page, int(resp.Total))
+	}
+
+	response.SuccessWithPagination(c, inboundEmails, message, pagination)
+}
@@ -0,0 +1,55 @@
+package email_handler
+
+import (
+	"net/http"
+	"wtm-backend/internal/dto/emaildto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PreviewEmailTemplate godoc
+// @Summary Preview Email Template
+// @Description Render the current email template, or one of its versions, against sample data for the template type
+// @Tags Email
+// @Accept json
+// @Produce json
+// @Param request body emaildto.PreviewEmailTemplateRequest true "Preview Email Template Request"
+// @Security BearerAuth
+// @Success 200 {object} response.Response{data=emaildto.PreviewEmailTemplateResponse} "Successfully rendered email template"
+// @Router /email/template/preview [post]
+func (eh *EmailHandler) PreviewEmailTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req emaildto.PreviewEmailTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Validation error:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := eh.emailUsecase.PreviewEmailTemplate(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Error rendering email template:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed rendering email template")
+		return
+	}
+
+	response.Success(c, resp, "Successfully rendered email template")
+}
@@ -5,19 +5,20 @@ import (
 	"wtm-backend/internal/dto/emaildto"
 	"wtm-backend/internal/response"
 	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
 
 	"github.com/gin-gonic/gin"
 )
 
 // RetryEmail godoc
-// @Summary Retry Failed Email
-// @Description Retry sending a failed email
+// @Summary Retry Failed Emails
+// @Description Queue a failed email for retry by ID, or every email matching the filter (status Failed or Pending, email type, hotel, booking code, date range). Emails are retried through the SMTP route of their scope.
 // @Tags Email
 // @Accept json
 // @Produce json
 // @Param request body emaildto.RetryEmailRequest true "Retry Email Request"
 // @Security BearerAuth
-// @Success 200 {object} response.Response{data=emaildto.RetryEmailResponse} "Email retry initiated"
+// @Success 200 {object} response.Response{data=emaildto.RetryEmailResponse} "Emails queued for retry"
 // @Router /email/logs/retry [post]
 func (eh *EmailHandler) RetryEmail(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -29,6 +30,16 @@ func (eh *EmailHandler) RetryEmail(c *gin.Context) {
 		return
 	}
 
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Validation error:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
 	resp, err := eh.emailUsecase.RetryEmail(ctx, &req)
 	if err != nil {
 		logger.Error(ctx, "Error retrying email:", err.Error())
@@ -0,0 +1,55 @@
+package email_handler
+
+import (
+	"net/http"
+	"wtm-backend/internal/dto/emaildto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RollbackEmailTemplate godoc
+// @Summary Rollback Email Template
+// @Description Restore a version of an email template. The restored content is saved as a new version.
+// @Tags Email
+// @Accept json
+// @Produce json
+// @Param request body emaildto.RollbackEmailTemplateRequest true "Rollback Email Template Request"
+// @Security BearerAuth
+// @Success 200 {object} response.Response{data=emaildto.EmailTemplateVersionResponse} "Successfully rolled back email template"
+// @Router /email/template/rollback [post]
+func (eh *EmailHandler) RollbackEmailTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req emaildto.RollbackEmailTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Validation error:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := eh.emailUsecase.RollbackEmailTemplate(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Error rolling back email template:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed rolling back email template")
+		return
+	}
+
+	response.Success(c, resp, "Successfully rolled back email template")
+}
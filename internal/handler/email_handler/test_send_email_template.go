@@ -0,0 +1,55 @@
+package email_handler
+
+import (
+	"net/http"
+	"wtm-backend/internal/dto/emaildto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TestSendEmailTemplate godoc
+// @Summary Test Send Email Template
+// @Description Send the preview of an email template, or one of its versions, to the requesting admin
+// @Tags Email
+// @Accept json
+// @Produce json
+// @Param request body emaildto.PreviewEmailTemplateRequest true "Test Send Email Template Request"
+// @Security BearerAuth
+// @Success 200 {object} response.Response{data=emaildto.TestSendEmailTemplateResponse} "Successfully sent test email"
+// @Router /email/template/test-send [post]
+func (eh *EmailHandler) TestSendEmailTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req emaildto.PreviewEmailTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Validation error:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := eh.emailUsecase.TestSendEmailTemplate(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Error sending test email:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed sending test email")
+		return
+	}
+
+	response.Success(c, resp, "Successfully sent test email")
+}
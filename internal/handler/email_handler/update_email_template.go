@@ -12,15 +12,17 @@ import (
 
 // UpdateEmailTemplate godoc
 // @Summary Update Email Template
-// @Description Update an existing email template
+// @Description Update an existing email template and save it as a new version. Templates with placeholders unknown to their type are rejected.
 // @Tags Email
 // @Accept multipart/form-data
 // @Produce json
-// @Param type formData string true "Template Type"
+// @Param type formData string true "Template Type (option: 'confirm', 'cancel', 'amend' or a template name, e.g. 'forgot_password')"
+// @Param locale formData string false "Language variant (option: 'en', 'id', 'ko'), created from the 'en' variant when missing"
 // @Param subject formData string true "Template Subject"
 // @Param body formData string true "Template Body dalam html format"
 // @Param signature_text formData string false "Template Signature Text dalam html format"
 // @Param signature_image formData file false "Template Signature Image"
+// @Param note formData string false "Change note kept with the new version"
 // @Success 200 {object} response.Response "Successfully updated email template"
 // @Security BearerAuth
 // @Router /email/template [put]
@@ -49,6 +51,10 @@ func (eh *EmailHandler) UpdateEmailTemplate(c *gin.Context) {
 
 	if err := eh.emailUsecase.UpdateEmailTemplate(ctx, &req); err != nil {
 		logger.Error(ctx, "Error updating email template:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed updating email template")
 		return
 	}
@@ -0,0 +1,49 @@
+package hotel_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/hoteldto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// CreateCancellationPolicy godoc
+// @Summary Create Cancellation Policy
+// @Description Create a named cancellation policy with penalty tiers, e.g. free until 7 days before check-in, 50% until 2 days before.
+// @Tags Hotel
+// @Accept json
+// @Produce json
+// @Param request body hoteldto.UpsertCancellationPolicyRequest true "Cancellation policy details"
+// @Success 200 {object} response.Response "Successfully created cancellation policy"
+// @Security BearerAuth
+// @Router /hotels/cancellation-policies [post]
+func (hh *HotelHandler) CreateCancellationPolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req hoteldto.UpsertCancellationPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Error validating request:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	if err := hh.hotelUsecase.UpsertCancellationPolicy(ctx, &req, 0); err != nil {
+		logger.Error(ctx, "Error creating cancellation policy:", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Error creating cancellation policy")
+		return
+	}
+
+	response.Success(c, nil, "Successfully created cancellation policy")
+}
@@ -0,0 +1,49 @@
+package hotel_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/hoteldto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// CreateRoomRatePlan godoc
+// @Summary Create Room Rate Plan
+// @Description Create a seasonal rate plan layered over a room price, optionally limited to some days of the week.
+// @Tags Hotel
+// @Accept json
+// @Produce json
+// @Param request body hoteldto.UpsertRoomRatePlanRequest true "Rate plan details"
+// @Success 200 {object} response.Response "Successfully created rate plan"
+// @Security BearerAuth
+// @Router /hotels/rate-plans [post]
+func (hh *HotelHandler) CreateRoomRatePlan(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req hoteldto.UpsertRoomRatePlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Error validating request:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	if err := hh.hotelUsecase.UpsertRoomRatePlan(ctx, &req, 0); err != nil {
+		logger.Error(ctx, "Error creating rate plan:", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Error creating rate plan")
+		return
+	}
+
+	response.Success(c, nil, "Successfully created rate plan")
+}
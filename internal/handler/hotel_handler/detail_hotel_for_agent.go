@@ -3,6 +3,7 @@ package hotel_handler
 import (
 	"github.com/gin-gonic/gin"
 	"net/http"
+	"wtm-backend/internal/dto/hoteldto"
 	"wtm-backend/internal/response"
 	"wtm-backend/pkg/logger"
 	"wtm-backend/pkg/utils"
@@ -15,6 +16,8 @@ import (
 // @Accept       json
 // @Produce      json
 // @Param id path string true "Hotel Id"
+// @Param from query string false "Check-in date (YYYY-MM-DD), prices use the effective rate for the stay"
+// @Param to query string false "Check-out date (YYYY-MM-DD)"
 // @Success 200 {object} response.ResponseWithData{data=hoteldto.DetailHotelForAgentResponse} "Successfully retrieved hotel details"
 // @Security BearerAuth
 // @Router /hotels/agent/{id} [get]
@@ -36,7 +39,15 @@ func (hh *HotelHandler) DetailHotelForAgent(c *gin.Context) {
 		return
 	}
 
-	hotel, err := hh.hotelUsecase.DetailHotelForAgent(ctx, hotelIDUint)
+	var req hoteldto.DetailHotelForAgentRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(ctx, "Error binding request", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	req.HotelID = hotelIDUint
+
+	hotel, err := hh.hotelUsecase.DetailHotelForAgent(ctx, &req)
 	if err != nil {
 		logger.Error(ctx, "Error getting hotel by Id", err.Error())
 		response.Error(c, http.StatusInternalServerError, "Failed to get hotel details")
@@ -0,0 +1,35 @@
+package hotel_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+)
+
+// ListCancellationPolicies godoc
+// @Summary List Cancellation Policies
+// @Description Retrieve the named cancellation policies that can be attached to hotels and rate plans.
+// @Tags Hotel
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.ResponseWithData{data=[]entity.CancellationPolicy} "Successfully retrieved list of cancellation policies"
+// @Security BearerAuth
+// @Router /hotels/cancellation-policies [get]
+func (hh *HotelHandler) ListCancellationPolicies(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	resp, err := hh.hotelUsecase.ListCancellationPolicies(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error getting list cancellation policies", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to get list cancellation policies")
+		return
+	}
+
+	message := "Successfully retrieved list of cancellation policies"
+	if len(resp.CancellationPolicies) == 0 {
+		message = "No cancellation policies found"
+	}
+
+	response.Success(c, resp.CancellationPolicies, message)
+}
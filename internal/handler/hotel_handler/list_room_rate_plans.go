@@ -0,0 +1,55 @@
+package hotel_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/hoteldto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// ListRoomRatePlans godoc
+// @Summary List Room Rate Plans
+// @Description Retrieve the seasonal rate plans of a room type.
+// @Tags Hotel
+// @Accept json
+// @Produce json
+// @Param room_type_id query uint true "Room Type Id"
+// @Success 200 {object} response.ResponseWithData{data=[]hoteldto.RoomRatePlan} "Successfully retrieved list of rate plans"
+// @Security BearerAuth
+// @Router /hotels/rate-plans [get]
+func (hh *HotelHandler) ListRoomRatePlans(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req hoteldto.ListRoomRatePlanRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Error(ctx, "Error binding request", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Validation error:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	resp, err := hh.hotelUsecase.ListRoomRatePlans(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "Error getting list rate plans", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to get list rate plans")
+		return
+	}
+
+	message := "Successfully retrieved list of rate plans"
+	if len(resp.RatePlans) == 0 {
+		message = "No rate plans found"
+	}
+
+	response.Success(c, resp.RatePlans, message)
+}
@@ -0,0 +1,38 @@
+package hotel_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// RemoveCancellationPolicy godoc
+// @Summary Remove Cancellation Policy
+// @Description Remove a cancellation policy by its Id. Hotels and rate plans using it fall back to the hotel cancellation period.
+// @Tags Hotel
+// @Accept json
+// @Produce json
+// @Param id path uint true "Cancellation Policy Id"
+// @Success 200 {object} response.Response "Successfully removed cancellation policy"
+// @Security BearerAuth
+// @Router /hotels/cancellation-policies/{id} [delete]
+func (hh *HotelHandler) RemoveCancellationPolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	policyID, err := utils.StringToUint(c.Param("id"))
+	if err != nil || policyID == 0 {
+		logger.Error(ctx, "Invalid cancellation policy Id format")
+		response.Error(c, http.StatusBadRequest, "Invalid cancellation policy Id format")
+		return
+	}
+
+	if err := hh.hotelUsecase.RemoveCancellationPolicy(ctx, policyID); err != nil {
+		logger.Error(ctx, "Error removing cancellation policy", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to remove cancellation policy")
+		return
+	}
+
+	response.Success(c, nil, "Successfully removed cancellation policy")
+}
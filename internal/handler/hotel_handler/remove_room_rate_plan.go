@@ -0,0 +1,38 @@
+package hotel_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// RemoveRoomRatePlan godoc
+// @Summary Remove Room Rate Plan
+// @Description Remove a seasonal rate plan by its Id.
+// @Tags Hotel
+// @Accept json
+// @Produce json
+// @Param id path uint true "Rate Plan Id"
+// @Success 200 {object} response.Response "Successfully removed rate plan"
+// @Security BearerAuth
+// @Router /hotels/rate-plans/{id} [delete]
+func (hh *HotelHandler) RemoveRoomRatePlan(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	ratePlanID, err := utils.StringToUint(c.Param("id"))
+	if err != nil || ratePlanID == 0 {
+		logger.Error(ctx, "Invalid rate plan Id format")
+		response.Error(c, http.StatusBadRequest, "Invalid rate plan Id format")
+		return
+	}
+
+	if err := hh.hotelUsecase.RemoveRoomRatePlan(ctx, ratePlanID); err != nil {
+		logger.Error(ctx, "Error removing rate plan", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to remove rate plan")
+		return
+	}
+
+	response.Success(c, nil, "Successfully removed rate plan")
+}
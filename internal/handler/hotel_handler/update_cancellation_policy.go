@@ -0,0 +1,57 @@
+package hotel_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/hoteldto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// UpdateCancellationPolicy godoc
+// @Summary Update Cancellation Policy
+// @Description Update an existing cancellation policy. Bookings already checked out keep the policy snapshot taken at checkout.
+// @Tags Hotel
+// @Accept json
+// @Produce json
+// @Param id path uint true "Cancellation Policy Id"
+// @Param request body hoteldto.UpsertCancellationPolicyRequest true "Cancellation policy details"
+// @Success 200 {object} response.Response "Successfully updated cancellation policy"
+// @Security BearerAuth
+// @Router /hotels/cancellation-policies/{id} [put]
+func (hh *HotelHandler) UpdateCancellationPolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	policyID, err := utils.StringToUint(c.Param("id"))
+	if err != nil || policyID == 0 {
+		logger.Error(ctx, "Invalid cancellation policy Id format")
+		response.Error(c, http.StatusBadRequest, "Invalid cancellation policy Id format")
+		return
+	}
+
+	var req hoteldto.UpsertCancellationPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Error validating request:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	if err := hh.hotelUsecase.UpsertCancellationPolicy(ctx, &req, policyID); err != nil {
+		logger.Error(ctx, "Error updating cancellation policy:", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Error updating cancellation policy")
+		return
+	}
+
+	response.Success(c, nil, "Successfully updated cancellation policy")
+}
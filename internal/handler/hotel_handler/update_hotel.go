@@ -22,6 +22,7 @@ import (
 // @Param district formData string true "District location"
 // @Param province formData string true "Province location"
 // @Param email formData string true "Contact email"
+// @Param language formData string false "Preferred language of emails (en, id, ko)"
 // @Param description formData string false "Hotel description"
 // @Param rating formData int false "Hotel rating (1–5)"
 // @Param nearby_places formData string false "Nearby places as JSON string. Example: /example_nearby_places "
@@ -0,0 +1,49 @@
+package hotel_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/hoteldto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// UpdateHotelCancellationPolicy godoc
+// @Summary Update Hotel Cancellation Policy
+// @Description Attach a cancellation policy to a hotel, null falls back to the hotel cancellation period.
+// @Tags Hotel
+// @Accept json
+// @Produce json
+// @Param request body hoteldto.UpdateHotelCancellationPolicyRequest true "Hotel cancellation policy"
+// @Success 200 {object} response.Response "Successfully updated hotel cancellation policy"
+// @Security BearerAuth
+// @Router /hotels/cancellation-policy [put]
+func (hh *HotelHandler) UpdateHotelCancellationPolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req hoteldto.UpdateHotelCancellationPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Validation error", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	if err := hh.hotelUsecase.UpdateHotelCancellationPolicy(ctx, &req); err != nil {
+		logger.Error(ctx, "Failed to update hotel cancellation policy", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to update hotel cancellation policy")
+		return
+	}
+
+	response.Success(c, nil, "Successfully updated hotel cancellation policy")
+}
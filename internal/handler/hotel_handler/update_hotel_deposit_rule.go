@@ -0,0 +1,49 @@
+package hotel_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/hoteldto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// UpdateHotelDepositRule godoc
+// @Summary Update Hotel Deposit Rule
+// @Description Set the deposit percentage of a hotel and the days before check-in the balance is due.
+// @Tags Hotel
+// @Accept json
+// @Produce json
+// @Param request body hoteldto.UpdateHotelDepositRuleRequest true "Hotel deposit rule"
+// @Success 200 {object} response.Response "Successfully updated hotel deposit rule"
+// @Security BearerAuth
+// @Router /hotels/deposit-rule [put]
+func (hh *HotelHandler) UpdateHotelDepositRule(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req hoteldto.UpdateHotelDepositRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Validation error", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	if err := hh.hotelUsecase.UpdateHotelDepositRule(ctx, &req); err != nil {
+		logger.Error(ctx, "Failed to update hotel deposit rule", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to update hotel deposit rule")
+		return
+	}
+
+	response.Success(c, nil, "Successfully updated hotel deposit rule")
+}
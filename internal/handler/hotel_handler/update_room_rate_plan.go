@@ -0,0 +1,57 @@
+package hotel_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/hoteldto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// UpdateRoomRatePlan godoc
+// @Summary Update Room Rate Plan
+// @Description Update an existing seasonal rate plan.
+// @Tags Hotel
+// @Accept json
+// @Produce json
+// @Param id path uint true "Rate Plan Id"
+// @Param request body hoteldto.UpsertRoomRatePlanRequest true "Rate plan details"
+// @Success 200 {object} response.Response "Successfully updated rate plan"
+// @Security BearerAuth
+// @Router /hotels/rate-plans/{id} [put]
+func (hh *HotelHandler) UpdateRoomRatePlan(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	ratePlanID, err := utils.StringToUint(c.Param("id"))
+	if err != nil || ratePlanID == 0 {
+		logger.Error(ctx, "Invalid rate plan Id format")
+		response.Error(c, http.StatusBadRequest, "Invalid rate plan Id format")
+		return
+	}
+
+	var req hoteldto.UpsertRoomRatePlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Error validating request:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	if err := hh.hotelUsecase.UpsertRoomRatePlan(ctx, &req, ratePlanID); err != nil {
+		logger.Error(ctx, "Error updating rate plan:", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Error updating rate plan")
+		return
+	}
+
+	response.Success(c, nil, "Successfully updated rate plan")
+}
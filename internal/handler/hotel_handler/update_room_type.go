@@ -59,6 +59,10 @@ func (hh *HotelHandler) UpdateRoomType(c *gin.Context) {
 
 	if err := hh.hotelUsecase.UpdateRoomType(ctx, &req); err != nil {
 		logger.Error(ctx, "Failed to update room type", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed to update room type")
 		return
 	}
@@ -0,0 +1,29 @@
+package notification_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+)
+
+// GetNotificationSettings godoc
+// @Summary Get notification settings
+// @Description Get the settings matrix of the notification types of the user's role per channel, with its daily digest, quiet hours and timezone
+// @Tags Notifications
+// @Produce json
+// @Success 200 {object} response.Response{data=notifdto.NotificationSettingsResponse} "Successfully retrieved notification settings"
+// @Security BearerAuth
+// @Router /notifications/settings [get]
+func (nh *NotificationHandler) GetNotificationSettings(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	resp, err := nh.notifUsecase.GetNotificationSettings(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error getting notification settings:", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to get notification settings")
+		return
+	}
+
+	response.Success(c, resp, "Successfully retrieved notification settings")
+}
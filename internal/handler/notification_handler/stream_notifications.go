@@ -0,0 +1,78 @@
+package notification_handler
+
+import (
+	"io"
+	"net/http"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// streamHeartbeat keeps proxies from closing an idle stream
+const streamHeartbeat = 25 * time.Second
+
+// StreamNotifications godoc
+// @Summary Stream Notifications
+// @Description Server-sent events of the notifications of the user: an unread_count event on connect and whenever notifications are read,
+// @Description a notification event with the notification and the unread count whenever one is created.
+// @Description EventSource cannot send headers, the access token may be passed in the access_token query parameter instead.
+// @Tags Notifications
+// @Produce text/event-stream
+// @Param access_token query string false "Access token, when the Authorization header cannot be set"
+// @Success 200 {object} entity.NotificationEvent "Stream of notification events"
+// @Security BearerAuth
+// @Router /notifications/stream [get]
+func (nh *NotificationHandler) StreamNotifications(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	stream, err := nh.notifUsecase.StreamNotifications(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error streaming notifications:", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to stream notifications")
+		return
+	}
+	defer stream.Close()
+
+	// The stream outlives the write timeout of the server
+	if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		logger.Warn(ctx, "Failed to clear write deadline of notification stream:", err.Error())
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	c.SSEvent(constant.NotificationEventUnreadCount, entity.NotificationEvent{
+		Type:        constant.NotificationEventUnreadCount,
+		UnreadCount: stream.UnreadCount,
+	})
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case event, ok := <-stream.Events:
+			if !ok {
+				return false
+			}
+			c.SSEvent(event.Type, event)
+			return true
+		case <-heartbeat.C:
+			if !stream.SessionActive(ctx) {
+				logger.Info(ctx, "Session of notification stream is no longer active, closing stream")
+				return false
+			}
+			_, err := io.WriteString(w, ": ping\n\n")
+			return err == nil
+		}
+	})
+}
@@ -11,7 +11,7 @@ import (
 
 // UpdateNotificationSettings godoc
 // @Summary Update notification settings
-// @Description Update user's notification settings
+// @Description Update user's notification settings: the channel and type matrix, the daily digest, the quiet hours and the timezone. Fields left out are kept.
 // @Tags Notifications
 // @Accept json
 // @Produce json
@@ -43,6 +43,10 @@ func (nh *NotificationHandler) UpdateNotificationSettings(c *gin.Context) {
 
 	if err := nh.notifUsecase.UpdateNotificationSetting(ctx, &req); err != nil {
 		logger.Error(ctx, "Error updating notification settings:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed to update notification settings")
 		return
 	}
@@ -0,0 +1,29 @@
+package user_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+)
+
+// ListTwoFactorPolicies godoc
+// @Summary Get two-factor policies
+// @Description Retrieve whether two-factor authentication is mandatory for the admin and super admin roles.
+// @Tags User
+// @Produce json
+// @Success 200 {object} response.ResponseWithData{data=[]userdto.TwoFactorPolicyResponse} "Successfully retrieved two-factor policies"
+// @Security BearerAuth
+// @Router /role-access/two-factor [get]
+func (uh *UserHandler) ListTwoFactorPolicies(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	data, err := uh.userUsecase.ListTwoFactorPolicies(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error getting two-factor policies", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to fetch two-factor policies")
+		return
+	}
+
+	response.Success(c, data, "Successfully retrieved two-factor policies")
+}
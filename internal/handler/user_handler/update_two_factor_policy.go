@@ -0,0 +1,51 @@
+package user_handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"wtm-backend/internal/dto/userdto"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// UpdateTwoFactorPolicy godoc
+// @Summary Update two-factor policy
+// @Description Make two-factor authentication mandatory or optional for the admin or super admin role.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param request body userdto.UpdateTwoFactorPolicyRequest true "Two-factor policy of the role"
+// @Success 200 {object} response.Response "Successfully updated two-factor policy"
+// @Security BearerAuth
+// @Router /role-access/two-factor [put]
+func (uh *UserHandler) UpdateTwoFactorPolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req userdto.UpdateTwoFactorPolicyRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Error(ctx, "Error binding request:", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.Error(ctx, "Error validating request:", err.Error())
+		if ve := utils.ParseValidationErrors(err); ve != nil {
+			response.ValidationError(c, ve)
+			return
+		}
+
+		logger.Error(ctx, "Unexpected validation error", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	if err := uh.userUsecase.UpdateTwoFactorPolicy(ctx, &req); err != nil {
+		logger.Error(ctx, "Error updating two-factor policy", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to update two-factor policy")
+		return
+	}
+
+	response.Success(c, nil, "Successfully updated two-factor policy")
+}
@@ -24,6 +24,7 @@ import (
 // @Param phone formData string true "Phone"
 // @Param currency formData string false "Currency (e.g., IDR, USD)"
 // @Param kakao_talk_id formData string false "Kakao Talk Id"
+// @Param language formData string false "Preferred language of emails (en, id, ko)"
 // @Param promo_group_id formData int false "Promo Group ID (required if role is agent)"
 // @Param agent_company formData string false "Agent Company (required if role is agent)"
 // @Param certificate formData file false "Certificate (optional)"
@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+// notificationBuffer is how many events a slow client may fall behind before events are dropped,
+// it catches up with the next unread count
+const notificationBuffer = 16
+
+// NotificationHub holds one Redis subscription per replica and fans the notification events out to the clients connected to it
+type NotificationHub struct {
+	redis *RedisClient
+
+	mu          sync.Mutex
+	subscribers map[uint]map[chan entity.NotificationEvent]struct{}
+	closed      bool
+}
+
+func NewNotificationHub(redis *RedisClient) *NotificationHub {
+	return &NotificationHub{
+		redis:       redis,
+		subscribers: make(map[uint]map[chan entity.NotificationEvent]struct{}),
+	}
+}
+
+// Run dispatches the published events until ctx is done
+func (h *NotificationHub) Run(ctx context.Context) {
+	pubsub := h.redis.PSubscribe(ctx, constant.NotificationChannelPrefix+"*")
+	defer func() {
+		if err := pubsub.Close(); err != nil {
+			logger.Error(ctx, "[notification-hub] failed to close subscription", err.Error())
+		}
+	}()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+			var event entity.NotificationEvent
+			if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+				logger.Error(ctx, "[notification-hub] invalid event", err.Error())
+				continue
+			}
+			h.dispatch(event)
+		}
+	}
+}
+
+func (h *NotificationHub) dispatch(event entity.NotificationEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for events := range h.subscribers[event.UserID] {
+		select {
+		case events <- event:
+		default:
+			// Never block the other clients on a slow one
+		}
+	}
+}
+
+func (h *NotificationHub) Subscribe(userID uint) (<-chan entity.NotificationEvent, func()) {
+	events := make(chan entity.NotificationEvent, notificationBuffer)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		close(events)
+		return events, func() {}
+	}
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = make(map[chan entity.NotificationEvent]struct{})
+	}
+	h.subscribers[userID][events] = struct{}{}
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			if _, ok := h.subscribers[userID][events]; !ok {
+				return // Already closed by Close
+			}
+			delete(h.subscribers[userID], events)
+			if len(h.subscribers[userID]) == 0 {
+				delete(h.subscribers, userID)
+			}
+			close(events)
+		})
+	}
+	return events, unsubscribe
+}
+
+// Close ends the streams of every connected client, so a graceful shutdown does not wait for them
+func (h *NotificationHub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for userID, subscribers := range h.subscribers {
+		for events := range subscribers {
+			close(events)
+		}
+		delete(h.subscribers, userID)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"github.com/redis/go-redis/v9"
+	"slices"
+	"strconv"
 	"time"
 	"wtm-backend/config"
 	"wtm-backend/pkg/constant"
@@ -103,3 +105,135 @@ func getEndOfDayTTL() time.Duration {
 func (r *RedisClient) TTL(ctx context.Context, key string) (time.Duration, error) {
 	return r.Client.TTL(ctx, key).Result()
 }
+
+// Increment increments the counter key and starts its window on the first increment
+func (r *RedisClient) Increment(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	pipe := r.Client.TxPipeline()
+
+	count := pipe.Incr(ctx, key)
+	ttl := pipe.TTL(ctx, key)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		logger.Error(ctx, "Error incrementing counter in Redis", err.Error())
+		return 0, 0, err
+	}
+
+	// Counter baru (atau yang kehilangan expiry-nya) mulai dihitung dari sekarang
+	if ttl.Val() < 0 {
+		if err := r.Client.Expire(ctx, key, window).Err(); err != nil {
+			logger.Error(ctx, "Error setting expiry of counter in Redis", err.Error())
+			return 0, 0, err
+		}
+		return count.Val(), window, nil
+	}
+
+	return count.Val(), ttl.Val(), nil
+}
+
+// addHoldsScript prunes the expired holds of every key (sorted sets scored by expiry) and adds the members to all of
+// them only when each key keeps within its limit, so concurrent holds cannot take more units than are left.
+// KEYS are the sorted sets, ARGV is now, expiresAt, the number of keys, the limit of every key, then the members.
+// It returns the index (1-based) of the first key without room and the units it has left, or {0, 0} once held.
+var addHoldsScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local expiresAt = tonumber(ARGV[2])
+local keyCount = tonumber(ARGV[3])
+local memberCount = #ARGV - 3 - keyCount
+
+for i = 1, keyCount do
+	redis.call('ZREMRANGEBYSCORE', KEYS[i], '-inf', now)
+	local left = tonumber(ARGV[3 + i]) - redis.call('ZCARD', KEYS[i])
+	if left < memberCount then
+		return {i, math.max(left, 0)}
+	end
+end
+
+for i = 1, keyCount do
+	for m = 1, memberCount do
+		redis.call('ZADD', KEYS[i], expiresAt, ARGV[3 + keyCount + m])
+	end
+	redis.call('EXPIREAT', KEYS[i], expiresAt)
+end
+return {0, 0}
+`)
+
+// AddHoldsWithinLimit adds members to every key in one atomic step, provided no key ends up with more active holds
+// than its limit. It returns the index of the first key without room and the units it has left, or -1 once held.
+func (r *RedisClient) AddHoldsWithinLimit(ctx context.Context, keys []string, limits []int, members []string, expiresAt time.Time) (int, int, error) {
+	args := make([]interface{}, 0, 3+len(limits)+len(members))
+	args = append(args, time.Now().Unix(), expiresAt.Unix(), len(keys))
+	for _, limit := range limits {
+		args = append(args, limit)
+	}
+	for _, member := range members {
+		args = append(args, member)
+	}
+
+	result, err := addHoldsScript.Run(ctx, r.Client, keys, args...).Int64Slice()
+	if err != nil {
+		logger.Error(ctx, "Error adding holds in Redis", err.Error())
+		return 0, 0, err
+	}
+	return int(result[0]) - 1, int(result[1]), nil
+}
+
+func (r *RedisClient) RemoveHold(ctx context.Context, key string, member string) error {
+	if err := r.Client.ZRem(ctx, key, member).Err(); err != nil {
+		logger.Error(ctx, "Error removing hold from Redis", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (r *RedisClient) GetHoldExpiry(ctx context.Context, key string, member string) (time.Time, error) {
+	score, err := r.Client.ZScore(ctx, key, member).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return time.Time{}, nil
+		}
+		logger.Error(ctx, "Error getting hold expiry from Redis", err.Error())
+		return time.Time{}, err
+	}
+
+	expiresAt := time.Unix(int64(score), 0)
+	if !expiresAt.After(time.Now()) {
+		return time.Time{}, nil
+	}
+	return expiresAt, nil
+}
+
+func (r *RedisClient) CountActiveHolds(ctx context.Context, key string, excludeMembers []string) (int, error) {
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+
+	if err := r.Client.ZRemRangeByScore(ctx, key, "-inf", now).Err(); err != nil {
+		logger.Error(ctx, "Error pruning expired holds in Redis", err.Error())
+		return 0, err
+	}
+
+	members, err := r.Client.ZRangeByScore(ctx, key, &redis.ZRangeBy{Min: "(" + now, Max: "+inf"}).Result()
+	if err != nil {
+		logger.Error(ctx, "Error counting holds in Redis", err.Error())
+		return 0, err
+	}
+
+	count := 0
+	for _, member := range members {
+		if !slices.Contains(excludeMembers, member) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *RedisClient) Publish(ctx context.Context, channel string, message interface{}) error {
+	if err := r.Client.Publish(ctx, channel, message).Err(); err != nil {
+		logger.Error(ctx, "Error publishing message to Redis", err.Error())
+		return err
+	}
+	return nil
+}
+
+// PSubscribe subscribes to the channels matching pattern, the subscription reconnects by itself until it is closed
+func (r *RedisClient) PSubscribe(ctx context.Context, pattern string) *redis.PubSub {
+	return r.Client.PSubscribe(ctx, pattern)
+}
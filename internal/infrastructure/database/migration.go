@@ -9,7 +9,10 @@ import (
 	"wtm-backend/internal/infrastructure/database/model"
 	"wtm-backend/internal/infrastructure/database/seed"
 	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/currency"
 	"wtm-backend/pkg/logger"
+
+	"github.com/lib/pq"
 )
 
 func (dbs *DBPostgre) runMigrations(ctx context.Context, cfg *config.Config) error {
@@ -34,6 +37,10 @@ func (dbs *DBPostgre) runMigrations(ctx context.Context, cfg *config.Config) err
 		&model.OtherPreference{},
 		&model.RoomTypePreference{},
 		&model.RoomUnavailable{},
+		&model.RoomInventory{},
+		&model.RoomRatePlan{},
+		&model.RoomRestriction{},
+		&model.CancellationPolicy{},
 		&model.PromoType{},
 		&model.Promo{},
 		&model.PromoGroup{},
@@ -51,15 +58,28 @@ func (dbs *DBPostgre) runMigrations(ctx context.Context, cfg *config.Config) err
 		&model.Booking{},
 		&model.BookingDetail{},
 		&model.BookingDetailAdditional{},
+		&model.BookingDetailRevision{},
+		&model.Payment{},
 		&model.BookingGuest{},
 		&model.EmailTemplate{},
+		&model.EmailTemplateVersion{},
 		&model.Notification{},
 		&model.UserNotificationSetting{},
+		&model.UserNotificationPreference{},
+		&model.NotificationDigestItem{},
 		&model.PasswordResetToken{},
+		&model.UserSession{},
+		&model.RefreshToken{},
+		&model.UserTwoFactor{},
+		&model.UserRecoveryCode{},
+		&model.UserAPIKey{},
 		&model.StatusEmail{},
 		&model.EmailLog{},
+		&model.EmailOutbox{},
+		&model.InboundEmail{},
 		&model.Invoice{},
 		&model.Currency{},
+		&model.ExchangeRate{},
 	}
 
 	if err := dbs.DB.AutoMigrate(models...); err != nil {
@@ -115,6 +135,30 @@ func (dbs *DBPostgre) runMigrations(ctx context.Context, cfg *config.Config) err
 		return fmt.Errorf("multi-currency migration: %w", err)
 	}
 
+	// ✅ Migrate money amounts to the decimal places of their currency
+	if err := dbs.migrateMoneyAmounts(ctx); err != nil {
+		logger.Error(ctx, "Money amounts migration failed", err.Error())
+		return fmt.Errorf("money amounts migration: %w", err)
+	}
+
+	// ✅ Migrate EmailTemplate to one row per name and locale
+	if err := dbs.migrateEmailTemplateLocale(ctx); err != nil {
+		logger.Error(ctx, "EmailTemplate locale migration failed", err.Error())
+		return fmt.Errorf("email_template locale migration: %w", err)
+	}
+
+	// ✅ Migrate notification settings to the per-event types of each role
+	if err := dbs.migrateNotificationSettings(ctx); err != nil {
+		logger.Error(ctx, "Notification settings migration failed", err.Error())
+		return fmt.Errorf("notification settings migration: %w", err)
+	}
+
+	// ✅ Migrate settings permissions of the Admin and Support roles
+	if err := dbs.migrateSettingsPermissions(ctx); err != nil {
+		logger.Error(ctx, "Settings permissions migration failed", err.Error())
+		return fmt.Errorf("settings permissions migration: %w", err)
+	}
+
 	logger.Info(ctx, "Database migration completed",
 		fmt.Sprintf("models: %d", len(models)))
 
@@ -960,3 +1004,171 @@ func (dbs *DBPostgre) migratePromoDetailStructure(ctx context.Context) error {
 	logger.Info(ctx, "✓ Successfully migrated promo detail structure")
 	return nil
 }
+
+// migrateMoneyAmounts rounds the float era amounts to the decimal places of their currency.
+// AutoMigrate already turned booking_details.price and room_prices.price into decimal(20,2),
+// this step drops the fractions of zero-decimal currencies (IDR, JPY, ...) and rounds the invoice totals.
+// Amounts inside the jsonb snapshots are rounded when read, see currency.Money.
+func (dbs *DBPostgre) migrateMoneyAmounts(ctx context.Context) error {
+	logger.Info(ctx, "Starting money amounts migration")
+
+	zeroDecimalCurrencies := currency.ZeroDecimalCurrencies()
+
+	roundBookingDetailsSQL := `
+		UPDATE booking_details
+		SET price = ROUND(price)
+		WHERE COALESCE(currency, 'IDR') IN ? AND price <> ROUND(price)
+	`
+	if err := dbs.DB.Exec(roundBookingDetailsSQL, zeroDecimalCurrencies).Error; err != nil {
+		return fmt.Errorf("failed to round booking_details price: %w", err)
+	}
+
+	roundAdditionalsSQL := `
+		UPDATE booking_detail_additionals bda
+		SET price = ROUND(bda.price)
+		FROM booking_details bd
+		WHERE bd.id = bda.booking_detail_id
+		  AND COALESCE(bd.currency, 'IDR') IN ?
+		  AND bda.price IS NOT NULL AND bda.price <> ROUND(bda.price)
+	`
+	if err := dbs.DB.Exec(roundAdditionalsSQL, zeroDecimalCurrencies).Error; err != nil {
+		return fmt.Errorf("failed to round booking_detail_additionals price: %w", err)
+	}
+
+	roundInvoicesSQL := `
+		UPDATE invoices
+		SET detail = jsonb_set(detail, '{total_price}', to_jsonb(ROUND((detail->>'total_price')::numeric,
+			CASE WHEN COALESCE(NULLIF(detail->>'currency', ''), 'IDR') IN ? THEN 0 ELSE 2 END)))
+		WHERE jsonb_typeof(detail->'total_price') = 'number'
+	`
+	if err := dbs.DB.Exec(roundInvoicesSQL, zeroDecimalCurrencies).Error; err != nil {
+		return fmt.Errorf("failed to round invoices total_price: %w", err)
+	}
+
+	logger.Info(ctx, "✓ Successfully migrated money amounts")
+	return nil
+}
+
+// migrateEmailTemplateLocale drops the old unique index on the template name, replaced by the name and locale index
+func (dbs *DBPostgre) migrateEmailTemplateLocale(ctx context.Context) error {
+	logger.Info(ctx, "Starting EmailTemplate locale migration")
+
+	if err := dbs.DB.Exec(`DROP INDEX IF EXISTS idx_email_templates_name`).Error; err != nil {
+		return fmt.Errorf("failed to drop idx_email_templates_name: %w", err)
+	}
+
+	setDefaultLocaleSQL := `
+		UPDATE email_templates
+		SET locale = ?
+		WHERE locale IS NULL OR locale = ''
+	`
+	if err := dbs.DB.Exec(setDefaultLocaleSQL, constant.DefaultLocale).Error; err != nil {
+		return fmt.Errorf("failed to set default locale of email_templates: %w", err)
+	}
+
+	logger.Info(ctx, "✓ Successfully migrated EmailTemplate locale")
+	return nil
+}
+
+// migrateNotificationSettings renames the agent settings of the former booking and reject types to the confirmed and
+// rejected events, then enables the event notifications of their role for the users created before they existed.
+// Settings a user already has are left untouched
+func (dbs *DBPostgre) migrateNotificationSettings(ctx context.Context) error {
+	logger.Info(ctx, "Starting notification settings migration")
+
+	renames := map[string]string{
+		constant.ConstBooking: constant.ConstBookingConfirmed,
+		constant.ConstReject:  constant.ConstBookingRejected,
+	}
+	for from, to := range renames {
+		renameSQL := `
+			UPDATE user_notification_settings s SET type = ?, updated_at = NOW()
+			WHERE s.type = ?
+			AND NOT EXISTS (
+				SELECT 1 FROM user_notification_settings o
+				WHERE o.user_id = s.user_id AND o.channel = s.channel AND o.type = ? AND o.deleted_at IS NULL
+			)
+		`
+		if err := dbs.DB.Exec(renameSQL, to, from, to).Error; err != nil {
+			return fmt.Errorf("failed to rename %s notification settings: %w", from, err)
+		}
+	}
+
+	insertSettingsSQL := `
+		INSERT INTO user_notification_settings (created_at, updated_at, external_id, user_id, channel, type, is_enabled)
+		SELECT NOW(), NOW(), gen_random_uuid()::text, u.id, c.channel, t.type, true
+		FROM users u
+		CROSS JOIN unnest(?::text[]) AS c(channel)
+		CROSS JOIN unnest(?::text[]) AS t(type)
+		WHERE (u.role_id = ?) = ? AND u.deleted_at IS NULL
+		AND NOT EXISTS (
+			SELECT 1 FROM user_notification_settings s
+			WHERE s.user_id = u.id AND s.channel = c.channel AND s.type = t.type AND s.deleted_at IS NULL
+		)
+	`
+	channels := pq.StringArray{constant.ConstEmail, constant.ConstWeb}
+	var added int64
+	for _, isAgent := range []bool{true, false} {
+		types := pq.StringArray(constant.AgentNotificationTypes)
+		if !isAgent {
+			types = pq.StringArray(constant.AdminNotificationTypes)
+		}
+		result := dbs.DB.Exec(insertSettingsSQL, channels, types, constant.RoleAgentID, isAgent)
+		if result.Error != nil {
+			return fmt.Errorf("failed to insert notification settings: %w", result.Error)
+		}
+		added += result.RowsAffected
+	}
+
+	logger.Info(ctx, fmt.Sprintf("✓ Successfully migrated notification settings, %d rows added", added))
+	return nil
+}
+
+// migrateSettingsPermissions adds the settings permissions to databases seeded before they existed and grants them
+// as the seed does: view, create and edit to Admin, view and edit to Support. A fresh database is left to the seed
+func (dbs *DBPostgre) migrateSettingsPermissions(ctx context.Context) error {
+	logger.Info(ctx, "Starting settings permissions migration")
+
+	insertPermissionsSQL := `
+		INSERT INTO permissions (created_at, updated_at, external_id, permission, page, action)
+		SELECT NOW(), NOW(), gen_random_uuid()::text, 'settings:' || a.action, 'settings', a.action
+		FROM unnest(?::text[]) AS a(action)
+		WHERE EXISTS (SELECT 1 FROM permissions WHERE deleted_at IS NULL)
+		AND NOT EXISTS (
+			SELECT 1 FROM permissions p WHERE p.permission = 'settings:' || a.action AND p.deleted_at IS NULL
+		)
+	`
+	actions := pq.StringArray{"view", "create", "edit", "delete"}
+	result := dbs.DB.Exec(insertPermissionsSQL, actions)
+	if result.Error != nil {
+		return fmt.Errorf("failed to insert settings permissions: %w", result.Error)
+	}
+	added := result.RowsAffected
+
+	grantSQL := `
+		INSERT INTO role_permissions (created_at, updated_at, role_id, permission_id)
+		SELECT NOW(), NOW(), r.id, p.id
+		FROM roles r
+		JOIN permissions p ON p.page = 'settings' AND p.action = ANY(?::text[]) AND p.deleted_at IS NULL
+		WHERE r.id = ? AND r.deleted_at IS NULL
+		AND NOT EXISTS (
+			SELECT 1 FROM role_permissions rp
+			WHERE rp.role_id = r.id AND rp.permission_id = p.id AND rp.deleted_at IS NULL
+		)
+	`
+	grants := map[uint]pq.StringArray{
+		constant.RoleAdminID:   {"view", "create", "edit"},
+		constant.RoleSupportID: {"view", "edit"},
+	}
+	var granted int64
+	for roleID, roleActions := range grants {
+		result := dbs.DB.Exec(grantSQL, roleActions, roleID)
+		if result.Error != nil {
+			return fmt.Errorf("failed to grant settings permissions to role %d: %w", roleID, result.Error)
+		}
+		granted += result.RowsAffected
+	}
+
+	logger.Info(ctx, fmt.Sprintf("✓ Successfully migrated settings permissions, %d added, %d granted", added, granted))
+	return nil
+}
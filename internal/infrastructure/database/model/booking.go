@@ -2,6 +2,7 @@ package model
 
 import (
 	"time"
+	"wtm-backend/pkg/currency"
 
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
@@ -67,8 +68,17 @@ type BookingDetail struct {
 	DetailRoom  datatypes.JSON `gorm:"type:jsonb"` // snapshot of room details
 
 	// Pricing
-	Price    float64 `gorm:"type:float"`
-	Currency string  `json:"currency" gorm:"type:varchar(3);default:'IDR'"` // Snapshot of currency at booking time
+	Price    currency.Money `gorm:"type:decimal(20,2)"`                            // Rounded to the decimal places of Currency
+	Currency string         `json:"currency" gorm:"type:varchar(3);default:'IDR'"` // Snapshot of currency at booking time
+
+	// Exchange rate snapshot, IDR per 1 unit of Currency used to derive prices not entered in Currency (0 = not used)
+	ExchangeRate       float64 `gorm:"type:float;default:0"`
+	ExchangeRateMarkup float64 `gorm:"type:float;default:0"` // Markup percentage applied on top of the converted price
+
+	// Cancellation penalty, recorded when the booking is cancelled
+	CancellationPenalty        currency.Money `gorm:"type:decimal(20,2);default:0"` // In Currency
+	CancellationPenaltyPercent float64        `gorm:"type:float;default:0"`
+	CancellationPolicyName     string         `gorm:"type:varchar(255)"`
 
 	// Guest per kamar
 	Guest            string `gorm:"type:text"`
@@ -77,9 +87,15 @@ type BookingDetail struct {
 	AdditionalNotes  string `gorm:"type:text"` // Optional notes from agent to admin (max 500 characters)
 	AdminNotes       string `gorm:"type:text"` // Optional notes from admin to agent (max 500 characters)
 
+	HotelConfirmationNumber string `gorm:"type:varchar(50)"` // Given by the hotel in its reply to the booking email
+
+	CheckInRemindedAt *time.Time // When the agent was reminded of the check in, nil until then
+
 	// Status
 	StatusBookingID uint `gorm:"index"`
 	StatusPaymentID uint `gorm:"index"`
+	// Payment status set by an admin by hand, the payments ledger leaves it until a payment of the sub-booking changes
+	StatusPaymentManual bool `gorm:"not null;default:false"`
 
 	Booking                  Booking                   `gorm:"foreignkey:BookingID"`
 	Promo                    *Promo                    `gorm:"foreignkey:PromoID"`
@@ -95,6 +111,13 @@ func (b *BookingDetail) BeforeCreate(tx *gorm.DB) error {
 	return b.ExternalID.BeforeCreate(tx)
 }
 
+// AfterFind restores the currency of the amounts, the columns only hold the number
+func (b *BookingDetail) AfterFind(tx *gorm.DB) error {
+	b.Price = b.Price.WithCurrency(b.Currency)
+	b.CancellationPenalty = b.CancellationPenalty.WithCurrency(b.Currency)
+	return nil
+}
+
 type BookingGuest struct {
 	gorm.Model
 	ExternalID ExternalID `gorm:"embedded"`
@@ -130,6 +153,69 @@ func (b *BookingDetailAdditional) BeforeCreate(tx *gorm.DB) error {
 	return b.ExternalID.BeforeCreate(tx)
 }
 
+// BookingDetailRevision keeps the values a booking detail had before an amendment
+type BookingDetailRevision struct {
+	gorm.Model
+	ExternalID      ExternalID `gorm:"embedded"`
+	BookingDetailID uint       `gorm:"index;not null"`
+	Revision        int        `gorm:"not null"` // 1 for the values at checkout, then one per amendment
+	RoomPriceID     uint
+	CheckInDate     time.Time
+	CheckOutDate    time.Time
+	Quantity        int
+	PromoID         *uint
+	DetailPromo     datatypes.JSON `gorm:"type:jsonb"`
+	DetailRoom      datatypes.JSON `gorm:"type:jsonb"`
+	Price           currency.Money `gorm:"type:decimal(20,2)"`
+	Currency        string         `gorm:"type:varchar(3)"`
+	StatusBookingID uint
+	Reason          string `gorm:"type:text"` // Reason given for the amendment
+	AmendedBy       uint   `gorm:"index"`     // User who amended the booking detail
+
+	BookingDetail BookingDetail `gorm:"foreignkey:BookingDetailID"`
+	AmendedByUser User          `gorm:"foreignkey:AmendedBy"`
+}
+
+func (b *BookingDetailRevision) BeforeCreate(tx *gorm.DB) error {
+	return b.ExternalID.BeforeCreate(tx)
+}
+
+// AfterFind restores the currency of the price, the column only holds the number
+func (b *BookingDetailRevision) AfterFind(tx *gorm.DB) error {
+	b.Price = b.Price.WithCurrency(b.Currency)
+	return nil
+}
+
+// Payment is an entry of the payments ledger of a booking, on a single sub-booking or on the whole booking
+type Payment struct {
+	gorm.Model
+	ExternalID      ExternalID     `gorm:"embedded"`
+	BookingID       uint           `gorm:"index;not null"`
+	BookingDetailID *uint          `gorm:"index"`              // nil = paid on the whole booking
+	Amount          currency.Money `gorm:"type:decimal(20,2)"` // In Currency, negative for refunds
+	Currency        string         `gorm:"type:varchar(3);default:'IDR'"`
+	Method          string         `gorm:"type:varchar(20)"` // bank_transfer, credit_card, cash or other
+	Reference       string         `gorm:"type:varchar(255)"`
+	ReceiptUrl      string         `gorm:"type:text"`
+	Notes           string         `gorm:"type:text"`
+	PaidAt          time.Time
+	RecordedBy      uint `gorm:"index"`
+
+	Booking        Booking        `gorm:"foreignkey:BookingID"`
+	BookingDetail  *BookingDetail `gorm:"foreignkey:BookingDetailID"`
+	RecordedByUser User           `gorm:"foreignkey:RecordedBy"`
+}
+
+func (p *Payment) BeforeCreate(tx *gorm.DB) error {
+	return p.ExternalID.BeforeCreate(tx)
+}
+
+// AfterFind restores the currency of the amount, the column only holds the number
+func (p *Payment) AfterFind(tx *gorm.DB) error {
+	p.Amount = p.Amount.WithCurrency(p.Currency)
+	return nil
+}
+
 type Invoice struct {
 	gorm.Model
 	ExternalID      ExternalID     `gorm:"embedded"`
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -16,3 +18,18 @@ type Currency struct {
 func (c *Currency) BeforeCreate(tx *gorm.DB) error {
 	return c.ExternalID.BeforeCreate(tx)
 }
+
+// ExchangeRate is the IDR price of one unit of CurrencyCode from EffectiveDate onwards.
+// It is used to derive prices that were not entered for that currency.
+type ExchangeRate struct {
+	gorm.Model
+	ExternalID    ExternalID `gorm:"embedded"`
+	CurrencyCode  string     `json:"currency_code" gorm:"type:varchar(3);not null;uniqueIndex:idx_exchange_rates_code_date"`
+	Rate          float64    `json:"rate" gorm:"not null"`            // IDR per 1 unit, e.g. USD = 16250
+	MarkupPercent float64    `json:"markup_percent" gorm:"default:0"` // Added on top of the converted price
+	EffectiveDate time.Time  `json:"effective_date" gorm:"type:date;not null;uniqueIndex:idx_exchange_rates_code_date"`
+}
+
+func (e *ExchangeRate) BeforeCreate(tx *gorm.DB) error {
+	return e.ExternalID.BeforeCreate(tx)
+}
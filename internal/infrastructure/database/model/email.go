@@ -1,6 +1,9 @@
 package model
 
 import (
+	"time"
+
+	"github.com/lib/pq"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
@@ -8,7 +11,8 @@ import (
 type EmailTemplate struct {
 	gorm.Model
 	ExternalID       ExternalID `gorm:"embedded"`
-	Name             string     `gorm:"type:varchar(100);uniqueIndex;not null"`
+	Name             string     `gorm:"type:varchar(100);uniqueIndex:idx_email_template_name_locale;not null"`
+	Locale           string     `gorm:"type:varchar(5);uniqueIndex:idx_email_template_name_locale;not null;default:'en'"` // One variant per language
 	Subject          string     `gorm:"type:varchar(255);not null"`
 	Body             string     `gorm:"type:text;not null"`
 	IsSignatureImage bool       `gorm:"type:boolean;not null"`
@@ -19,6 +23,27 @@ func (b *EmailTemplate) BeforeCreate(tx *gorm.DB) error {
 	return b.ExternalID.BeforeCreate(tx)
 }
 
+// EmailTemplateVersion is a snapshot of an email template, written on every edit and rollback
+type EmailTemplateVersion struct {
+	gorm.Model
+	ExternalID       ExternalID `gorm:"embedded"`
+	EmailTemplateID  uint       `gorm:"not null;uniqueIndex:idx_email_template_version"`
+	Version          int        `gorm:"not null;uniqueIndex:idx_email_template_version"`
+	Subject          string     `gorm:"type:varchar(255);not null"`
+	Body             string     `gorm:"type:text;not null"`
+	IsSignatureImage bool       `gorm:"type:boolean;not null"`
+	Signature        string     `gorm:"type:text;not null"`
+	AuthorID         *uint      // Nil for the baseline taken before the first edit
+	AuthorName       string     `gorm:"type:varchar(255)"`
+	Note             string     `gorm:"type:text"`
+
+	EmailTemplate EmailTemplate `gorm:"foreignKey:EmailTemplateID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+func (b *EmailTemplateVersion) BeforeCreate(tx *gorm.DB) error {
+	return b.ExternalID.BeforeCreate(tx)
+}
+
 type EmailLog struct {
 	gorm.Model
 	ExternalID      ExternalID     `gorm:"embedded"`
@@ -28,6 +53,10 @@ type EmailLog struct {
 	Meta            datatypes.JSON `gorm:"type:jsonb"`
 	EmailTemplateID uint           `gorm:"not null"`
 	StatusID        uint           `gorm:"not null"`
+	Scope           string         `gorm:"type:varchar(20)"`        // SMTP route the email was sent through, hotel or agent
+	TemplateName    string         `gorm:"type:varchar(100);index"` // Name of the template at send time
+	RetryCount      int            `gorm:"default:0"`               // Retries requested manually or by the auto retry job
+	Attachments     datatypes.JSON `gorm:"type:jsonb"`              // Storage location of the attached files
 
 	EmailStatus   StatusEmail   `gorm:"foreignKey:StatusID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
 	EmailTemplate EmailTemplate `gorm:"foreignKey:EmailTemplateID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
@@ -46,3 +75,52 @@ type StatusEmail struct {
 func (b *StatusEmail) BeforeCreate(tx *gorm.DB) error {
 	return b.ExternalID.BeforeCreate(tx)
 }
+
+// EmailOutbox is an email waiting to be sent by the outbox workers, written in the same transaction as the change it reports
+type EmailOutbox struct {
+	gorm.Model
+	ExternalID    ExternalID `gorm:"embedded"`
+	EmailLogID    *uint      `gorm:"index"`
+	Scope         string     `gorm:"type:varchar(20);not null"`
+	To            string     `gorm:"type:text"`
+	Subject       string     `gorm:"type:text"`
+	BodyHTML      string     `gorm:"type:text"`
+	BodyText      string     `gorm:"type:text"`
+	Status        string     `gorm:"type:varchar(20);index:idx_email_outbox_due,priority:1;not null"` // pending, processing, sent or dead
+	Attempts      int        `gorm:"default:0"`
+	NextAttemptAt time.Time  `gorm:"index:idx_email_outbox_due,priority:2"`
+	LockedAt      *time.Time // Start of the lease of the last claim, only its worker may record the outcome
+	LockedUntil   *time.Time
+	LastError     string `gorm:"type:text"`
+	SentAt        *time.Time
+	Attachments   datatypes.JSON `gorm:"type:jsonb"`
+
+	EmailLog *EmailLog `gorm:"foreignKey:EmailLogID"`
+}
+
+func (b *EmailOutbox) BeforeCreate(tx *gorm.DB) error {
+	return b.ExternalID.BeforeCreate(tx)
+}
+
+// InboundEmail is a reply received by the inbound email webhook, matched to a booking by the codes in its subject
+type InboundEmail struct {
+	gorm.Model
+	ExternalID         ExternalID     `gorm:"embedded"`
+	MessageID          string         `gorm:"type:varchar(255);uniqueIndex;not null"` // A redelivered reply is processed once
+	From               string         `gorm:"type:varchar(255)"`
+	To                 string         `gorm:"type:varchar(255)"`
+	Subject            string         `gorm:"type:text"`
+	Body               string         `gorm:"type:text"` // Reply without the quoted original email
+	BookingID          *uint          `gorm:"index"`     // nil = no booking matched
+	SubBookingIDs      pq.StringArray `gorm:"type:text[]"`
+	Action             string         `gorm:"type:varchar(20)"` // confirm, reject or none
+	ConfirmationNumber string         `gorm:"type:varchar(50)"`
+	Status             string         `gorm:"type:varchar(20);index;not null"` // unmatched, received, attached or applied
+	Note               string         `gorm:"type:text"`
+
+	Booking *Booking `gorm:"foreignKey:BookingID"`
+}
+
+func (b *InboundEmail) BeforeCreate(tx *gorm.DB) error {
+	return b.ExternalID.BeforeCreate(tx)
+}
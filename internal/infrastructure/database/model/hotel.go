@@ -32,10 +32,14 @@ type Hotel struct {
 	StatusID        uint           `json:"status_id" gorm:"index;default:1"`
 	Rating          int            `json:"rating" gorm:"default:0"`
 	Email           string         `json:"email" gorm:"uniqueIndex:idx_hotels_email_not_deleted,where:deleted_at IS NULL"`
+	Language        string         `json:"language" gorm:"type:varchar(5)"` // Preferred language of emails, empty for the default
 
-	CancellationPeriod int        `json:"cancellation_period" gorm:"default:0"`
-	CheckInHour        *time.Time `json:"check_in_hour" gorm:"default:null;type:time"`
-	CheckOutHour       *time.Time `json:"check_out_hour" gorm:"default:null;type:time"`
+	CancellationPeriod   int        `json:"cancellation_period" gorm:"default:0"`
+	CancellationPolicyID *uint      `json:"cancellation_policy_id" gorm:"index"` // Overrides CancellationPeriod when set
+	DepositPercent       float64    `json:"deposit_percent" gorm:"default:0"`    // Share of the booking total due as deposit, 0 = full payment
+	BalanceDueDays       int        `json:"balance_due_days" gorm:"default:0"`   // Days before check-in the balance is due
+	CheckInHour          *time.Time `json:"check_in_hour" gorm:"default:null;type:time"`
+	CheckOutHour         *time.Time `json:"check_out_hour" gorm:"default:null;type:time"`
 
 	SocialMedia datatypes.JSON `json:"social_media" gorm:"type:jsonb"`
 
@@ -50,6 +54,20 @@ func (b *Hotel) BeforeCreate(tx *gorm.DB) error {
 	return b.ExternalID.BeforeCreate(tx)
 }
 
+// CancellationPolicy is a named set of penalty tiers attached to hotels or rate plans,
+// e.g. free until 7 days before check-in, 50% until 2 days before, non-refundable after.
+type CancellationPolicy struct {
+	gorm.Model
+	ExternalID  ExternalID     `gorm:"embedded"`
+	Name        string         `json:"name"`
+	Description string         `json:"description" gorm:"type:text"`
+	Tiers       datatypes.JSON `json:"tiers" gorm:"type:jsonb"` // [{"days_before_check_in": 7, "penalty_percent": 0}, ...]
+}
+
+func (b *CancellationPolicy) BeforeCreate(tx *gorm.DB) error {
+	return b.ExternalID.BeforeCreate(tx)
+}
+
 type NearbyPlace struct {
 	gorm.Model
 	ExternalID ExternalID `gorm:"embedded"`
@@ -27,7 +27,7 @@ type UserNotificationSetting struct {
 	ExternalID ExternalID `gorm:"embedded"`
 	UserID     uint       `gorm:"index;not null"`
 	Channel    string     `gorm:"type:varchar(20);not null"` // "email", "web"
-	Type       string     `gorm:"type:varchar(50);not null"` // "booking", "reject", "both"
+	Type       string     `gorm:"type:varchar(50);not null"` // One of constant.AgentNotificationTypes or constant.AdminNotificationTypes
 	IsEnabled  bool       `gorm:"not null"`
 
 	User User `gorm:"foreignKey:UserID"`
@@ -36,3 +36,41 @@ type UserNotificationSetting struct {
 func (b *UserNotificationSetting) BeforeCreate(tx *gorm.DB) error {
 	return b.ExternalID.BeforeCreate(tx)
 }
+
+// UserNotificationPreference holds the digest and quiet hours of a user, users without one get every notification when it happens
+type UserNotificationPreference struct {
+	gorm.Model
+	ExternalID        ExternalID `gorm:"embedded"`
+	UserID            uint       `gorm:"uniqueIndex;not null"`
+	DigestMode        string     `gorm:"type:varchar(10);not null;default:'off'"` // off or daily
+	DigestHour        int        `gorm:"not null;default:8"`                      // Hour of the daily digest in Timezone
+	LastDigestAt      *time.Time `gorm:"index"`
+	QuietHoursEnabled bool       `gorm:"not null;default:false"`
+	QuietHoursStart   string     `gorm:"type:varchar(5)"` // "22:00" in Timezone
+	QuietHoursEnd     string     `gorm:"type:varchar(5)"` // "07:00" in Timezone, before the start when crossing midnight
+	Timezone          string     `gorm:"type:varchar(64)"`
+
+	User User `gorm:"foreignKey:UserID"`
+}
+
+func (b *UserNotificationPreference) BeforeCreate(tx *gorm.DB) error {
+	return b.ExternalID.BeforeCreate(tx)
+}
+
+// NotificationDigestItem is a notification held for the next daily digest of its user
+type NotificationDigestItem struct {
+	gorm.Model
+	ExternalID  ExternalID `gorm:"embedded"`
+	UserID      uint       `gorm:"index:idx_notification_digest_items_pending,priority:1;not null"`
+	Title       string     `gorm:"type:varchar(255)"`
+	Message     string     `gorm:"type:text"`
+	RedirectURL string     `gorm:"type:varchar(255)"`
+	Type        string     `gorm:"type:varchar(50)"`
+	Web         bool       `gorm:"not null"` // Summarized in the digest web notification
+	Email       bool       `gorm:"not null"` // Summarized in the digest email
+	DigestedAt  *time.Time `gorm:"index:idx_notification_digest_items_pending,priority:2"`
+}
+
+func (b *NotificationDigestItem) BeforeCreate(tx *gorm.DB) error {
+	return b.ExternalID.BeforeCreate(tx)
+}
@@ -41,8 +41,8 @@ type RoomPrice struct {
 	RoomTypeID  uint           `json:"room_type_id_id" gorm:"index"`
 	IsBreakfast bool           `json:"is_breakfast"`
 	Pax         int            `json:"pax"`
-	Price       float64        `json:"price"`      // DEPRECATED: Keep for backward compatibility during migration
-	Prices      datatypes.JSON `gorm:"type:jsonb"` // NEW: Multi-currency prices {"IDR": 1600000, "USD": 100}
+	Price       float64        `json:"price" gorm:"type:decimal(20,2)"` // DEPRECATED: Keep for backward compatibility during migration
+	Prices      datatypes.JSON `gorm:"type:jsonb"`                      // NEW: Multi-currency prices {"IDR": 1600000, "USD": 100}
 	IsShow      bool           `json:"is_show"`
 
 	RoomType RoomType `gorm:"foreignKey:RoomTypeID"`
@@ -134,3 +134,61 @@ type RoomUnavailable struct {
 func (b *RoomUnavailable) BeforeCreate(tx *gorm.DB) error {
 	return b.ExternalID.BeforeCreate(tx)
 }
+
+// RoomInventory is the per-night allotment ledger of a room type.
+// TotalUnit is seeded from RoomType.TotalUnit and BookedUnit is moved by checkout, cancellation and rejection.
+type RoomInventory struct {
+	gorm.Model
+	ExternalID ExternalID `gorm:"embedded"`
+	RoomTypeID uint       `json:"room_type_id" gorm:"not null;uniqueIndex:idx_room_inventories_room_type_date"`
+	Date       time.Time  `json:"date" gorm:"type:date;not null;uniqueIndex:idx_room_inventories_room_type_date"`
+	TotalUnit  int        `json:"total_unit"`
+	BookedUnit int        `json:"booked_unit" gorm:"default:0"`
+	RoomType   RoomType   `gorm:"foreignkey:RoomTypeID"`
+}
+
+func (b *RoomInventory) BeforeCreate(tx *gorm.DB) error {
+	return b.ExternalID.BeforeCreate(tx)
+}
+
+// RoomRestriction holds the yield rules of a room type for a single date.
+// Length of stay and release days are checked on the arrival date, closed to departure on the check-out date.
+type RoomRestriction struct {
+	gorm.Model
+	ExternalID        ExternalID `gorm:"embedded"`
+	RoomTypeID        uint       `json:"room_type_id" gorm:"not null;uniqueIndex:idx_room_restrictions_room_type_date"`
+	Date              time.Time  `json:"date" gorm:"type:date;not null;uniqueIndex:idx_room_restrictions_room_type_date"`
+	MinStay           int        `json:"min_stay" gorm:"default:0"`     // 0 = no minimum
+	MaxStay           int        `json:"max_stay" gorm:"default:0"`     // 0 = no maximum
+	ClosedToArrival   bool       `json:"closed_to_arrival"`             // no check-in on this date
+	ClosedToDeparture bool       `json:"closed_to_departure"`           // no check-out on this date
+	ReleaseDays       int        `json:"release_days" gorm:"default:0"` // cut-off in days before arrival
+	RoomType          RoomType   `gorm:"foreignkey:RoomTypeID"`
+}
+
+func (b *RoomRestriction) BeforeCreate(tx *gorm.DB) error {
+	return b.ExternalID.BeforeCreate(tx)
+}
+
+// RoomRatePlan overrides the base RoomPrice between StartDate and EndDate (inclusive),
+// optionally only on some days of the week. The matching plan with the highest Priority wins.
+type RoomRatePlan struct {
+	gorm.Model
+	ExternalID  ExternalID     `gorm:"embedded"`
+	RoomPriceID uint           `json:"room_price_id" gorm:"index"`
+	Name        string         `json:"name"`
+	StartDate   time.Time      `json:"start_date" gorm:"type:date;not null"`
+	EndDate     time.Time      `json:"end_date" gorm:"type:date;not null"`
+	DaysOfWeek  pq.Int64Array  `json:"days_of_week" gorm:"type:integer[]"` // 0 = Sunday ... 6 = Saturday, empty = every day
+	Prices      datatypes.JSON `gorm:"type:jsonb"`                         // Multi-currency nightly prices {"IDR": 1800000, "USD": 115}
+	Priority    int            `json:"priority" gorm:"default:0"`
+	IsActive    bool           `json:"is_active"`
+
+	CancellationPolicyID *uint `json:"cancellation_policy_id" gorm:"index"` // Overrides the hotel policy
+
+	RoomPrice RoomPrice `gorm:"foreignKey:RoomPriceID"`
+}
+
+func (b *RoomRatePlan) BeforeCreate(tx *gorm.DB) error {
+	return b.ExternalID.BeforeCreate(tx)
+}
@@ -3,6 +3,7 @@ package model
 import (
 	"time"
 
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 )
 
@@ -43,8 +44,13 @@ type User struct {
 	PhotoSelfie    string     `json:"photo_selfie"`
 	PhotoIDCard    string     `json:"photo_id_card"`
 	Currency       string     `json:"currency" gorm:"type:varchar(3);default:'IDR'"` // Agent currency preference (set by admin)
+	Language       string     `json:"language" gorm:"type:varchar(5)"`               // Preferred language of emails, empty for the default
 	ExternalID     ExternalID `gorm:"embedded"`
 
+	FailedLoginAttempts int        `json:"failed_login_attempts" gorm:"default:0"` // Wrong passwords since the last login or lockout
+	LockoutCount        int        `json:"lockout_count" gorm:"default:0"`         // Lockouts since the last login, each one lasts twice as long
+	LockedUntil         *time.Time `json:"locked_until"`
+
 	Status       StatusUser    `gorm:"foreignKey:StatusID"`
 	AgentCompany *AgentCompany `gorm:"foreignKey:AgentCompanyID"`
 
@@ -61,9 +67,10 @@ func (b *User) BeforeCreate(tx *gorm.DB) error {
 
 type Role struct {
 	gorm.Model
-	ExternalID  ExternalID   `gorm:"embedded"`
-	Role        string       `json:"role"`
-	Permissions []Permission `gorm:"many2many:role_permissions"` // many-to-many
+	ExternalID        ExternalID   `gorm:"embedded"`
+	Role              string       `json:"role"`
+	TwoFactorRequired bool         `json:"two_factor_required" gorm:"default:false"` // Users of the role must log in with a TOTP code
+	Permissions       []Permission `gorm:"many2many:role_permissions"`               // many-to-many
 }
 
 func (b *Role) BeforeCreate(tx *gorm.DB) error {
@@ -105,3 +112,87 @@ type PasswordResetToken struct {
 func (b *PasswordResetToken) BeforeCreate(tx *gorm.DB) error {
 	return b.ExternalID.BeforeCreate(tx)
 }
+
+// UserSession is a login of a user on one device, its ExternalID is the session ID carried by the tokens of the login
+type UserSession struct {
+	gorm.Model
+	ExternalID ExternalID `gorm:"embedded"`
+	UserID     uint       `json:"user_id" gorm:"index;not null"`
+	Device     string     `json:"device" gorm:"type:varchar(100)"`
+	IPAddress  string     `json:"ip_address" gorm:"type:varchar(45)"`
+	UserAgent  string     `json:"user_agent" gorm:"type:text"`
+	LastSeenAt time.Time  `json:"last_seen_at"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"index"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+func (b *UserSession) BeforeCreate(tx *gorm.DB) error {
+	return b.ExternalID.BeforeCreate(tx)
+}
+
+// RefreshToken is a refresh token issued to a session, stored hashed. A refresh token is used once, the session of a
+// token used twice is revoked
+type RefreshToken struct {
+	gorm.Model
+	ExternalID    ExternalID `gorm:"embedded"`
+	UserSessionID uint       `json:"user_session_id" gorm:"index;not null"`
+	TokenHash     string     `json:"token_hash" gorm:"type:varchar(64);uniqueIndex;not null"`
+	ExpiresAt     time.Time  `json:"expires_at"`
+	UsedAt        *time.Time `json:"used_at"`
+
+	UserSession UserSession `gorm:"foreignKey:UserSessionID"`
+}
+
+func (b *RefreshToken) BeforeCreate(tx *gorm.DB) error {
+	return b.ExternalID.BeforeCreate(tx)
+}
+
+// UserAPIKey is a personal credential of a user for system integrations, limited to scopes out of the permissions
+// of the user. Only the hash of the key is stored.
+type UserAPIKey struct {
+	gorm.Model
+	ExternalID ExternalID     `gorm:"embedded"`
+	UserID     uint           `json:"user_id" gorm:"index;not null"`
+	Name       string         `json:"name" gorm:"type:varchar(100);not null"`
+	Prefix     string         `json:"prefix" gorm:"type:varchar(16);not null"` // Start of the key, to recognize it in the list
+	KeyHash    string         `json:"-" gorm:"type:varchar(64);uniqueIndex;not null"`
+	Scopes     pq.StringArray `json:"scopes" gorm:"type:text[]"`  // Permission strings, e.g. booking:view
+	RateLimit  int            `json:"rate_limit" gorm:"not null"` // Requests per minute
+	LastUsedAt *time.Time     `json:"last_used_at"`
+	LastUsedIP string         `json:"last_used_ip" gorm:"type:varchar(45)"`
+	ExpiresAt  *time.Time     `json:"expires_at"` // nil for a key that does not expire
+	RevokedAt  *time.Time     `json:"revoked_at"`
+
+	User User `gorm:"foreignKey:UserID"`
+}
+
+func (b *UserAPIKey) BeforeCreate(tx *gorm.DB) error {
+	return b.ExternalID.BeforeCreate(tx)
+}
+
+// UserTwoFactor is the TOTP authenticator of a user, enabled once a first code of it was verified
+type UserTwoFactor struct {
+	gorm.Model
+	ExternalID   ExternalID `gorm:"embedded"`
+	UserID       uint       `json:"user_id" gorm:"uniqueIndex;not null"`
+	Secret       string     `json:"-" gorm:"type:text;not null"` // Encrypted with the two-factor secret key
+	EnabledAt    *time.Time `json:"enabled_at"`                  // nil while the enrollment is not verified
+	LastUsedStep int64      `json:"last_used_step"`              // Period of the last accepted code, each code is accepted once
+}
+
+func (b *UserTwoFactor) BeforeCreate(tx *gorm.DB) error {
+	return b.ExternalID.BeforeCreate(tx)
+}
+
+// UserRecoveryCode is a single-use code that replaces a TOTP code when the authenticator is lost, stored hashed
+type UserRecoveryCode struct {
+	gorm.Model
+	ExternalID ExternalID `gorm:"embedded"`
+	UserID     uint       `json:"user_id" gorm:"index;not null"`
+	CodeHash   string     `json:"-" gorm:"type:varchar(64);index;not null"`
+	UsedAt     *time.Time `json:"used_at"`
+}
+
+func (b *UserRecoveryCode) BeforeCreate(tx *gorm.DB) error {
+	return b.ExternalID.BeforeCreate(tx)
+}
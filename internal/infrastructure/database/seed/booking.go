@@ -22,6 +22,7 @@ func (s *Seed) SeedBooking() {
 	statusPayments := []model.StatusPayment{
 		{ID: constant.StatusPaymentUnpaidID, Status: constant.StatusPaymentUnpaid},
 		{ID: constant.StatusPaymentPaidID, Status: constant.StatusPaymentPaid},
+		{ID: constant.StatusPaymentPartiallyPaidID, Status: constant.StatusPaymentPartiallyPaid},
 	}
 
 	// sinkronisasi status booking
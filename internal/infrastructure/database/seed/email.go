@@ -122,6 +122,10 @@ func (s *Seed) SeedEmailTemplate() {
     <li><strong>BOOKING CODE:</strong> {{.BookingCode}}</li>
     <li><strong>REMARK:</strong> {{.Remark}}</li>
     <li><strong>ADDITIONAL:</strong> {{.Additional}}</li>
+    {{if .CancellationPolicy}}
+    <li><strong>CANCELLATION POLICY:</strong> {{.CancellationPolicy}}</li>
+    <li><strong>CANCELLATION PENALTY:</strong> {{.CancellationPenalty}}</li>
+    {{end}}
 </ul>
 
 <p>Thank you but regret to inform you that we would like to <strong>CANCEL</strong> our reservation as detailed below.</p>
@@ -129,6 +133,61 @@ func (s *Seed) SeedEmailTemplate() {
 <p>We are looking forward to hearing back from you soon.<br>
 Many thanks for your kind attention and assistance.</p>
 
+<p>Best Regards,<br>{{.SystemSignature}}</p>
+`
+	bodyHotelBookingAmend := `
+<p>Dear Reservation Team,</p>
+
+<p><em>" Warmest Greeting From World Travel Marketing Bali "</em></p>
+
+<p>Please kindly assist us to <strong>AMEND & RECONFIRM</strong> reservation with details as below:</p>
+
+<ul>
+    <li><strong>NAME:</strong> {{.GuestName}}</li>
+    <li><strong>BOOKING CODE:</strong> {{.BookingCode}}</li>
+    <li><strong>SUB-BOOKING ID:</strong> {{.SubBookingID}}</li>
+</ul>
+
+<h3>PREVIOUS RESERVATION:</h3>
+<ul>
+    <li><strong>PERIOD:</strong> {{.PreviousPeriod}}</li>
+    <li><strong>ROOM:</strong> {{.PreviousRoomType}}</li>
+    <li><strong>QUANTITY:</strong> {{.PreviousQuantity}}</li>
+</ul>
+
+<h3>NEW RESERVATION:</h3>
+<ul>
+    <li><strong>PERIOD:</strong> {{.Period}}</li>
+    <li><strong>ROOM:</strong> {{.RoomType}}</li>
+    {{if .BedTypes}}
+    <li><strong>BED TYPE:</strong> {{.BedTypes}}</li>
+    {{end}}
+    <li><strong>QUANTITY:</strong> {{.Quantity}}</li>
+    <li><strong>RATE:</strong> {{.Rate}}</li>
+    {{if .AdditionalServices}}
+    <li><strong>ADDITIONAL SERVICES:</strong>
+        <ul>
+            {{range .AdditionalServices}}
+            <li>
+                {{.Name}}
+                {{if eq .Category "price"}}
+                    - Price: {{.Price}}{{if .IsRequired}} <strong>(Required)</strong>{{end}}
+                {{else if eq .Category "pax"}}
+                    - Pax: {{.Pax}}{{if .IsRequired}} <strong>(Required)</strong>{{end}}
+                {{end}}
+            </li>
+            {{end}}
+        </ul>
+    </li>
+    {{end}}
+    {{if .Reason}}
+    <li><strong>REMARK:</strong> {{.Reason}}</li>
+    {{end}}
+</ul>
+
+<p>We are looking forward to hearing back from you soon.<br>
+Many thanks for your kind attention and assistance.</p>
+
 <p>Best Regards,<br>{{.SystemSignature}}</p>
 `
 	bodyHotelBookingRequest := `
@@ -314,24 +373,76 @@ After logging in, you can go directly to your account settings here:<br>
 
 <p>Best regards,<br>
 World Travel Management</p>
+`
+	bodyNotification := `
+<p>Hello {{.FullName}},</p>
+
+<p>{{.Message}}</p>
+
+<p>
+See the details here:<br>
+👉 <a href="{{.Link}}" target="_blank">{{.Link}}</a>
+</p>
+
+<p>Best regards,<br>
+The HotelBox System</p>
+`
+	bodyNotificationDigest := `
+<p>Hello {{.FullName}},</p>
+
+<p>Here is your summary of {{.Count}} notification(s) for {{.Date}}:</p>
+
+<ul>
+{{range .Items}}
+    <li><strong>{{.Title}}</strong> ({{.Time}})<br>
+    {{.Message}}{{if .Link}}<br>
+    👉 <a href="{{.Link}}" target="_blank">{{.Link}}</a>{{end}}</li>
+{{end}}
+</ul>
+
+<p>You receive this summary because the daily digest is enabled in your notification settings.</p>
+
+<p>Best regards,<br>
+The HotelBox System</p>
+`
+	bodyAccountLocked := `
+<p>Hello {{.FullName}},</p>
+
+<p>We temporarily locked your account after {{.Attempts}} failed login attempts in a row.
+The last attempt came from IP address <strong>{{.IPAddress}}</strong> at {{.AttemptedAt}}.</p>
+
+<p>You can log in again in <strong>{{.LockedFor}}</strong>, or ask an administrator to unlock your account.</p>
+
+<p>
+If these attempts were not made by you, someone may be trying to guess your password.
+Please reset your password here:<br>
+👉 <a href="{{.ForgotPasswordLink}}" target="_blank">{{.ForgotPasswordLink}}</a>
+</p>
+
+<p>Best regards,<br>
+The HotelBox System</p>
 `
 	templates := []model.EmailTemplate{
-		{Subject: `🎉 Welcome to The HotelBox – Your Agent Account is Approved!`, Body: bodyAgentApproval, Name: constant.EmailAgentApproved, IsSignatureImage: false},
-		{Subject: `Your The HotelBox Registration – Action Required`, Body: bodyAgentRejection, Name: constant.EmailAgentRejected, IsSignatureImage: false},
-		{Subject: `Booking Confirmation – {{.ID}}`, Body: bodyBookingConfirmed, Name: constant.EmailBookingConfirmed, IsSignatureImage: false},
-		{Subject: `Booking Request Update – {{.ID}}`, Body: bodyBookingRejected, Name: constant.EmailBookingRejected, IsSignatureImage: false},
-		{Subject: `New Booking Request – {{.BookingCode}}`, Body: bodyHotelBookingRequest, Name: constant.EmailHotelBookingRequest, IsSignatureImage: false},
-		{Subject: `New Contact Us Submission – The HotelBox - {{.UserName}}`, Body: bodyContactUsGeneral, Name: constant.EmailContactUsGeneral, IsSignatureImage: false},
-		{Subject: `Help Request for Booking – {{.BookingID}}`, Body: bodyContactUsBooking, Name: constant.EmailContactUsBooking, IsSignatureImage: false},
-		{Subject: `Password Reset Request`, Body: bodyForgotPassword, Name: constant.EmailForgotPassword, IsSignatureImage: false},
-		{Subject: `Your Account Has Been Activated – Please Change Your Password Immediately`, Body: bodyAccountActivated, Name: constant.EmailAccountActivated, IsSignatureImage: false},
-		{Subject: `Booking Cancellation – {{.BookingCode}}`, Body: bodyHotelBookingCancel, Name: constant.EmailHotelBookingCancel, IsSignatureImage: false},
+		{Subject: `🎉 Welcome to The HotelBox – Your Agent Account is Approved!`, Body: bodyAgentApproval, Name: constant.EmailAgentApproved, Locale: constant.DefaultLocale, IsSignatureImage: false},
+		{Subject: `Your The HotelBox Registration – Action Required`, Body: bodyAgentRejection, Name: constant.EmailAgentRejected, Locale: constant.DefaultLocale, IsSignatureImage: false},
+		{Subject: `Booking Confirmation – {{.ID}}`, Body: bodyBookingConfirmed, Name: constant.EmailBookingConfirmed, Locale: constant.DefaultLocale, IsSignatureImage: false},
+		{Subject: `Booking Request Update – {{.ID}}`, Body: bodyBookingRejected, Name: constant.EmailBookingRejected, Locale: constant.DefaultLocale, IsSignatureImage: false},
+		{Subject: `New Booking Request – {{.BookingCode}}`, Body: bodyHotelBookingRequest, Name: constant.EmailHotelBookingRequest, Locale: constant.DefaultLocale, IsSignatureImage: false},
+		{Subject: `New Contact Us Submission – The HotelBox - {{.UserName}}`, Body: bodyContactUsGeneral, Name: constant.EmailContactUsGeneral, Locale: constant.DefaultLocale, IsSignatureImage: false},
+		{Subject: `Help Request for Booking – {{.BookingID}}`, Body: bodyContactUsBooking, Name: constant.EmailContactUsBooking, Locale: constant.DefaultLocale, IsSignatureImage: false},
+		{Subject: `Password Reset Request`, Body: bodyForgotPassword, Name: constant.EmailForgotPassword, Locale: constant.DefaultLocale, IsSignatureImage: false},
+		{Subject: `Your Account Has Been Activated – Please Change Your Password Immediately`, Body: bodyAccountActivated, Name: constant.EmailAccountActivated, Locale: constant.DefaultLocale, IsSignatureImage: false},
+		{Subject: `Booking Cancellation – {{.BookingCode}}`, Body: bodyHotelBookingCancel, Name: constant.EmailHotelBookingCancel, Locale: constant.DefaultLocale, IsSignatureImage: false},
+		{Subject: `Booking Amendment – {{.BookingCode}}`, Body: bodyHotelBookingAmend, Name: constant.EmailHotelBookingAmend, Locale: constant.DefaultLocale, IsSignatureImage: false},
+		{Subject: `[The HotelBox] {{.Title}}`, Body: bodyNotification, Name: constant.EmailNotification, Locale: constant.DefaultLocale, IsSignatureImage: false},
+		{Subject: `[The HotelBox] Your daily summary – {{.Date}}`, Body: bodyNotificationDigest, Name: constant.EmailNotificationDigest, Locale: constant.DefaultLocale, IsSignatureImage: false},
+		{Subject: `[The HotelBox] Your account has been temporarily locked`, Body: bodyAccountLocked, Name: constant.EmailAccountLocked, Locale: constant.DefaultLocale, IsSignatureImage: false},
 	}
 
 	for _, tpl := range templates {
 		var existing model.EmailTemplate
 		err := s.db.
-			Where("name = ?", tpl.Name).
+			Where("name = ? AND locale = ?", tpl.Name, tpl.Locale).
 			First(&existing).Error
 
 		if errors.Is(err, gorm.ErrRecordNotFound) {
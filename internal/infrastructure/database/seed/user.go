@@ -62,6 +62,10 @@ func (s *Seed) SeedUser() {
 			{Permission: "booking:create", Page: "booking", Action: "create"},
 			{Permission: "booking:edit", Page: "booking", Action: "edit"},
 			{Permission: "booking:delete", Page: "booking", Action: "delete"},
+			{Permission: "settings:view", Page: "settings", Action: "view"},
+			{Permission: "settings:create", Page: "settings", Action: "create"},
+			{Permission: "settings:edit", Page: "settings", Action: "edit"},
+			{Permission: "settings:delete", Page: "settings", Action: "delete"},
 		}
 		if err := s.db.Create(&perms).Error; err != nil {
 			log.Fatalf("Failed to seed roles: %s", err.Error())
@@ -6,12 +6,21 @@ import (
 	"errors"
 	"fmt"
 	"gorm.io/gorm"
+	"sync"
 	"wtm-backend/pkg/logger"
 )
 
 // Key untuk menyimpan transaction di context
 type dbContextKey struct{}
 
+// Key untuk menyimpan fungsi yang dijalankan setelah commit
+type afterCommitKey struct{}
+
+type afterCommitHooks struct {
+	mu  sync.Mutex
+	fns []func(ctx context.Context)
+}
+
 func (dbs *DBPostgre) BeginTrx(ctx context.Context) (*gorm.DB, context.Context, error) {
 	if dbs.DB == nil {
 		return nil, ctx, errors.New("database connection is nil")
@@ -24,6 +33,7 @@ func (dbs *DBPostgre) BeginTrx(ctx context.Context) (*gorm.DB, context.Context,
 	}
 
 	txCtx := context.WithValue(ctx, dbContextKey{}, tx)
+	txCtx = context.WithValue(txCtx, afterCommitKey{}, &afterCommitHooks{})
 	return tx, txCtx, nil
 }
 
@@ -40,9 +50,43 @@ func (dbs *DBPostgre) CommitTrx(ctx context.Context, tx *gorm.DB) error {
 
 	logger.Info(ctx, "Transaction committed")
 	dbs.resetDBSession()
+	runAfterCommit(ctx)
 	return nil
 }
 
+// AfterCommit runs fn once the transaction of ctx is committed, never when it is rolled back. Without a transaction fn
+// runs right away. fn gets a context without the transaction.
+func (dbs *DBPostgre) AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommitHooks)
+	if tx, inTx := ctx.Value(dbContextKey{}).(*gorm.DB); !ok || hooks == nil || !inTx || tx == nil {
+		fn(ctx)
+		return
+	}
+
+	hooks.mu.Lock()
+	hooks.fns = append(hooks.fns, fn)
+	hooks.mu.Unlock()
+}
+
+func runAfterCommit(ctx context.Context) {
+	hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommitHooks)
+	if !ok || hooks == nil {
+		return
+	}
+
+	hooks.mu.Lock()
+	fns := hooks.fns
+	hooks.fns = nil
+	hooks.mu.Unlock()
+
+	// Transaksi sudah selesai, query di dalam hook memakai koneksi biasa
+	ctx = context.WithValue(ctx, dbContextKey{}, (*gorm.DB)(nil))
+	ctx = context.WithValue(ctx, afterCommitKey{}, (*afterCommitHooks)(nil))
+	for _, fn := range fns {
+		fn(ctx)
+	}
+}
+
 func (dbs *DBPostgre) RollbackTrx(ctx context.Context, tx *gorm.DB) error {
 	if tx == nil {
 		return errors.New("transaction is nil")
@@ -90,7 +134,7 @@ func (dbs *DBPostgre) WithTransaction(ctx context.Context, fn func(txCtx context
 		return operationErr
 	}
 
-	if err := dbs.CommitTrx(ctx, tx); err != nil {
+	if err := dbs.CommitTrx(txCtx, tx); err != nil {
 		return fmt.Errorf("commit: %w", err)
 	}
 
@@ -0,0 +1,112 @@
+package document
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/currency"
+)
+
+// invoiceColumns are the widths of the description, quantity, unit, price and total columns
+var invoiceColumns = [5]float64{78, 16, 22, 32, 32}
+
+// RenderInvoice renders the invoice of a sub-booking from the detail stored at checkout
+func (r *PDFRenderer) RenderInvoice(ctx context.Context, invoice entity.Invoice) ([]byte, error) {
+	detail := invoice.DetailInvoice
+	currencyCode := detail.Currency
+	if currencyCode == "" {
+		currencyCode = "IDR" // Default fallback
+	}
+
+	doc := r.newDocument(fmt.Sprintf("Invoice %s", invoice.InvoiceCode), invoice.CreatedAt)
+	r.letterhead(doc, "INVOICE", [][2]string{
+		{"Invoice No", invoice.InvoiceCode},
+		{"Invoice Date", invoice.CreatedAt.Format("02 Jan 2006")},
+		{"Sub Booking ID", detail.SubBookingID},
+	})
+
+	doc.section("Bill To", [][2]string{
+		{"Company", detail.CompanyAgent},
+		{"Agent", detail.Agent},
+		{"Email", detail.Email},
+	})
+	doc.section("Stay", [][2]string{
+		{"Hotel", detail.Hotel},
+		{"Guest", detail.Guest},
+		{"Check-in", formatDate(detail.CheckIn)},
+		{"Check-out", formatDate(detail.CheckOut)},
+		{"Bed Type", detail.BedType},
+	})
+
+	// Lines
+	doc.SetFont(fontFamily, "B", 9)
+	doc.SetFillColor(brandColor[0], brandColor[1], brandColor[2])
+	doc.SetTextColor(255, 255, 255)
+	for i, header := range []string{"Description", "Qty", "Unit", "Price", "Total"} {
+		align := "R"
+		if i == 0 {
+			align = "L"
+		}
+		doc.CellFormat(invoiceColumns[i], 7, header, "", 0, align, true, 0, "")
+	}
+	doc.Ln(-1)
+
+	doc.SetFont(fontFamily, "", 9)
+	doc.SetTextColor(0, 0, 0)
+	doc.SetDrawColor(220, 220, 220)
+	for _, line := range detail.DescriptionInvoice {
+		description := line.Description
+		if line.Pax != nil {
+			description = fmt.Sprintf("%s (%d pax)", description, *line.Pax)
+		}
+		doc.CellFormat(invoiceColumns[0], lineHeight, description, "B", 0, "L", false, 0, "")
+		doc.CellFormat(invoiceColumns[1], lineHeight, strconv.Itoa(line.Quantity), "B", 0, "R", false, 0, "")
+		doc.CellFormat(invoiceColumns[2], lineHeight, line.Unit, "B", 0, "R", false, 0, "")
+		doc.CellFormat(invoiceColumns[3], lineHeight, formatMoney(line.Price.WithCurrency(currencyCode)), "B", 0, "R", false, 0, "")
+		doc.CellFormat(invoiceColumns[4], lineHeight, formatMoney(line.Total.WithCurrency(currencyCode)), "B", 1, "R", false, 0, "")
+	}
+	doc.Ln(2)
+
+	// Totals
+	labelWidth := invoiceColumns[0] + invoiceColumns[1] + invoiceColumns[2] + invoiceColumns[3]
+	if detail.Promo.Name != "" {
+		doc.SetFont(fontFamily, "", 9)
+		doc.CellFormat(labelWidth, lineHeight, fmt.Sprintf("Promo: %s", detail.Promo.Name), "", 0, "R", false, 0, "")
+		doc.CellFormat(invoiceColumns[4], lineHeight, "", "", 1, "R", false, 0, "")
+	}
+	doc.SetFont(fontFamily, "B", 10)
+	doc.CellFormat(labelWidth, 8, "Total", "", 0, "R", false, 0, "")
+	doc.CellFormat(invoiceColumns[4], 8, formatMoney(detail.TotalPrice.WithCurrency(currencyCode)), "T", 1, "R", false, 0, "")
+	doc.Ln(4)
+
+	// Notes
+	doc.SetFont(fontFamily, "", 8)
+	doc.SetTextColor(80, 80, 80)
+	if rateIDR, err := currency.NewMoney(detail.ExchangeRate, ""); err == nil && detail.ExchangeRate > 0 {
+		rate := fmt.Sprintf("Prices not set in %s were converted at IDR %s per %s", currencyCode,
+			formatMoney(rateIDR), currencyCode)
+		if detail.ExchangeRateMarkup > 0 {
+			rate += fmt.Sprintf(" plus a %g%% markup", detail.ExchangeRateMarkup)
+		}
+		if detail.ExchangeRateDate != "" {
+			rate += fmt.Sprintf(", rate of %s", formatDate(detail.ExchangeRateDate))
+		}
+		doc.MultiCell(0, 4.5, rate+".", "", "L", false)
+	}
+	if detail.Description != "" {
+		doc.MultiCell(0, 4.5, detail.Description, "", "L", false)
+	}
+
+	return doc.bytes()
+}
+
+// formatDate formats a YYYY-MM-DD date for documents, other values are returned unchanged
+func formatDate(date string) string {
+	parsed, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return date
+	}
+	return parsed.Format("02 Jan 2006")
+}
@@ -0,0 +1,161 @@
+package document
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"strings"
+	"time"
+	"wtm-backend/config"
+	"wtm-backend/pkg/currency"
+
+	"github.com/go-pdf/fpdf"
+)
+
+const (
+	pageMargin = 15.0
+	lineHeight = 6.0
+)
+
+// fontFamily is DejaVu Sans Condensed, embedded because the core fonts only cover cp1252 and garble names in other scripts
+const fontFamily = "DejaVu"
+
+var (
+	//go:embed fonts/DejaVuSansCondensed.ttf
+	fontRegular []byte
+	//go:embed fonts/DejaVuSansCondensed-Bold.ttf
+	fontBold []byte
+	//go:embed fonts/DejaVuSansCondensed-Oblique.ttf
+	fontItalic []byte
+)
+
+// brandColor is the accent of headings and table headers
+var brandColor = [3]int{31, 78, 121}
+
+// PDFRenderer renders invoices and vouchers with the company letterhead
+type PDFRenderer struct {
+	CompanyName    string
+	CompanyAddress string
+	CompanyPhone   string
+	CompanyEmail   string
+}
+
+// NewPDFRenderer initializes the renderer with the company details of the config
+func NewPDFRenderer(cfg *config.Config) *PDFRenderer {
+	return &PDFRenderer{
+		CompanyName:    cfg.CompanyName,
+		CompanyAddress: cfg.CompanyAddress,
+		CompanyPhone:   cfg.CompanyPhone,
+		CompanyEmail:   cfg.EmailContactUs,
+	}
+}
+
+// pdfDocument wraps fpdf with the sections shared by the documents
+type pdfDocument struct {
+	*fpdf.Fpdf
+}
+
+func (r *PDFRenderer) newDocument(title string, createdAt time.Time) *pdfDocument {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin+5)
+	pdf.SetTitle(title, true)
+	pdf.SetAuthor(r.CompanyName, true)
+	pdf.SetCreationDate(createdAt)
+	pdf.AliasNbPages("")
+	pdf.AddUTF8FontFromBytes(fontFamily, "", fontRegular)
+	pdf.AddUTF8FontFromBytes(fontFamily, "B", fontBold)
+	pdf.AddUTF8FontFromBytes(fontFamily, "I", fontItalic)
+
+	doc := &pdfDocument{Fpdf: pdf}
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-pageMargin)
+		pdf.SetFont(fontFamily, "I", 8)
+		pdf.SetTextColor(128, 128, 128)
+		pdf.CellFormat(0, 5, fmt.Sprintf("%s - Page %d of {nb}", title, pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+	pdf.AddPage()
+	return doc
+}
+
+// letterhead writes the company details on the left and the document title on the right
+func (r *PDFRenderer) letterhead(doc *pdfDocument, title string, references [][2]string) {
+	top := doc.GetY()
+	pageWidth, _ := doc.GetPageSize()
+	half := (pageWidth - 2*pageMargin) / 2
+
+	doc.SetFont(fontFamily, "B", 16)
+	doc.SetTextColor(brandColor[0], brandColor[1], brandColor[2])
+	doc.CellFormat(half, 8, r.CompanyName, "", 2, "L", false, 0, "")
+	doc.SetFont(fontFamily, "", 9)
+	doc.SetTextColor(80, 80, 80)
+	for _, line := range []string{r.CompanyAddress, r.CompanyPhone, r.CompanyEmail} {
+		if line != "" {
+			doc.MultiCell(half, 4.5, line, "", "L", false)
+		}
+	}
+	bottom := doc.GetY()
+
+	doc.SetXY(pageMargin+half, top)
+	doc.SetFont(fontFamily, "B", 18)
+	doc.SetTextColor(brandColor[0], brandColor[1], brandColor[2])
+	doc.CellFormat(half, 8, title, "", 2, "R", false, 0, "")
+	doc.SetFont(fontFamily, "", 9)
+	doc.SetTextColor(0, 0, 0)
+	for _, ref := range references {
+		doc.CellFormat(half, 4.5, fmt.Sprintf("%s: %s", ref[0], ref[1]), "", 2, "R", false, 0, "")
+	}
+
+	doc.SetY(max(bottom, doc.GetY()) + 4)
+	doc.SetDrawColor(brandColor[0], brandColor[1], brandColor[2])
+	doc.Line(pageMargin, doc.GetY(), pageWidth-pageMargin, doc.GetY())
+	doc.Ln(5)
+}
+
+// section writes a heading followed by label/value rows, rows with an empty value are skipped
+func (doc *pdfDocument) section(heading string, rows [][2]string) {
+	doc.SetFont(fontFamily, "B", 11)
+	doc.SetTextColor(brandColor[0], brandColor[1], brandColor[2])
+	doc.CellFormat(0, 7, heading, "", 1, "L", false, 0, "")
+	doc.SetTextColor(0, 0, 0)
+	for _, row := range rows {
+		if strings.TrimSpace(row[1]) == "" {
+			continue
+		}
+		doc.SetFont(fontFamily, "B", 9)
+		doc.CellFormat(40, 5, row[0], "", 0, "L", false, 0, "")
+		doc.SetFont(fontFamily, "", 9)
+		doc.MultiCell(0, 5, row[1], "", "L", false)
+	}
+	doc.Ln(3)
+}
+
+func (doc *pdfDocument) bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := doc.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// formatMoney formats an amount with thousands separators, e.g. "IDR 1,250,000"
+func formatMoney(m currency.Money) string {
+	amount := m.String()
+	sign := ""
+	if strings.HasPrefix(amount, "-") {
+		sign, amount = "-", amount[1:]
+	}
+	whole, fraction, hasFraction := strings.Cut(amount, ".")
+
+	var grouped strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+	if hasFraction {
+		grouped.WriteString("." + fraction)
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s %s%s", m.Currency(), sign, grouped.String()))
+}
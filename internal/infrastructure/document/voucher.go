@@ -0,0 +1,57 @@
+package document
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"wtm-backend/internal/domain/entity"
+)
+
+// RenderVoucher renders the hotel voucher of a confirmed sub-booking
+func (r *PDFRenderer) RenderVoucher(ctx context.Context, voucher entity.Voucher) ([]byte, error) {
+	doc := r.newDocument(fmt.Sprintf("Voucher %s", voucher.SubBookingID), voucher.ConfirmedAt)
+	r.letterhead(doc, "HOTEL VOUCHER", [][2]string{
+		{"Sub Booking ID", voucher.SubBookingID},
+		{"Booking ID", voucher.BookingCode},
+		{"Confirmed", voucher.ConfirmedAt.Format("02 Jan 2006")},
+	})
+
+	nights := int(voucher.CheckOut.Sub(voucher.CheckIn).Hours() / 24)
+	checkIn := voucher.CheckIn.Format("Mon, 02 Jan 2006")
+	if voucher.CheckInHour != "" {
+		checkIn += fmt.Sprintf(" from %s", voucher.CheckInHour)
+	}
+	checkOut := voucher.CheckOut.Format("Mon, 02 Jan 2006")
+	if voucher.CheckOutHour != "" {
+		checkOut += fmt.Sprintf(" until %s", voucher.CheckOutHour)
+	}
+
+	doc.section("Hotel", [][2]string{
+		{"Name", voucher.HotelName},
+		{"Address", voucher.HotelAddress},
+		{"Email", voucher.HotelEmail},
+	})
+	doc.section("Reservation", [][2]string{
+		{"Guest", voucher.Guest},
+		{"Room Type", voucher.RoomTypeName},
+		{"Rooms", strconv.Itoa(max(voucher.Quantity, 1))},
+		{"Bed Type", voucher.BedType},
+		{"Check-in", checkIn},
+		{"Check-out", checkOut},
+		{"Nights", strconv.Itoa(nights)},
+		{"Additional Services", strings.Join(voucher.Additionals, ", ")},
+		{"Preferences", voucher.OtherPreferences},
+	})
+	doc.section("Booked By", [][2]string{
+		{"Company", voucher.AgentCompany},
+		{"Agent", voucher.Agent},
+	})
+
+	doc.SetFont(fontFamily, "I", 8)
+	doc.SetTextColor(80, 80, 80)
+	doc.MultiCell(0, 4.5, "Please present this voucher at check-in. Room rates are settled with the booking agent, "+
+		"services not listed above are paid by the guest directly to the hotel.", "", "L", false)
+
+	return doc.bytes()
+}
@@ -0,0 +1,91 @@
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"wtm-backend/config"
+)
+
+// MailhogInbox reads the replies sent to the inbound email address from Mailhog,
+// standing in for the webhook of the mail provider when running locally
+type MailhogInbox struct {
+	baseURL string
+	address string
+	client  *http.Client
+}
+
+// InboxMessage is a raw MIME message of the inbox
+type InboxMessage struct {
+	ID  string
+	Raw []byte
+}
+
+func NewMailhogInbox(cfg *config.Config) *MailhogInbox {
+	return &MailhogInbox{
+		baseURL: strings.TrimRight(cfg.URLMailhogAPI, "/"),
+		address: cfg.InboundEmailAddress,
+		client:  &http.Client{Timeout: cfg.DurationCtxTOSlow},
+	}
+}
+
+type mailhogSearchResponse struct {
+	Items []struct {
+		ID  string `json:"ID"`
+		Raw struct {
+			Data string `json:"Data"`
+		} `json:"Raw"`
+	} `json:"items"`
+}
+
+// Fetch returns the messages addressed to the inbound email address
+func (m *MailhogInbox) Fetch(ctx context.Context) ([]InboxMessage, error) {
+	query := url.Values{"kind": {"to"}, "query": {m.address}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.baseURL+"/api/v2/search?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("mailhog search returned %s", resp.Status)
+	}
+
+	var result mailhogSearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode mailhog search: %w", err)
+	}
+
+	messages := make([]InboxMessage, 0, len(result.Items))
+	for _, item := range result.Items {
+		messages = append(messages, InboxMessage{ID: item.ID, Raw: []byte(item.Raw.Data)})
+	}
+	return messages, nil
+}
+
+// Delete removes a processed message from Mailhog
+func (m *MailhogInbox) Delete(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, m.baseURL+"/api/v1/messages/"+url.PathEscape(id), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("mailhog delete returned %s", resp.Status)
+	}
+	return nil
+}
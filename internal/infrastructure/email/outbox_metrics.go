@@ -0,0 +1,37 @@
+package email
+
+import (
+	"wtm-backend/pkg/constant"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	outboxDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "email_outbox_depth",
+		Help: "Number of unsent emails in the outbox per status.",
+	}, []string{"status"})
+
+	outboxSent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "email_outbox_sent_total",
+		Help: "Number of outbox emails sent per scope.",
+	}, []string{"scope"})
+
+	outboxFailedAttempts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "email_outbox_failed_attempts_total",
+		Help: "Number of failed outbox send attempts per scope.",
+	}, []string{"scope"})
+
+	outboxDead = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "email_outbox_dead_total",
+		Help: "Number of outbox emails moved to dead-letter per scope.",
+	}, []string{"scope"})
+)
+
+// setOutboxDepth exports the counts per status, statuses missing from counts are empty
+func setOutboxDepth(counts map[string]int64) {
+	for _, status := range []string{constant.EmailOutboxPending, constant.EmailOutboxProcessing, constant.EmailOutboxDead} {
+		outboxDepth.WithLabelValues(status).Set(float64(counts[status]))
+	}
+}
@@ -0,0 +1,202 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+	"wtm-backend/config"
+	"wtm-backend/internal/domain"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// OutboxWorker sends the emails of the outbox with a pool of workers, retrying failures with exponential backoff
+type OutboxWorker struct {
+	repo    domain.EmailRepository
+	sender  domain.EmailSender
+	storage domain.StorageClient
+
+	workers      int
+	batchSize    int
+	pollInterval time.Duration
+	lease        time.Duration
+	maxAttempts  int
+	backoff      time.Duration
+	maxBackoff   time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewOutboxWorker initializes the worker pool, it sends nothing until Start
+func NewOutboxWorker(cfg *config.Config, repo domain.EmailRepository, sender domain.EmailSender, storage domain.StorageClient) *OutboxWorker {
+	return &OutboxWorker{
+		repo:         repo,
+		sender:       sender,
+		storage:      storage,
+		workers:      max(cfg.EmailOutboxWorkers, 1),
+		batchSize:    max(cfg.EmailOutboxBatchSize, 1),
+		pollInterval: cfg.EmailOutboxPollInterval,
+		lease:        cfg.EmailOutboxLease,
+		maxAttempts:  max(cfg.EmailOutboxMaxAttempts, 1),
+		backoff:      cfg.EmailOutboxBackoff,
+		maxBackoff:   cfg.EmailOutboxMaxBackoff,
+	}
+}
+
+// Start polls the outbox until Stop is called
+func (w *OutboxWorker) Start(ctx context.Context) {
+	ctx, w.cancel = context.WithCancel(ctx)
+	jobs := make(chan entity.EmailOutbox)
+
+	for i := 0; i < w.workers; i++ {
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			for outbox := range jobs {
+				w.send(outbox)
+			}
+		}()
+	}
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		defer close(jobs)
+		w.poll(ctx, jobs)
+	}()
+
+	logger.Info(ctx, fmt.Sprintf("[email-outbox] started %d workers", w.workers))
+}
+
+// Stop stops claiming emails and waits for the emails being sent
+func (w *OutboxWorker) Stop() {
+	if w.cancel == nil {
+		return
+	}
+	w.cancel()
+	w.wg.Wait()
+	logger.Info(context.Background(), "[email-outbox] stopped")
+}
+
+func (w *OutboxWorker) poll(ctx context.Context, jobs chan<- entity.EmailOutbox) {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if counts, err := w.repo.CountEmailOutbox(ctx); err == nil {
+			setOutboxDepth(counts)
+		}
+
+		// A full batch means more may be due, claim again without waiting
+		for {
+			claimed, err := w.repo.ClaimEmailOutbox(ctx, w.batchSize, w.lease)
+			if err != nil {
+				break
+			}
+			for _, outbox := range claimed {
+				select {
+				case jobs <- outbox:
+				case <-ctx.Done():
+					// Unsent claims are picked up again once their lease expires
+					return
+				}
+			}
+			if len(claimed) < w.batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// send delivers one email and records the outcome. It does not use the poll context so shutdown lets it finish.
+func (w *OutboxWorker) send(outbox entity.EmailOutbox) {
+	// The claim counted this attempt, more than the maximum only happens when earlier workers stopped mid-send
+	if outbox.Attempts > w.maxAttempts {
+		w.recordFailure(outbox, fmt.Errorf("worker stopped before finishing %d attempts", outbox.Attempts-1))
+		return
+	}
+
+	// The lease runs from the claim, time spent waiting for a free worker counts. A lease that expired before the
+	// send started is left to the next claim, which already counted this attempt.
+	deadline := outbox.LockedAt.Add(w.lease)
+	if !time.Now().Before(deadline) {
+		logger.Warn(context.Background(), fmt.Sprintf("[email-outbox] id=%d lease expired before sending, left for the next claim", outbox.ID))
+		return
+	}
+
+	sendCtx, cancelSend := context.WithDeadline(context.Background(), deadline)
+	attachments, err := w.loadAttachments(sendCtx, outbox.Attachments)
+	if err == nil {
+		err = w.sender.Send(sendCtx, constant.Scope(outbox.Scope), outbox.To, outbox.Subject, outbox.BodyHTML, outbox.BodyText, attachments...)
+	}
+	cancelSend()
+
+	if err != nil {
+		w.recordFailure(outbox, err)
+		return
+	}
+
+	// A send that ran out of time still gets its outcome recorded
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	outboxSent.WithLabelValues(outbox.Scope).Inc()
+	if err := w.repo.MarkEmailOutboxSent(ctx, outbox.ID, outbox.LockedAt); err != nil {
+		logger.Error(ctx, "[email-outbox] failed to mark sent", err.Error())
+	}
+}
+
+// recordFailure schedules the next attempt of the email, or moves it to dead-letter once it used all attempts
+func (w *OutboxWorker) recordFailure(outbox entity.EmailOutbox, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	attempt := outbox.Attempts
+	dead := attempt >= w.maxAttempts
+	outboxFailedAttempts.WithLabelValues(outbox.Scope).Inc()
+	if dead {
+		outboxDead.WithLabelValues(outbox.Scope).Inc()
+		logger.Error(ctx, fmt.Sprintf("[email-outbox] id=%d to=%s moved to dead-letter after %d attempts", outbox.ID, outbox.To, attempt), err.Error())
+	} else {
+		logger.Warn(ctx, fmt.Sprintf("[email-outbox] id=%d to=%s attempt %d failed", outbox.ID, outbox.To, attempt), err.Error())
+	}
+
+	nextAttemptAt := time.Now().Add(utils.Backoff(attempt, w.backoff, w.maxBackoff))
+	if err := w.repo.MarkEmailOutboxFailed(ctx, outbox.ID, outbox.LockedAt, err.Error(), nextAttemptAt, dead); err != nil {
+		logger.Error(ctx, "[email-outbox] failed to mark failed", err.Error())
+	}
+}
+
+// loadAttachments reads the content of the attachments from storage, a missing file fails the attempt
+func (w *OutboxWorker) loadAttachments(ctx context.Context, attachments []entity.EmailAttachment) ([]entity.EmailAttachment, error) {
+	loaded := make([]entity.EmailAttachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		object, err := w.storage.GetFileObject(ctx, attachment.Bucket, attachment.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get attachment %s: %w", attachment.Filename, err)
+		}
+		content, err := io.ReadAll(object)
+		object.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read attachment %s: %w", attachment.Filename, err)
+		}
+
+		attachment.Content = content
+		if attachment.ContentType == "" {
+			attachment.ContentType = object.GetContentType()
+		}
+		loaded = append(loaded, attachment)
+	}
+	return loaded, nil
+}
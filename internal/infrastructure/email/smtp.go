@@ -1,14 +1,21 @@
 package email
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
 	"net"
 	"net/smtp"
+	"net/textproto"
 	"strings"
 	"time"
 	"wtm-backend/config"
+	"wtm-backend/internal/domain/entity"
 	"wtm-backend/pkg/constant"
 	"wtm-backend/pkg/logger"
 )
@@ -147,7 +154,7 @@ func buildAccounts(cfg *config.Config) map[string]SMTPAccount {
 	}
 }
 
-// Send sends email with retry logic
+// Send sends email with retry logic. Attachments must carry their content, inline ones are embedded by Content-ID.
 func (s *SMTPEmailSender) Send(
 	ctx context.Context,
 	scope constant.Scope,
@@ -155,6 +162,7 @@ func (s *SMTPEmailSender) Send(
 	subject string,
 	bodyHTML string,
 	bodyText string,
+	attachments ...entity.EmailAttachment,
 ) error {
 	// Validation
 	if to == "" {
@@ -175,15 +183,18 @@ func (s *SMTPEmailSender) Send(
 	from := s.resolveFrom(route, acc)
 
 	// Build message once
-	msg := s.buildMessage(route, to, subject, bodyHTML, bodyText, from)
+	msg, err := s.buildMessage(route, to, subject, bodyHTML, bodyText, from, attachments)
+	if err != nil {
+		return fmt.Errorf("build message failed: %w", err)
+	}
 
-	logger.Info(ctx, fmt.Sprintf("[email] scope=%s provider=%s from=%s to=%s",
-		scope, acc.Name, from, to))
+	logger.Info(ctx, fmt.Sprintf("[email] scope=%s provider=%s from=%s to=%s attachments=%d",
+		scope, acc.Name, from, to, len(attachments)))
 
 	var lastErr error
 
 	// Retry logic
-	err := s.sendEmail(ctx, acc, from, to, msg)
+	err = s.sendEmail(ctx, acc, from, to, msg)
 	if err == nil {
 		logger.Info(ctx, fmt.Sprintf("[email] ✅ sent via %s to %s", acc.Name, to))
 		return nil
@@ -380,7 +391,9 @@ func (s *SMTPEmailSender) dialWithTimeout(
 	return dialer.DialContext(dialCtx, "tcp", addr)
 }
 
-// buildMessage constructs RFC 5322 compliant email
+// buildMessage constructs RFC 5322 compliant email.
+// Without attachments the body is multipart/alternative, inline images wrap it in multipart/related
+// and file attachments wrap everything in multipart/mixed.
 func (s *SMTPEmailSender) buildMessage(
 	route ScopeRoute,
 	to string,
@@ -388,8 +401,9 @@ func (s *SMTPEmailSender) buildMessage(
 	bodyHTML string,
 	bodyText string,
 	from string,
-) []byte {
-	var b strings.Builder
+	attachments []entity.EmailAttachment,
+) ([]byte, error) {
+	var b bytes.Buffer
 
 	// Required headers
 	b.WriteString(fmt.Sprintf("From: %s\r\n", from))
@@ -412,35 +426,157 @@ func (s *SMTPEmailSender) buildMessage(
 
 	b.WriteString("MIME-Version: 1.0\r\n")
 
-	// Multipart setup
-	boundary := fmt.Sprintf("----=_Part_%d_%d", time.Now().Unix(), time.Now().UnixNano())
-	b.WriteString(fmt.Sprintf("Content-Type: multipart/alternative; boundary=\"%s\"\r\n", boundary))
+	var inline, files []entity.EmailAttachment
+	for _, attachment := range attachments {
+		if attachment.Inline() {
+			inline = append(inline, attachment)
+		} else {
+			files = append(files, attachment)
+		}
+	}
+
+	// Multipart setup, the outermost part is mixed with files, related with inline images only
+	top := multipart.NewWriter(&b)
+	mediaType := "multipart/alternative"
+	switch {
+	case len(files) > 0:
+		mediaType = "multipart/mixed"
+	case len(inline) > 0:
+		mediaType = "multipart/related"
+	}
+	b.WriteString(fmt.Sprintf("Content-Type: %s; boundary=\"%s\"\r\n", mediaType, top.Boundary()))
 	b.WriteString("\r\n")
 
+	// Inline images belong to the body they are shown in, nested in the mixed part when files are attached too
+	related := top
+	if len(files) > 0 && len(inline) > 0 {
+		w, err := nestedWriter(top, "multipart/related")
+		if err != nil {
+			return nil, err
+		}
+		related = w
+	}
+
+	alternative := related
+	if len(attachments) > 0 {
+		w, err := nestedWriter(related, "multipart/alternative")
+		if err != nil {
+			return nil, err
+		}
+		alternative = w
+	}
+
 	// Plain text part
-	b.WriteString(fmt.Sprintf("--%s\r\n", boundary))
-	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
-	b.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
-	if bodyText != "" {
-		b.WriteString(bodyText)
-	} else {
-		b.WriteString("Please view the HTML version of this email.")
+	if bodyText == "" {
+		bodyText = "Please view the HTML version of this email."
+	}
+	if err := writeTextPart(alternative, "text/plain", bodyText); err != nil {
+		return nil, err
 	}
-	b.WriteString("\r\n\r\n")
 
 	// HTML part
 	if bodyHTML != "" {
-		b.WriteString(fmt.Sprintf("--%s\r\n", boundary))
-		b.WriteString("Content-Type: text/html; charset=UTF-8\r\n")
-		b.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
-		b.WriteString(bodyHTML)
-		b.WriteString("\r\n\r\n")
+		if err := writeTextPart(alternative, "text/html", bodyHTML); err != nil {
+			return nil, err
+		}
+	}
+
+	if alternative != related {
+		if err := alternative.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, attachment := range inline {
+		if err := writeAttachmentPart(related, attachment); err != nil {
+			return nil, err
+		}
+	}
+
+	if related != top {
+		if err := related.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, attachment := range files {
+		if err := writeAttachmentPart(top, attachment); err != nil {
+			return nil, err
+		}
 	}
 
 	// End boundary
-	b.WriteString(fmt.Sprintf("--%s--\r\n", boundary))
+	if err := top.Close(); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// nestedWriter starts a multipart part of mediaType inside parent
+func nestedWriter(parent *multipart.Writer, mediaType string) (*multipart.Writer, error) {
+	// The boundary goes in the part header, so it is generated before the part is created
+	boundary := multipart.NewWriter(io.Discard).Boundary()
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", fmt.Sprintf("%s; boundary=\"%s\"", mediaType, boundary))
+	part, err := parent.CreatePart(header)
+	if err != nil {
+		return nil, err
+	}
+
+	w := multipart.NewWriter(part)
+	if err := w.SetBoundary(boundary); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func writeTextPart(w *multipart.Writer, contentType string, body string) error {
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType+"; charset=UTF-8")
+	header.Set("Content-Transfer-Encoding", "8bit")
+	part, err := w.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	_, err = part.Write([]byte(body + "\r\n"))
+	return err
+}
+
+// writeAttachmentPart writes the attachment base64 encoded, in lines of 76 characters
+func writeAttachmentPart(w *multipart.Writer, attachment entity.EmailAttachment) error {
+	contentType := attachment.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	disposition := "attachment"
+	if attachment.Inline() {
+		disposition = "inline"
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", mime.FormatMediaType(contentType, map[string]string{"name": attachment.Filename}))
+	header.Set("Content-Transfer-Encoding", "base64")
+	header.Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": attachment.Filename}))
+	if attachment.Inline() {
+		header.Set("Content-ID", fmt.Sprintf("<%s>", attachment.ContentID))
+	}
 
-	return []byte(b.String())
+	part, err := w.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(attachment.Content)
+	for len(encoded) > 76 {
+		if _, err := io.WriteString(part, encoded[:76]+"\r\n"); err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+	_, err = io.WriteString(part, encoded+"\r\n")
+	return err
 }
 
 // resolveFrom determines the From address
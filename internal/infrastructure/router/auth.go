@@ -3,6 +3,7 @@ package router
 import (
 	"wtm-backend/internal/bootstrap"
 	"wtm-backend/internal/handler/auth_handler"
+	"wtm-backend/pkg/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -12,12 +13,40 @@ func AuthRoutes(app *bootstrap.Application, middlewareMap MiddlewareMap, routerG
 
 	auth := routerGroup.Group("")
 	{
-		auth.POST("/login", authHandler.Login)
+		auth.POST("/login", middlewareMap.RateLimitLogin, authHandler.Login)
+		auth.POST("/login/2fa", middlewareMap.RateLimitTwoFactor, authHandler.VerifyTwoFactorLogin)
+		auth.POST("/login/2fa/setup", middlewareMap.RateLimitTwoFactor, authHandler.SetupTwoFactorLogin)
 		auth.GET("/refresh-token", authHandler.RefreshToken)
 		auth.POST("/logout", middlewareMap.Auth, authHandler.Logout)
-		auth.POST("/forgot-password", authHandler.ForgotPassword)
+		auth.POST("/forgot-password", middlewareMap.RateLimitForgotPassword, authHandler.ForgotPassword)
 		auth.GET("/reset-password", authHandler.ValidateTokenResetPassword)
 		auth.POST("/reset-password", authHandler.ResetPassword)
 	}
 
+	sessions := routerGroup.Group("/profile/sessions", middlewareMap.Auth)
+	{
+		sessions.GET("", authHandler.ListSessions)
+		sessions.DELETE("", authHandler.RevokeOtherSessions)
+		sessions.DELETE("/:id", authHandler.RevokeSession)
+	}
+
+	twoFactor := routerGroup.Group("/profile/2fa", middlewareMap.Auth)
+	{
+		twoFactor.GET("", authHandler.GetTwoFactorStatus)
+		twoFactor.POST("/setup", authHandler.SetupTwoFactor)
+		twoFactor.POST("/verify", authHandler.VerifyTwoFactorSetup)
+		twoFactor.POST("/disable", authHandler.DisableTwoFactor)
+		twoFactor.POST("/recovery-codes", authHandler.RegenerateRecoveryCodes)
+	}
+
+	apiKeys := routerGroup.Group("/profile/api-keys", middlewareMap.Auth)
+	{
+		apiKeys.GET("", authHandler.ListAPIKeys)
+		apiKeys.POST("", authHandler.CreateAPIKey)
+		apiKeys.DELETE("/:id", authHandler.RevokeAPIKey)
+	}
+
+	routerGroup.POST("/users/force-logout", middlewareMap.Auth, middlewareMap.RequirePermission(constant.PermissionAccountEdit), authHandler.ForceLogout)
+	routerGroup.POST("/users/unlock", middlewareMap.Auth, middlewareMap.RequirePermission(constant.PermissionAccountEdit), authHandler.UnlockUser)
+
 }
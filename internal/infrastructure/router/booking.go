@@ -3,6 +3,7 @@ package router
 import (
 	"wtm-backend/internal/bootstrap"
 	"wtm-backend/internal/handler/booking_handler"
+	"wtm-backend/pkg/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -10,9 +11,13 @@ import (
 func BookingRoute(app *bootstrap.Application, mm MiddlewareMap, routerGroup *gin.RouterGroup) {
 	bookingHandler := booking_handler.NewBookingHandler(app.Usecases.BookingUsecase)
 
-	bookingRouter := routerGroup.Group("/bookings", mm.Auth)
+	// Webhook of the mail provider, signed instead of authenticated
+	routerGroup.POST("/bookings/inbound-email", mm.InboundEmailSignature, mm.TimeoutSlow, bookingHandler.ReceiveInboundEmail)
+
+	// API keys are accepted only on the routes of agents, with the scope of the route
+	bookingRouter := routerGroup.Group("/bookings")
 	{
-		cart := bookingRouter.Group("/cart")
+		cart := bookingRouter.Group("/cart", mm.APIKeyAuth(constant.PermissionBookingCreate))
 		{
 			cart.POST("", mm.TimeoutSlow, bookingHandler.AddToCart)
 			cart.GET("", bookingHandler.ListCart)
@@ -23,19 +28,28 @@ func BookingRoute(app *bootstrap.Application, mm MiddlewareMap, routerGroup *gin
 			// Update additional notes per sub-cart (booking_detail) item
 			cart.POST("/sub-notes", bookingHandler.UpdateCartAdditionalNotes)
 		}
-		bookingRouter.GET("/ids", bookingHandler.ListBookingIDs)
-		bookingRouter.GET("/:booking_id/sub-ids", bookingHandler.ListSubBookingIDs)
-		bookingRouter.POST("/checkout", mm.TimeoutSlow, bookingHandler.CheckOutCart)
-		bookingRouter.GET("", mm.RequirePermission("booking:view"), bookingHandler.ListBookings)
-		bookingRouter.GET("/booking-status", mm.RequirePermission("promo:view"), bookingHandler.ListStatusBooking)
-		bookingRouter.POST("/booking-status", mm.RequirePermission("booking:edit"), bookingHandler.UpdateStatusBooking)
-		bookingRouter.POST("/payment-status", mm.RequirePermission("booking:edit"), bookingHandler.UpdateStatusPayment)
-		bookingRouter.GET("/payment-status", mm.RequirePermission("promo:view"), bookingHandler.ListStatusPayment)
-		bookingRouter.GET("/history", mm.RequirePermission("promo:view"), bookingHandler.ListBookingHistory)
-		bookingRouter.GET("/logs", mm.RequirePermission("promo:view"), bookingHandler.ListBookingLog)
-		bookingRouter.POST("/receipt", bookingHandler.UploadReceipt)
-		bookingRouter.POST("/:sub_booking_id/cancel", bookingHandler.CancelBooking)
+		bookingRouter.GET("/ids", mm.APIKeyAuth(constant.PermissionBookingView), bookingHandler.ListBookingIDs)
+		bookingRouter.GET("/:booking_id/sub-ids", mm.APIKeyAuth(constant.PermissionBookingView), bookingHandler.ListSubBookingIDs)
+		// Documents of a sub-booking, the wildcard keeps the name of the sub-ids route as gin requires
+		bookingRouter.GET("/:booking_id/invoice.pdf", mm.APIKeyAuth(constant.PermissionBookingView), mm.RequirePermission("booking:view"), bookingHandler.InvoicePDF)
+		bookingRouter.GET("/:booking_id/voucher.pdf", mm.APIKeyAuth(constant.PermissionBookingView), mm.RequirePermission("booking:view"), bookingHandler.VoucherPDF)
+		bookingRouter.POST("/checkout", mm.APIKeyAuth(constant.PermissionBookingCreate), mm.TimeoutSlow, bookingHandler.CheckOutCart)
+		bookingRouter.POST("/quote", mm.APIKeyAuth(constant.PermissionBookingCreate), bookingHandler.QuoteBooking)
+		bookingRouter.GET("", mm.APIKeyAuth(constant.PermissionBookingView), mm.RequirePermission("booking:view"), bookingHandler.ListBookings)
+		bookingRouter.GET("/booking-status", mm.Auth, mm.RequirePermission("promo:view"), bookingHandler.ListStatusBooking)
+		bookingRouter.POST("/booking-status", mm.Auth, mm.RequirePermission("booking:edit"), bookingHandler.UpdateStatusBooking)
+		bookingRouter.POST("/payment-status", mm.Auth, mm.RequirePermission("booking:edit"), bookingHandler.UpdateStatusPayment)
+		bookingRouter.GET("/payment-status", mm.Auth, mm.RequirePermission("promo:view"), bookingHandler.ListStatusPayment)
+		bookingRouter.GET("/history", mm.Auth, mm.RequirePermission("promo:view"), bookingHandler.ListBookingHistory)
+		bookingRouter.GET("/logs", mm.Auth, mm.RequirePermission("promo:view"), bookingHandler.ListBookingLog)
+		bookingRouter.POST("/receipt", mm.APIKeyAuth(constant.PermissionBookingCreate), bookingHandler.UploadReceipt)
+		bookingRouter.POST("/payments", mm.Auth, mm.RequirePermission("booking:edit"), bookingHandler.RecordPayment)
+		bookingRouter.GET("/payments", mm.APIKeyAuth(constant.PermissionBookingView), bookingHandler.ListPayments)
+		bookingRouter.DELETE("/payments/:id", mm.Auth, mm.RequirePermission("booking:edit"), bookingHandler.RemovePayment)
+		bookingRouter.POST("/:sub_booking_id/cancel", mm.APIKeyAuth(constant.PermissionBookingCreate), bookingHandler.CancelBooking)
+		bookingRouter.POST("/:sub_booking_id/amend", mm.APIKeyAuth(constant.PermissionBookingCreate), mm.TimeoutSlow, bookingHandler.AmendBooking)
+		bookingRouter.GET("/revisions/:sub_booking_id", mm.APIKeyAuth(constant.PermissionBookingView), bookingHandler.ListBookingRevisions)
 		// Update admin notes for booking detail (admin to agent)
-		bookingRouter.POST("/admin-notes", mm.RequirePermission("booking:edit"), bookingHandler.UpdateAdminNotes)
+		bookingRouter.POST("/admin-notes", mm.Auth, mm.RequirePermission("booking:edit"), bookingHandler.UpdateAdminNotes)
 	}
 }
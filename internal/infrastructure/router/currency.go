@@ -16,5 +16,13 @@ func CurrencyRoutes(app *bootstrap.Application, mm MiddlewareMap, routerGroup *g
 		currencies.GET("/active", currencyHandler.GetActiveCurrencies)
 		currencies.POST("", mm.RequirePermission("settings:create"), currencyHandler.CreateCurrency)
 		currencies.PUT("/:id", mm.RequirePermission("settings:edit"), currencyHandler.UpdateCurrency)
+
+		exchangeRates := currencies.Group("/exchange-rates")
+		{
+			exchangeRates.GET("", currencyHandler.ListExchangeRates)
+			exchangeRates.POST("", mm.RequirePermission("settings:create"), currencyHandler.CreateExchangeRate)
+			exchangeRates.PUT("/:id", mm.RequirePermission("settings:edit"), currencyHandler.UpdateExchangeRate)
+			exchangeRates.DELETE("/:id", mm.RequirePermission("settings:delete"), currencyHandler.RemoveExchangeRate)
+		}
 	}
 }
@@ -12,12 +12,17 @@ func EmailRoute(app *bootstrap.Application, middlewareMap MiddlewareMap, routerG
 
 	emailRouter := routerGroup.Group("/email")
 	{
-		emailRouter.POST("/contact-us", middlewareMap.TimeoutFast, emailHandler.SendContactUs)
+		emailRouter.POST("/contact-us", middlewareMap.RateLimitContactUs, middlewareMap.TimeoutFast, emailHandler.SendContactUs)
 		emailRouter.GET("/template", middlewareMap.Auth, middlewareMap.TimeoutFast, emailHandler.EmailTemplate)
-		emailRouter.PUT("/template", middlewareMap.Auth, middlewareMap.TimeoutFile, emailHandler.UpdateEmailTemplate)
+		emailRouter.PUT("/template", middlewareMap.Auth, middlewareMap.RequirePermission("settings:edit"), middlewareMap.TimeoutFile, emailHandler.UpdateEmailTemplate)
+		emailRouter.GET("/template/versions", middlewareMap.Auth, middlewareMap.RequirePermission("settings:view"), middlewareMap.TimeoutFast, emailHandler.ListEmailTemplateVersions)
+		emailRouter.POST("/template/rollback", middlewareMap.Auth, middlewareMap.RequirePermission("settings:edit"), middlewareMap.TimeoutFast, emailHandler.RollbackEmailTemplate)
+		emailRouter.POST("/template/preview", middlewareMap.Auth, middlewareMap.RequirePermission("settings:view"), middlewareMap.TimeoutFast, emailHandler.PreviewEmailTemplate)
+		emailRouter.POST("/template/test-send", middlewareMap.Auth, middlewareMap.RequirePermission("settings:edit"), middlewareMap.RateLimitTestSendEmail, middlewareMap.TimeoutSlow, emailHandler.TestSendEmailTemplate)
 		emailRouter.GET("/logs", middlewareMap.Auth, middlewareMap.TimeoutFast, emailHandler.ListEmailLogs)
 		emailRouter.GET("/logs/:id", middlewareMap.Auth, middlewareMap.TimeoutFast, emailHandler.GetEmailLogDetail)
-		emailRouter.POST("/logs/retry", middlewareMap.Auth, middlewareMap.TimeoutSlow, emailHandler.RetryEmail)
+		emailRouter.POST("/logs/retry", middlewareMap.Auth, middlewareMap.RequirePermission("settings:edit"), middlewareMap.TimeoutSlow, emailHandler.RetryEmail)
+		emailRouter.GET("/inbound", middlewareMap.Auth, middlewareMap.RequirePermission("booking:view"), middlewareMap.TimeoutFast, emailHandler.ListInboundEmails)
 	}
 
 }
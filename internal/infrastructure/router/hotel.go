@@ -3,6 +3,7 @@ package router
 import (
 	"wtm-backend/internal/bootstrap"
 	"wtm-backend/internal/handler/hotel_handler"
+	"wtm-backend/pkg/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -31,8 +32,8 @@ func HotelRoute(app *bootstrap.Application, mm MiddlewareMap, routerGroup *gin.R
 
 			agents := hotels.Group("/agent")
 			{
-				agents.GET("", mm.Auth, hotelHandler.ListHotelsForAgent)
-				agents.GET("/:id", mm.Auth, hotelHandler.DetailHotelForAgent)
+				agents.GET("", mm.APIKeyAuth(constant.PermissionHotelView), hotelHandler.ListHotelsForAgent)
+				agents.GET("/:id", mm.APIKeyAuth(constant.PermissionHotelView), hotelHandler.DetailHotelForAgent)
 			}
 
 			roomTypes := hotels.Group("/room-types", mm.Auth)
@@ -43,6 +44,24 @@ func HotelRoute(app *bootstrap.Application, mm MiddlewareMap, routerGroup *gin.R
 				roomTypes.DELETE("/:id", mm.RequirePermission("hotel:edit"), hotelHandler.RemoveRoomType)
 			}
 
+			ratePlans := hotels.Group("/rate-plans", mm.Auth)
+			{
+				ratePlans.GET("", mm.RequirePermission("hotel:view"), hotelHandler.ListRoomRatePlans)
+				ratePlans.POST("", mm.RequirePermission("hotel:edit"), hotelHandler.CreateRoomRatePlan)
+				ratePlans.PUT("/:id", mm.RequirePermission("hotel:edit"), hotelHandler.UpdateRoomRatePlan)
+				ratePlans.DELETE("/:id", mm.RequirePermission("hotel:edit"), hotelHandler.RemoveRoomRatePlan)
+			}
+
+			cancellationPolicies := hotels.Group("/cancellation-policies", mm.Auth)
+			{
+				cancellationPolicies.GET("", mm.RequirePermission("hotel:view"), hotelHandler.ListCancellationPolicies)
+				cancellationPolicies.POST("", mm.RequirePermission("hotel:edit"), hotelHandler.CreateCancellationPolicy)
+				cancellationPolicies.PUT("/:id", mm.RequirePermission("hotel:edit"), hotelHandler.UpdateCancellationPolicy)
+				cancellationPolicies.DELETE("/:id", mm.RequirePermission("hotel:edit"), hotelHandler.RemoveCancellationPolicy)
+			}
+			hotels.PUT("/cancellation-policy", mm.Auth, mm.RequirePermission("hotel:edit"), hotelHandler.UpdateHotelCancellationPolicy)
+			hotels.PUT("/deposit-rule", mm.Auth, mm.RequirePermission("hotel:edit"), hotelHandler.UpdateHotelDepositRule)
+
 			hotels.GET("/bed-types", mm.Auth, hotelHandler.ListAllBedTypes)
 			hotels.GET("/facilities", mm.Auth, hotelHandler.ListFacilities)
 			hotels.GET("/additional-rooms", mm.Auth, hotelHandler.ListAdditionalRooms)
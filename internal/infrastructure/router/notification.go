@@ -10,10 +10,14 @@ import (
 func NotificationRouter(app *bootstrap.Application, middlewareMap MiddlewareMap, routerGroup *gin.RouterGroup) {
 	notificationHandler := notification_handler.NewNotificationHandler(app.Usecases.NotificationUsecase)
 
+	// Long-lived, outside the timeout of the other routes
+	routerGroup.GET("/notifications/stream", middlewareMap.StreamAuth, notificationHandler.StreamNotifications)
+
 	notificationRouter := routerGroup.Group("/notifications", middlewareMap.TimeoutFast, middlewareMap.Auth)
 	{
 		notificationRouter.GET("", notificationHandler.ListNotifications)
 		notificationRouter.PUT("/read", notificationHandler.ReadNotification)
+		notificationRouter.GET("/settings", notificationHandler.GetNotificationSettings)
 		notificationRouter.PUT("/settings", notificationHandler.UpdateNotificationSettings)
 	}
 }
@@ -1,6 +1,7 @@
 package router
 
 import (
+	"context"
 	"wtm-backend/internal/bootstrap"
 	"wtm-backend/internal/middleware"
 	"wtm-backend/pkg/logger"
@@ -11,15 +12,33 @@ import (
 
 type MiddlewareMap struct {
 	Auth              gin.HandlerFunc
+	APIKeyAuth        func(scope string) gin.HandlerFunc // Auth that also accepts API keys with the scope
+	StreamAuth        gin.HandlerFunc
 	TimeoutFast       gin.HandlerFunc
 	TimeoutSlow       gin.HandlerFunc
 	TimeoutFile       gin.HandlerFunc
 	RequirePermission func(required string) gin.HandlerFunc
 	RequireRole       func(required string) gin.HandlerFunc
+
+	InboundEmailSignature gin.HandlerFunc
+
+	RateLimitLogin          gin.HandlerFunc
+	RateLimitTwoFactor      gin.HandlerFunc
+	RateLimitForgotPassword gin.HandlerFunc
+	RateLimitContactUs      gin.HandlerFunc
+	RateLimitTestSendEmail  gin.HandlerFunc
 }
 
 func SetupRouter(app *bootstrap.Application) *gin.Engine {
-	route := gin.Default()
+	// Logger bawaan gin mencatat query string apa adanya, termasuk access_token stream notifikasi
+	route := gin.New()
+	route.Use(middleware.RequestLogger())
+	route.Use(gin.Recovery())
+
+	// IP klien untuk rate limit hanya diambil dari X-Forwarded-For bila dikirim proxy yang dipercaya
+	if err := route.SetTrustedProxies(app.Config.TrustedProxyCIDRs); err != nil {
+		logger.Fatal(context.Background(), "Invalid TRUSTED_PROXIES", err.Error())
+	}
 
 	// Set maximum multipart memory size to 50MB for file uploads
 	// This prevents "payload too large" errors for hotel image uploads
@@ -29,17 +48,36 @@ func SetupRouter(app *bootstrap.Application) *gin.Engine {
 	route.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
 	//route.Use(app.Middleware.CORSMiddleware())
-	route.Use(gin.Logger())
-	route.Use(gin.Recovery())
 	route.Use(middleware.TraceIDMiddleware())
 
 	middlewareMap := MiddlewareMap{
 		Auth:              app.Middleware.AuthMiddleware(),
+		APIKeyAuth:        app.Middleware.APIKeyAuthMiddleware,
+		StreamAuth:        app.Middleware.StreamAuthMiddleware(),
 		TimeoutFast:       middleware.TimeoutMiddleware(app.Config.DurationCtxTOFast),
 		TimeoutSlow:       middleware.TimeoutMiddleware(app.Config.DurationCtxTOSlow),
 		TimeoutFile:       middleware.TimeoutMiddleware(app.Config.DurationCtxTOFile),
 		RequirePermission: app.Middleware.RequirePermission,
 		RequireRole:       app.Middleware.RequireRole,
+
+		InboundEmailSignature: app.Middleware.InboundEmailSignature(),
+
+		RateLimitLogin: app.Middleware.RateLimit(middleware.RateLimitRule{
+			Name: "login", Limit: app.Config.RateLimitLogin, Window: app.Config.RateLimitLoginWindow, KeyField: "username",
+		}),
+		// Langkah kedua login memakai batas yang sama dengan login, dihitung per challenge
+		RateLimitTwoFactor: app.Middleware.RateLimit(middleware.RateLimitRule{
+			Name: "login_2fa", Limit: app.Config.RateLimitLogin, Window: app.Config.RateLimitLoginWindow, KeyField: "challenge_token",
+		}),
+		RateLimitForgotPassword: app.Middleware.RateLimit(middleware.RateLimitRule{
+			Name: "forgot_password", Limit: app.Config.RateLimitForgotPassword, Window: app.Config.RateLimitForgotPasswordWindow, KeyField: "email",
+		}),
+		RateLimitContactUs: app.Middleware.RateLimit(middleware.RateLimitRule{
+			Name: "contact_us", Limit: app.Config.RateLimitContactUs, Window: app.Config.RateLimitContactUsWindow, KeyField: "email",
+		}),
+		RateLimitTestSendEmail: app.Middleware.RateLimit(middleware.RateLimitRule{
+			Name: "test_send_email", Limit: app.Config.RateLimitTestSendEmail, Window: app.Config.RateLimitTestSendEmailWindow,
+		}),
 	}
 
 	api := route.Group("api")
@@ -17,6 +17,8 @@ func UserRoutes(app *bootstrap.Application, mm MiddlewareMap, routerGroup *gin.R
 	{
 		roleAccess.GET("", userHandler.ListRoleAccess)
 		roleAccess.PUT("", userHandler.UpdateRoleAccess)
+		roleAccess.GET("/two-factor", userHandler.ListTwoFactorPolicies)
+		roleAccess.PUT("/two-factor", userHandler.UpdateTwoFactorPolicy)
 	}
 
 	profile := routerGroup.Group("/profile", mm.Auth)
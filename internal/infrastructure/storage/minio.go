@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -83,6 +84,23 @@ func (m *MinioClient) UploadFile(ctx context.Context, file multipart.File, fileH
 	return objectName, nil
 }
 
+func (m *MinioClient) UploadBytes(ctx context.Context, content []byte, contentType string, bucketName string, objectName string) (string, error) {
+	if err := m.ensureBucket(ctx, bucketName); err != nil {
+		logger.Error(ctx, "Error to ensure bucket", err.Error())
+		return "", err
+	}
+
+	_, err := m.client.PutObject(ctx, bucketName, objectName, bytes.NewReader(content), int64(len(content)), minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		logger.Error(ctx, "Error to upload file", err.Error())
+		return "", err
+	}
+
+	return objectName, nil
+}
+
 func (m *MinioClient) GetFile(ctx context.Context, bucketName, objectName string) (string, error) {
 	// Cek apakah file ada
 	_, err := m.client.StatObject(ctx, bucketName, objectName, minio.StatObjectOptions{})
@@ -26,8 +26,20 @@ type S3Client struct {
 }
 
 func (s *S3Client) GetFileObject(ctx context.Context, bucketName, objectName string) (domain.StreamableObject, error) {
-	//TODO implement me
-	panic("implement me")
+	out, err := s.s3.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file from S3: %s", err.Error())
+	}
+
+	return &S3Object{
+		body:        out.Body,
+		contentType: aws.ToString(out.ContentType),
+		contentLen:  aws.ToInt64(out.ContentLength),
+		filename:    objectName,
+	}, nil
 }
 
 type S3Object struct {
@@ -138,6 +150,24 @@ func (s *S3Client) UploadFile(ctx context.Context, file multipart.File, fileHead
 	return objectName, nil
 }
 
+func (s *S3Client) UploadBytes(ctx context.Context, content []byte, contentType string, bucketName string, objectName string) (string, error) {
+	if bucketName == "" || objectName == "" {
+		return "", errors.New("bucketName and objectName cannot be empty")
+	}
+
+	_, err := s.s3.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(objectName),
+		Body:        bytes.NewReader(content),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload file to S3: %s", err.Error())
+	}
+
+	return objectName, nil
+}
+
 func (s *S3Client) GetFile(ctx context.Context, bucketName, objectName string) (string, error) {
 	presignedReq, err := s.presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(bucketName),
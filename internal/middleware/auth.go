@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/response"
 	"wtm-backend/pkg/constant"
@@ -19,21 +20,56 @@ type userContextKey struct{}
 const (
 	roleKey       = "role"
 	permissionKey = "permissions"
+
+	// sessionTouchInterval is how often the last seen time of a session is updated
+	sessionTouchInterval = time.Minute
 )
 
+// AuthMiddleware accepts an access token of a session only, API keys are refused unless the route uses APIKeyAuthMiddleware.
 func (m *Middleware) AuthMiddleware() gin.HandlerFunc {
+	return m.authenticate(false, "")
+}
+
+// APIKeyAuthMiddleware is AuthMiddleware that also accepts a personal API key with the given scope, in the X-API-Key
+// header or as bearer token.
+func (m *Middleware) APIKeyAuthMiddleware(scope string) gin.HandlerFunc {
+	return m.authenticate(false, scope)
+}
+
+// StreamAuthMiddleware is AuthMiddleware for event streams. Browsers cannot set headers on an EventSource,
+// so the access token may also be passed in the access_token query parameter.
+func (m *Middleware) StreamAuthMiddleware() gin.HandlerFunc {
+	return m.authenticate(true, "")
+}
+
+// authenticate accepts API keys with apiKeyScope, none when it is empty
+func (m *Middleware) authenticate(allowQueryToken bool, apiKeyScope string) gin.HandlerFunc {
+	allowAPIKey := apiKeyScope != ""
 
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
 		authHeader := c.GetHeader("Authorization")
-		if !strings.HasPrefix(authHeader, "Bearer ") {
+
+		var tokenStr string
+		switch {
+		case allowAPIKey && c.GetHeader(APIKeyHeader) != "":
+			m.authenticateAPIKey(c, c.GetHeader(APIKeyHeader), apiKeyScope)
+			return
+		case strings.HasPrefix(authHeader, "Bearer "):
+			tokenStr = strings.TrimPrefix(authHeader, "Bearer ")
+		case allowQueryToken && c.Query("access_token") != "":
+			tokenStr = c.Query("access_token")
+		default:
 			logger.Warn(ctx, "Authorization header is not bearer token")
 			response.Error(c, http.StatusUnauthorized, "Unauthorized")
 			c.Abort()
 			return
 		}
 
-		tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
+		if allowAPIKey && strings.HasPrefix(tokenStr, constant.APIKeyPrefix) {
+			m.authenticateAPIKey(c, tokenStr, apiKeyScope)
+			return
+		}
 
 		claims, err := jwt.ParseToken(ctx, tokenStr, m.jwtSecret)
 		if err != nil {
@@ -52,20 +88,26 @@ func (m *Middleware) AuthMiddleware() gin.HandlerFunc {
 
 		user := m.GenerateUserFromClaimToken(claims)
 
-		// Cek apakah user ada di redis
-		ok, err := m.authRepo.ValidateAccessToken(c.Request.Context(), user.ID, tokenStr)
+		// Sesi token harus masih aktif, logout dan pencabutan sesi berlaku seketika
+		session, err := m.authRepo.GetActiveSession(ctx, claims.SessionID)
 		if err != nil {
-			logger.Error(ctx, "Failed to validate access token", "valid", ok, "err", err.Error())
+			logger.Error(ctx, "Failed to get session", "err", err.Error())
 			response.Error(c, http.StatusUnauthorized, "Unauthorized")
 			c.Abort()
 			return
-		} else if !ok {
-			logger.Error(ctx, "Access token not valid", "valid", ok)
+		} else if session == nil || session.UserID != user.ID {
+			logger.Error(ctx, "Session not active", "sessionID", claims.SessionID)
 			response.Error(c, http.StatusUnauthorized, "Unauthorized")
 			c.Abort()
 			return
 		}
 
+		if now := time.Now(); now.Sub(session.LastSeenAt) >= sessionTouchInterval {
+			if err := m.authRepo.TouchSession(ctx, session.SessionID, now); err != nil {
+				logger.Warn(ctx, "Failed to update last seen of session", err.Error())
+			}
+		}
+
 		// Simpan ke context.Context
 		ctx = context.WithValue(ctx, userContextKey{}, user)
 
@@ -88,6 +130,7 @@ func (m *Middleware) GenerateUserFromClaimToken(claims *jwt.JwtClaims) *entity.U
 		Permissions: claims.User.Permissions,
 		PhotoSelfie: claims.User.PhotoURL,
 		FullName:    claims.User.FullName,
+		SessionID:   claims.SessionID,
 	}
 }
 
@@ -102,8 +145,8 @@ func (m *Middleware) RequirePermission(required string) gin.HandlerFunc {
 			return
 		}
 
-		if role == constant.RoleSuperAdminCap {
-			// Superadmin has all permissions, skip check
+		// Superadmin has all permissions, skip check. An API key of a superadmin only has its scopes.
+		if _, viaAPIKey := c.Get(apiKeyScopesKey); !viaAPIKey && role == constant.RoleSuperAdminCap {
 			c.Next()
 			return
 		}
@@ -141,6 +184,13 @@ func (m *Middleware) RequirePermission(required string) gin.HandlerFunc {
 func (m *Middleware) RequireRole(required string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
+
+		// Halaman yang dijaga role hanya untuk login interaktif, API key hanya membawa permission
+		if _, viaAPIKey := c.Get(apiKeyScopesKey); viaAPIKey {
+			logger.Warn(ctx, "API key used on a role restricted route")
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+			return
+		}
 		role, exists := c.Get(roleKey)
 		if !exists {
 			logger.Warn(ctx, "Role not found in context")
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/inboundmail"
+	"wtm-backend/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	InboundEmailTimestampHeader = "X-Inbound-Timestamp"
+	InboundEmailSignatureHeader = "X-Inbound-Signature"
+
+	maxInboundEmailSize = 25 << 20 // 25MB, the usual limit of mail providers
+)
+
+// InboundEmailSignature accepts requests signed with the inbound email secret:
+// X-Inbound-Signature is the hex HMAC-SHA256 of "<X-Inbound-Timestamp>.<body>", the timestamp in unix seconds.
+func (m *Middleware) InboundEmailSignature() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxInboundEmailSize))
+		if err != nil {
+			logger.Warn(ctx, "Failed to read inbound email body", err.Error())
+			response.Error(c, http.StatusRequestEntityTooLarge, "Payload too large")
+			c.Abort()
+			return
+		}
+
+		timestamp := c.GetHeader(InboundEmailTimestampHeader)
+		signature := c.GetHeader(InboundEmailSignatureHeader)
+		if err := inboundmail.Verify(m.inboundEmailSecret, timestamp, signature, body, m.inboundEmailTolerance, time.Now()); err != nil {
+			logger.Warn(ctx, "Invalid inbound email signature", err.Error())
+			response.Error(c, http.StatusUnauthorized, "Unauthorized")
+			c.Abort()
+			return
+		}
+
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		c.Next()
+	}
+}
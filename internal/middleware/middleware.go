@@ -12,11 +12,17 @@ type Middleware struct {
 	jwtSecret      string
 	maxAgeCors     time.Duration
 	allowedOrigins []string
+
+	inboundEmailSecret    string
+	inboundEmailTolerance time.Duration
 }
 
 func NewMiddleware(config *config.Config, authRepo *auth_repository.AuthRepository) *Middleware {
 	return &Middleware{
 		jwtSecret: config.JWTSecret,
 		authRepo:  authRepo,
+
+		inboundEmailSecret:    config.InboundEmailSecret,
+		inboundEmailTolerance: config.InboundEmailTolerance,
 	}
 }
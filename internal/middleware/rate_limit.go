@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"wtm-backend/internal/response"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxRateLimitBodySize is how much of the body is read to find the key field, larger bodies are only limited per IP
+const maxRateLimitBodySize = 1 << 20 // 1MB
+
+// RateLimitRule limits the requests of a route within a fixed window, per client IP and, when KeyField is set, per
+// value of that field of the JSON or form body, so one account cannot be tried from many addresses either.
+type RateLimitRule struct {
+	Name     string // Prefix of the counters of the route
+	Limit    int    // 0 disables the limit
+	Window   time.Duration
+	KeyField string
+}
+
+func (m *Middleware) RateLimit(rule RateLimitRule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		if rule.Limit <= 0 {
+			c.Next()
+			return
+		}
+
+		// ClientIP hanya membaca X-Forwarded-For dari TRUSTED_PROXIES, header dari klien lain tidak bisa mengganti IP
+		keys := []string{fmt.Sprintf("%s:ip:%s", rule.Name, c.ClientIP())}
+		if rule.KeyField != "" {
+			if value := rateLimitBodyField(c, rule.KeyField); value != "" {
+				keys = append(keys, fmt.Sprintf("%s:%s:%s", rule.Name, rule.KeyField, utils.HashToken(value)))
+			}
+		}
+
+		for _, key := range keys {
+			count, ttl, err := m.authRepo.HitRateLimit(ctx, key, rule.Window)
+			if err != nil {
+				// Tanpa Redis permintaan tetap dilayani, pembatasan tidak boleh mematikan login
+				logger.Error(ctx, "Error counting rate limit, request is not limited", err.Error())
+				break
+			}
+
+			if count > int64(rule.Limit) {
+				logger.Warn(ctx, "Rate limit exceeded", "route", rule.Name, "ip", c.ClientIP())
+				c.Header("Retry-After", strconv.Itoa(int(math.Ceil(ttl.Seconds()))))
+				response.Error(c, http.StatusTooManyRequests, "Too many requests, please try again later")
+				c.Abort()
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// rateLimitBodyField returns the normalized value of field from the body and puts the body back for the handler
+func rateLimitBodyField(c *gin.Context, field string) string {
+	if c.Request.Body == nil {
+		return ""
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxRateLimitBodySize+1))
+	c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), c.Request.Body))
+	if err != nil || len(body) > maxRateLimitBodySize {
+		return ""
+	}
+
+	var value string
+	switch c.ContentType() {
+	case gin.MIMEJSON:
+		var payload map[string]interface{}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return ""
+		}
+		value, _ = payload[field].(string)
+	case gin.MIMEPOSTForm:
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return ""
+		}
+		value = form.Get(field)
+	}
+
+	return strings.ToLower(strings.TrimSpace(value))
+}
@@ -2,7 +2,10 @@ package auth_repository
 
 import (
 	"wtm-backend/internal/domain"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
 	"wtm-backend/internal/repository/driver"
+	"wtm-backend/pkg/utils"
 )
 
 type AuthRepository struct {
@@ -16,3 +19,39 @@ func NewAuthRepository(redisClient domain.RedisClient, db driver.DBPostgre) *Aut
 		db:          db,
 	}
 }
+
+func toEntityUserSession(session model.UserSession) entity.UserSession {
+	return entity.UserSession{
+		ID:         session.ID,
+		SessionID:  session.ExternalID.ExternalID,
+		UserID:     session.UserID,
+		Device:     session.Device,
+		IPAddress:  session.IPAddress,
+		UserAgent:  session.UserAgent,
+		CreatedAt:  session.CreatedAt,
+		LastSeenAt: session.LastSeenAt,
+		ExpiresAt:  session.ExpiresAt,
+	}
+}
+
+func toEntityAPIKey(apiKey model.UserAPIKey) entity.APIKey {
+	return entity.APIKey{
+		ID:         apiKey.ID,
+		KeyID:      apiKey.ExternalID.ExternalID,
+		UserID:     apiKey.UserID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		KeyHash:    apiKey.KeyHash,
+		Scopes:     apiKey.Scopes,
+		RateLimit:  apiKey.RateLimit,
+		CreatedAt:  apiKey.CreatedAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		LastUsedIP: apiKey.LastUsedIP,
+		ExpiresAt:  apiKey.ExpiresAt,
+	}
+}
+
+// twoFactorChallengeKey is the redis key of a login challenge, the token itself is only known by the client
+func twoFactorChallengeKey(token string) string {
+	return "two_factor_challenge:" + utils.HashToken(token)
+}
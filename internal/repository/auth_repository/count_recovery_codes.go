@@ -0,0 +1,22 @@
+package auth_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) CountRecoveryCodes(ctx context.Context, userID uint) (int64, error) {
+	db := ar.db.GetTx(ctx)
+
+	var count int64
+	if err := db.WithContext(ctx).
+		Model(&model.UserRecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error; err != nil {
+		logger.Error(ctx, "Error counting recovery codes of user", err.Error())
+		return 0, err
+	}
+
+	return count, nil
+}
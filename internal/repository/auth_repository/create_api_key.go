@@ -0,0 +1,29 @@
+package auth_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) CreateAPIKey(ctx context.Context, apiKey *entity.APIKey) error {
+	db := ar.db.GetTx(ctx)
+
+	modelKey := model.UserAPIKey{
+		UserID:    apiKey.UserID,
+		Name:      apiKey.Name,
+		Prefix:    apiKey.Prefix,
+		KeyHash:   apiKey.KeyHash,
+		Scopes:    apiKey.Scopes,
+		RateLimit: apiKey.RateLimit,
+		ExpiresAt: apiKey.ExpiresAt,
+	}
+	if err := db.WithContext(ctx).Create(&modelKey).Error; err != nil {
+		logger.Error(ctx, "Error creating api key", err.Error())
+		return err
+	}
+
+	*apiKey = toEntityAPIKey(modelKey)
+	return nil
+}
@@ -0,0 +1,25 @@
+package auth_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) CreateRefreshToken(ctx context.Context, token *entity.RefreshToken) error {
+	db := ar.db.GetTx(ctx)
+
+	modelToken := model.RefreshToken{
+		UserSessionID: token.UserSessionID,
+		TokenHash:     token.TokenHash,
+		ExpiresAt:     token.ExpiresAt,
+	}
+	if err := db.WithContext(ctx).Create(&modelToken).Error; err != nil {
+		logger.Error(ctx, "Error creating refresh token", err.Error())
+		return err
+	}
+
+	token.ID = modelToken.ID
+	return nil
+}
@@ -0,0 +1,28 @@
+package auth_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) CreateSession(ctx context.Context, session *entity.UserSession) error {
+	db := ar.db.GetTx(ctx)
+
+	modelSession := model.UserSession{
+		UserID:     session.UserID,
+		Device:     session.Device,
+		IPAddress:  session.IPAddress,
+		UserAgent:  session.UserAgent,
+		LastSeenAt: session.LastSeenAt,
+		ExpiresAt:  session.ExpiresAt,
+	}
+	if err := db.WithContext(ctx).Create(&modelSession).Error; err != nil {
+		logger.Error(ctx, "Error creating user session", err.Error())
+		return err
+	}
+
+	*session = toEntityUserSession(modelSession)
+	return nil
+}
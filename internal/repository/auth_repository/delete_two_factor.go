@@ -0,0 +1,30 @@
+package auth_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+// DeleteTwoFactor removes the authenticator and the recovery codes of the user
+func (ar *AuthRepository) DeleteTwoFactor(ctx context.Context, userID uint) error {
+	db := ar.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).
+		Unscoped().
+		Where("user_id = ?", userID).
+		Delete(&model.UserRecoveryCode{}).Error; err != nil {
+		logger.Error(ctx, "Error deleting recovery codes of user", err.Error())
+		return err
+	}
+
+	if err := db.WithContext(ctx).
+		Unscoped().
+		Where("user_id = ?", userID).
+		Delete(&model.UserTwoFactor{}).Error; err != nil {
+		logger.Error(ctx, "Error deleting two-factor of user", err.Error())
+		return err
+	}
+
+	return nil
+}
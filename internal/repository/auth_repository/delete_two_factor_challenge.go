@@ -0,0 +1,14 @@
+package auth_repository
+
+import (
+	"context"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) DeleteTwoFactorChallenge(ctx context.Context, token string) error {
+	if err := ar.redisClient.Delete(ctx, twoFactorChallengeKey(token)); err != nil {
+		logger.Error(ctx, "Error deleting two-factor challenge from redis:", err.Error())
+		return err
+	}
+	return nil
+}
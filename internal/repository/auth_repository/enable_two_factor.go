@@ -0,0 +1,26 @@
+package auth_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) EnableTwoFactor(ctx context.Context, userID uint, step int64) (bool, error) {
+	db := ar.db.GetTx(ctx)
+
+	result := db.WithContext(ctx).
+		Model(&model.UserTwoFactor{}).
+		Where("user_id = ? AND enabled_at IS NULL", userID).
+		Updates(map[string]interface{}{
+			"enabled_at":     time.Now(),
+			"last_used_step": step,
+		})
+	if result.Error != nil {
+		logger.Error(ctx, "Error enabling two-factor of user", result.Error.Error())
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,46 @@
+package auth_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (*entity.APIKey, error) {
+	db := ar.db.GetTx(ctx)
+
+	var apiKey model.UserAPIKey
+	if err := db.WithContext(ctx).
+		Preload("User").
+		Preload("User.Role").
+		Preload("User.Role.Permissions").
+		Where("key_hash = ? AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > NOW())", keyHash).
+		First(&apiKey).Error; err != nil {
+		if ar.db.ErrRecordNotFound(ctx, err) {
+			return nil, nil
+		}
+		logger.Error(ctx, "Error getting api key", err.Error())
+		return nil, err
+	}
+
+	result := toEntityAPIKey(apiKey)
+
+	// Permission dibaca dari role saat ini, perubahan role access langsung berlaku untuk key yang sudah ada
+	user := apiKey.User
+	result.User = &entity.User{
+		ID:       user.ID,
+		Username: user.Username,
+		FullName: user.FullName,
+		StatusID: user.StatusID,
+		RoleID:   user.RoleID,
+	}
+	if user.Role != nil {
+		result.User.RoleName = user.Role.Role
+		for _, permission := range user.Role.Permissions {
+			result.User.Permissions = append(result.User.Permissions, permission.Permission)
+		}
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,27 @@
+package auth_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) GetActiveAPIKeysByUserID(ctx context.Context, userID uint) ([]entity.APIKey, error) {
+	db := ar.db.GetTx(ctx)
+
+	var apiKeys []model.UserAPIKey
+	if err := db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > NOW())", userID).
+		Order("created_at DESC").
+		Find(&apiKeys).Error; err != nil {
+		logger.Error(ctx, "Error getting api keys of user", err.Error())
+		return nil, err
+	}
+
+	result := make([]entity.APIKey, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		result = append(result, toEntityAPIKey(apiKey))
+	}
+	return result, nil
+}
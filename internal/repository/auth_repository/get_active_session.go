@@ -0,0 +1,28 @@
+package auth_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) GetActiveSession(ctx context.Context, sessionID string) (*entity.UserSession, error) {
+	db := ar.db.GetTx(ctx)
+
+	var session model.UserSession
+	err := db.WithContext(ctx).
+		Where("external_id = ? AND revoked_at IS NULL AND expires_at > NOW()", sessionID).
+		First(&session).Error
+	if err != nil {
+		if ar.db.ErrRecordNotFound(ctx, err) {
+			logger.Warn(ctx, "Active session not found", sessionID)
+			return nil, nil
+		}
+		logger.Error(ctx, "Error getting user session", err.Error())
+		return nil, err
+	}
+
+	result := toEntityUserSession(session)
+	return &result, nil
+}
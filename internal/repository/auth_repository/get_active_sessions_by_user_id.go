@@ -0,0 +1,27 @@
+package auth_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) GetActiveSessionsByUserID(ctx context.Context, userID uint) ([]entity.UserSession, error) {
+	db := ar.db.GetTx(ctx)
+
+	var sessions []model.UserSession
+	if err := db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > NOW()", userID).
+		Order("last_seen_at DESC").
+		Find(&sessions).Error; err != nil {
+		logger.Error(ctx, "Error getting user sessions", err.Error())
+		return nil, err
+	}
+
+	result := make([]entity.UserSession, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, toEntityUserSession(session))
+	}
+	return result, nil
+}
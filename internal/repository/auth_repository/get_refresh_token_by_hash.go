@@ -0,0 +1,36 @@
+package auth_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error) {
+	db := ar.db.GetTx(ctx)
+
+	var token model.RefreshToken
+	err := db.WithContext(ctx).
+		Preload("UserSession").
+		Where("token_hash = ?", tokenHash).
+		First(&token).Error
+	if err != nil {
+		if ar.db.ErrRecordNotFound(ctx, err) {
+			logger.Warn(ctx, "Refresh token not found")
+			return nil, nil
+		}
+		logger.Error(ctx, "Error getting refresh token", err.Error())
+		return nil, err
+	}
+
+	return &entity.RefreshToken{
+		ID:            token.ID,
+		UserSessionID: token.UserSessionID,
+		SessionID:     token.UserSession.ExternalID.ExternalID,
+		UserID:        token.UserSession.UserID,
+		TokenHash:     token.TokenHash,
+		ExpiresAt:     token.ExpiresAt,
+		UsedAt:        token.UsedAt,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package auth_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) GetTwoFactor(ctx context.Context, userID uint) (*entity.UserTwoFactor, error) {
+	db := ar.db.GetTx(ctx)
+
+	var twoFactor model.UserTwoFactor
+	if err := db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		First(&twoFactor).Error; err != nil {
+		if ar.db.ErrRecordNotFound(ctx, err) {
+			return nil, nil
+		}
+		logger.Error(ctx, "Error getting two-factor of user", err.Error())
+		return nil, err
+	}
+
+	return &entity.UserTwoFactor{
+		UserID:       twoFactor.UserID,
+		Secret:       twoFactor.Secret,
+		EnabledAt:    twoFactor.EnabledAt,
+		LastUsedStep: twoFactor.LastUsedStep,
+	}, nil
+}
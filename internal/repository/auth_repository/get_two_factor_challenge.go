@@ -0,0 +1,39 @@
+package auth_repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) GetTwoFactorChallenge(ctx context.Context, token string) (*entity.TwoFactorChallenge, time.Duration, error) {
+	key := twoFactorChallengeKey(token)
+
+	value, err := ar.redisClient.Get(ctx, key)
+	if err != nil {
+		logger.Error(ctx, "Error getting two-factor challenge from redis:", err.Error())
+		return nil, 0, err
+	}
+	if value == "" {
+		return nil, 0, nil
+	}
+
+	ttl, err := ar.redisClient.TTL(ctx, key)
+	if err != nil {
+		logger.Error(ctx, "Error getting ttl of two-factor challenge:", err.Error())
+		return nil, 0, err
+	}
+	if ttl <= 0 {
+		return nil, 0, nil
+	}
+
+	var challenge entity.TwoFactorChallenge
+	if err := json.Unmarshal([]byte(value), &challenge); err != nil {
+		logger.Error(ctx, "Error unmarshalling two-factor challenge", err.Error())
+		return nil, 0, err
+	}
+
+	return &challenge, ttl, nil
+}
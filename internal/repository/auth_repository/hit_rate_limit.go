@@ -0,0 +1,16 @@
+package auth_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) HitRateLimit(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	count, ttl, err := ar.redisClient.Increment(ctx, "rate_limit:"+key, window)
+	if err != nil {
+		logger.Error(ctx, "Error counting rate limit in redis:", err.Error())
+		return 0, 0, err
+	}
+	return count, ttl, nil
+}
@@ -0,0 +1,37 @@
+package auth_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) ReplaceRecoveryCodes(ctx context.Context, userID uint, codeHashes []string) error {
+	db := ar.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).
+		Unscoped().
+		Where("user_id = ?", userID).
+		Delete(&model.UserRecoveryCode{}).Error; err != nil {
+		logger.Error(ctx, "Error deleting recovery codes of user", err.Error())
+		return err
+	}
+
+	codes := make([]model.UserRecoveryCode, 0, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes = append(codes, model.UserRecoveryCode{
+			UserID:   userID,
+			CodeHash: hash,
+		})
+	}
+	if len(codes) == 0 {
+		return nil
+	}
+
+	if err := db.WithContext(ctx).Create(&codes).Error; err != nil {
+		logger.Error(ctx, "Error creating recovery codes of user", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package auth_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) RevokeAPIKey(ctx context.Context, userID uint, keyID string) (bool, error) {
+	db := ar.db.GetTx(ctx)
+
+	result := db.WithContext(ctx).
+		Model(&model.UserAPIKey{}).
+		Where("external_id = ? AND user_id = ? AND revoked_at IS NULL", keyID, userID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		logger.Error(ctx, "Error revoking api key", result.Error.Error())
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,23 @@
+package auth_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) RevokeSession(ctx context.Context, userID uint, sessionID string) (bool, error) {
+	db := ar.db.GetTx(ctx)
+
+	result := db.WithContext(ctx).
+		Model(&model.UserSession{}).
+		Where("external_id = ? AND user_id = ? AND revoked_at IS NULL", sessionID, userID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		logger.Error(ctx, "Error revoking user session", result.Error.Error())
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
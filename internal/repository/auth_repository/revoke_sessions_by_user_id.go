@@ -0,0 +1,27 @@
+package auth_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) RevokeSessionsByUserID(ctx context.Context, userID uint, exceptSessionID string) (int64, error) {
+	db := ar.db.GetTx(ctx)
+
+	query := db.WithContext(ctx).
+		Model(&model.UserSession{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID)
+	if exceptSessionID != "" {
+		query = query.Where("external_id <> ?", exceptSessionID)
+	}
+
+	result := query.Update("revoked_at", time.Now())
+	if result.Error != nil {
+		logger.Error(ctx, "Error revoking user sessions", result.Error.Error())
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,31 @@
+package auth_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+// SaveTwoFactorSecret replaces the authenticator of the user with a pending one, enabled by EnableTwoFactor
+func (ar *AuthRepository) SaveTwoFactorSecret(ctx context.Context, userID uint, secret string) error {
+	db := ar.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).
+		Unscoped().
+		Where("user_id = ?", userID).
+		Delete(&model.UserTwoFactor{}).Error; err != nil {
+		logger.Error(ctx, "Error deleting previous two-factor of user", err.Error())
+		return err
+	}
+
+	twoFactor := model.UserTwoFactor{
+		UserID: userID,
+		Secret: secret,
+	}
+	if err := db.WithContext(ctx).Create(&twoFactor).Error; err != nil {
+		logger.Error(ctx, "Error creating two-factor of user", err.Error())
+		return err
+	}
+
+	return nil
+}
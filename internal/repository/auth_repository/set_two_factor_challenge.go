@@ -0,0 +1,23 @@
+package auth_repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) SetTwoFactorChallenge(ctx context.Context, token string, challenge *entity.TwoFactorChallenge, ttl time.Duration) error {
+	value, err := json.Marshal(challenge)
+	if err != nil {
+		logger.Error(ctx, "Error marshalling two-factor challenge", err.Error())
+		return err
+	}
+
+	if err := ar.redisClient.Set(ctx, twoFactorChallengeKey(token), string(value), ttl); err != nil {
+		logger.Error(ctx, "Error setting two-factor challenge in redis:", err.Error())
+		return err
+	}
+	return nil
+}
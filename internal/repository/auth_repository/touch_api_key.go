@@ -0,0 +1,25 @@
+package auth_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) TouchAPIKey(ctx context.Context, id uint, usedAt time.Time, ipAddress string) error {
+	db := ar.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).
+		Model(&model.UserAPIKey{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"last_used_at": usedAt,
+			"last_used_ip": ipAddress,
+		}).Error; err != nil {
+		logger.Error(ctx, "Error updating last use of api key", err.Error())
+		return err
+	}
+
+	return nil
+}
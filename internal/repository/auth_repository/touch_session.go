@@ -0,0 +1,22 @@
+package auth_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) TouchSession(ctx context.Context, sessionID string, seenAt time.Time) error {
+	db := ar.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).
+		Model(&model.UserSession{}).
+		Where("external_id = ?", sessionID).
+		Update("last_seen_at", seenAt).Error; err != nil {
+		logger.Error(ctx, "Error updating last seen of user session", err.Error())
+		return err
+	}
+
+	return nil
+}
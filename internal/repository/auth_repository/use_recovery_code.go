@@ -0,0 +1,23 @@
+package auth_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) UseRecoveryCode(ctx context.Context, userID uint, codeHash string) (bool, error) {
+	db := ar.db.GetTx(ctx)
+
+	result := db.WithContext(ctx).
+		Model(&model.UserRecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		logger.Error(ctx, "Error using recovery code", result.Error.Error())
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,23 @@
+package auth_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) UseRefreshToken(ctx context.Context, id uint) (bool, error) {
+	db := ar.db.GetTx(ctx)
+
+	result := db.WithContext(ctx).
+		Model(&model.RefreshToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		logger.Error(ctx, "Error using refresh token", result.Error.Error())
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
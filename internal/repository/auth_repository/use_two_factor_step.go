@@ -0,0 +1,23 @@
+package auth_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ar *AuthRepository) UseTwoFactorStep(ctx context.Context, userID uint, step int64) (bool, error) {
+	db := ar.db.GetTx(ctx)
+
+	// Kode yang sama (atau kode periode sebelumnya) tidak bisa dipakai dua kali
+	result := db.WithContext(ctx).
+		Model(&model.UserTwoFactor{}).
+		Where("user_id = ? AND enabled_at IS NOT NULL AND last_used_step < ?", userID, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		logger.Error(ctx, "Error using two-factor code", result.Error.Error())
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
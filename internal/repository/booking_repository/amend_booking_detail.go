@@ -0,0 +1,52 @@
+package booking_repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+
+	"gorm.io/gorm"
+)
+
+func (br *BookingRepository) AmendBookingDetail(ctx context.Context, detail *entity.BookingDetail) error {
+	db := br.db.GetTx(ctx)
+
+	detailRoom, err := json.Marshal(detail.DetailRooms)
+	if err != nil {
+		return fmt.Errorf("failed to marshal room details: %w", err)
+	}
+	detailPromo, err := json.Marshal(detail.DetailPromos)
+	if err != nil {
+		return fmt.Errorf("failed to marshal promo details: %w", err)
+	}
+
+	// Amended stays go back to the hotel for approval
+	if err := db.WithContext(ctx).
+		Model(&model.BookingDetail{}).
+		Where("id = ?", detail.ID).
+		Updates(map[string]interface{}{
+			"room_price_id":        detail.RoomPriceID,
+			"check_in_date":        detail.CheckInDate,
+			"check_out_date":       detail.CheckOutDate,
+			"quantity":             detail.Quantity,
+			"promo_id":             detail.PromoID,
+			"detail_promo":         detailPromo,
+			"detail_room":          detailRoom,
+			"price":                detail.Price,
+			"exchange_rate":        detail.ExchangeRate,
+			"exchange_rate_markup": detail.ExchangeRateMarkup,
+			"status_booking_id":    constant.StatusBookingWaitingApprovalID,
+			"approved_at":          time.Time{},
+			"updated_at":           gorm.Expr("NOW()"),
+		}).Error; err != nil {
+		logger.Error(ctx, "failed to amend booking detail: ", err.Error())
+		return fmt.Errorf("failed to amend booking detail: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package booking_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (br *BookingRepository) ClaimCheckInReminder(ctx context.Context, bookingDetailID uint) (bool, error) {
+	db := br.db.GetTx(ctx)
+
+	result := db.WithContext(ctx).
+		Model(&model.BookingDetail{}).
+		Where("id = ? AND check_in_reminded_at IS NULL", bookingDetailID).
+		Update("check_in_reminded_at", time.Now())
+	if result.Error != nil {
+		logger.Error(ctx, "failed to claim check in reminder", result.Error.Error())
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,27 @@
+package booking_repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+	"wtm-backend/pkg/logger"
+)
+
+func (br *BookingRepository) CountCartHolds(ctx context.Context, roomTypeID uint, checkIn, checkOut time.Time, excludeBookingDetailIDs []uint) (map[string]int, error) {
+	excludeMembers := make([]string, 0, len(excludeBookingDetailIDs))
+	for _, id := range excludeBookingDetailIDs {
+		excludeMembers = append(excludeMembers, strconv.FormatUint(uint64(id), 10))
+	}
+
+	holds := make(map[string]int)
+	for night := checkIn; night.Before(checkOut); night = night.AddDate(0, 0, 1) {
+		count, err := br.redisClient.CountActiveHolds(ctx, cartHoldKey(roomTypeID, night), excludeMembers)
+		if err != nil {
+			logger.Error(ctx, "failed to count cart holds", err.Error())
+			return nil, err
+		}
+		holds[night.Format(time.DateOnly)] = count
+	}
+
+	return holds, nil
+}
@@ -0,0 +1,58 @@
+package booking_repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (br *BookingRepository) CreateBookingDetailRevision(ctx context.Context, revision *entity.BookingDetailRevision) error {
+	db := br.db.GetTx(ctx)
+
+	detailRoom, err := json.Marshal(revision.DetailRooms)
+	if err != nil {
+		return fmt.Errorf("failed to marshal room details: %w", err)
+	}
+	detailPromo, err := json.Marshal(revision.DetailPromos)
+	if err != nil {
+		return fmt.Errorf("failed to marshal promo details: %w", err)
+	}
+
+	var lastRevision int
+	if err := db.WithContext(ctx).
+		Model(&model.BookingDetailRevision{}).
+		Select("COALESCE(MAX(revision), 0)").
+		Where("booking_detail_id = ?", revision.BookingDetailID).
+		Scan(&lastRevision).Error; err != nil {
+		logger.Error(ctx, "failed to get last booking detail revision", err.Error())
+		return err
+	}
+
+	revisionModel := model.BookingDetailRevision{
+		BookingDetailID: revision.BookingDetailID,
+		Revision:        lastRevision + 1,
+		RoomPriceID:     revision.RoomPriceID,
+		CheckInDate:     revision.CheckInDate,
+		CheckOutDate:    revision.CheckOutDate,
+		Quantity:        revision.Quantity,
+		PromoID:         revision.PromoID,
+		DetailPromo:     detailPromo,
+		DetailRoom:      detailRoom,
+		Price:           revision.Price,
+		Currency:        revision.Currency,
+		StatusBookingID: revision.StatusBookingID,
+		Reason:          revision.Reason,
+		AmendedBy:       revision.AmendedBy,
+	}
+	if err := db.WithContext(ctx).Create(&revisionModel).Error; err != nil {
+		logger.Error(ctx, "failed to create booking detail revision", err.Error())
+		return fmt.Errorf("failed to create booking detail revision: %w", err)
+	}
+
+	revision.ID = revisionModel.ID
+	revision.Revision = revisionModel.Revision
+	return nil
+}
@@ -0,0 +1,45 @@
+package booking_repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+	"wtm-backend/pkg/logger"
+)
+
+func (br *BookingRepository) CreateCartHold(ctx context.Context, roomTypeID uint, checkIn, checkOut time.Time, bookingDetailIDs []uint, available map[string]int, ttl time.Duration) (time.Time, error) {
+	expiresAt := time.Now().Add(ttl)
+
+	var keys []string
+	var limits []int
+	var nights []string
+	for night := checkIn; night.Before(checkOut); night = night.AddDate(0, 0, 1) {
+		date := night.Format(time.DateOnly)
+		keys = append(keys, cartHoldKey(roomTypeID, night))
+		limits = append(limits, available[date])
+		nights = append(nights, date)
+	}
+	members := make([]string, 0, len(bookingDetailIDs))
+	for _, id := range bookingDetailIDs {
+		members = append(members, strconv.FormatUint(uint64(id), 10))
+	}
+
+	full, left, err := br.redisClient.AddHoldsWithinLimit(ctx, keys, limits, members, expiresAt)
+	if err != nil {
+		logger.Error(ctx, "failed to create cart hold", err.Error())
+		return time.Time{}, err
+	}
+	if full >= 0 {
+		logger.Warn(ctx, "Room held out", "roomTypeID", roomTypeID, "date", nights[full], "remaining", left)
+		return time.Time{}, fmt.Errorf("not enough rooms available on %s: %d requested, %d left", nights[full], len(members), left)
+	}
+
+	return expiresAt, nil
+}
+
+// cartHoldKey is the Redis sorted set holding cart booking detail IDs for one room type night.
+// Every cart booking detail has quantity 1, so one member is one held unit.
+func cartHoldKey(roomTypeID uint, night time.Time) string {
+	return fmt.Sprintf("cart_holds:%d:%s", roomTypeID, night.Format(time.DateOnly))
+}
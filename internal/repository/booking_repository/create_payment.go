@@ -0,0 +1,34 @@
+package booking_repository
+
+import (
+	"context"
+	"fmt"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (br *BookingRepository) CreatePayment(ctx context.Context, payment *entity.Payment) error {
+	db := br.db.GetTx(ctx)
+
+	paymentModel := model.Payment{
+		BookingID:       payment.BookingID,
+		BookingDetailID: payment.BookingDetailID,
+		Amount:          payment.Amount,
+		Currency:        payment.Currency,
+		Method:          payment.Method,
+		Reference:       payment.Reference,
+		ReceiptUrl:      payment.ReceiptUrl,
+		Notes:           payment.Notes,
+		PaidAt:          payment.PaidAt,
+		RecordedBy:      payment.RecordedBy,
+	}
+	if err := db.WithContext(ctx).Create(&paymentModel).Error; err != nil {
+		logger.Error(ctx, "failed to create payment", err.Error())
+		return fmt.Errorf("failed to create payment: %w", err)
+	}
+
+	payment.ID = paymentModel.ID
+	payment.CreatedAt = paymentModel.CreatedAt
+	return nil
+}
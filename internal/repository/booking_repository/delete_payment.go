@@ -0,0 +1,18 @@
+package booking_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (br *BookingRepository) DeletePayment(ctx context.Context, id uint) error {
+	db := br.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).Delete(&model.Payment{}, id).Error; err != nil {
+		logger.Error(ctx, "failed to delete payment", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package booking_repository
+
+import (
+	"context"
+	"encoding/json"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+func (br *BookingRepository) GetBookingDetailRevisions(ctx context.Context, bookingDetailID uint) ([]entity.BookingDetailRevision, error) {
+	db := br.db.GetTx(ctx)
+
+	var revisions []model.BookingDetailRevision
+	if err := db.WithContext(ctx).
+		Preload("AmendedByUser").
+		Where("booking_detail_id = ?", bookingDetailID).
+		Order("revision DESC").
+		Find(&revisions).Error; err != nil {
+		logger.Error(ctx, "failed to get booking detail revisions", err.Error())
+		return nil, err
+	}
+
+	var result []entity.BookingDetailRevision
+	if err := utils.CopyStrict(&result, &revisions); err != nil {
+		logger.Error(ctx, "failed to copy booking detail revisions model to entity", err.Error())
+		return nil, err
+	}
+
+	for i, revision := range revisions {
+		result[i].AmendedByName = revision.AmendedByUser.FullName
+		if len(revision.DetailRoom) > 0 {
+			if err := json.Unmarshal(revision.DetailRoom, &result[i].DetailRooms); err != nil {
+				logger.Error(ctx, "failed to unmarshal room", err.Error())
+			}
+		}
+		if len(revision.DetailPromo) > 0 {
+			if err := json.Unmarshal(revision.DetailPromo, &result[i].DetailPromos); err != nil {
+				logger.Error(ctx, "failed to unmarshal promo", err.Error())
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,47 @@
+package booking_repository
+
+import (
+	"context"
+	"encoding/json"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+func (br *BookingRepository) GetBookingDetailsByIDs(ctx context.Context, ids []uint) ([]entity.BookingDetail, error) {
+	db := br.db.GetTx(ctx)
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var bookingDetails []model.BookingDetail
+	if err := db.WithContext(ctx).
+		Preload("Booking").
+		Preload("RoomPrice").
+		Preload("RoomPrice.RoomType.Hotel").
+		Preload("BookingDetailsAdditional").
+		Where("id IN ?", ids).
+		Find(&bookingDetails).Error; err != nil {
+		logger.Error(ctx, "failed to get booking details by ids", err.Error())
+		return nil, err
+	}
+
+	var result []entity.BookingDetail
+	if err := utils.CopyStrict(&result, &bookingDetails); err != nil {
+		logger.Error(ctx, "failed to copy booking details model to entity", err.Error())
+		return nil, err
+	}
+
+	for i, detail := range bookingDetails {
+		if len(detail.DetailRoom) == 0 {
+			continue
+		}
+		if err := json.Unmarshal(detail.DetailRoom, &result[i].DetailRooms); err != nil {
+			logger.Error(ctx, "failed to unmarshal room", err.Error())
+		}
+	}
+
+	return result, nil
+}
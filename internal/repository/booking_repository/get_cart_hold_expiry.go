@@ -0,0 +1,19 @@
+package booking_repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+	"wtm-backend/pkg/logger"
+)
+
+func (br *BookingRepository) GetCartHoldExpiry(ctx context.Context, roomTypeID uint, checkIn time.Time, bookingDetailID uint) (time.Time, error) {
+	// All nights of a detail are held with the same expiry, the first night is enough
+	expiresAt, err := br.redisClient.GetHoldExpiry(ctx, cartHoldKey(roomTypeID, checkIn), strconv.FormatUint(uint64(bookingDetailID), 10))
+	if err != nil {
+		logger.Error(ctx, "failed to get cart hold expiry", err.Error())
+		return time.Time{}, err
+	}
+
+	return expiresAt, nil
+}
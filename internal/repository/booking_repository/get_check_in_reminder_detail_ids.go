@@ -0,0 +1,26 @@
+package booking_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+func (br *BookingRepository) GetCheckInReminderDetailIDs(ctx context.Context, checkInDate string) ([]uint, error) {
+	db := br.db.GetTx(ctx)
+
+	var ids []uint
+	if err := db.WithContext(ctx).
+		Model(&model.BookingDetail{}).
+		Where("status_booking_id = ?", constant.StatusBookingConfirmedID).
+		Where("check_in_date::date = ?", checkInDate).
+		Where("check_in_reminded_at IS NULL").
+		Order("id ASC").
+		Pluck("id", &ids).Error; err != nil {
+		logger.Error(ctx, "failed to get booking details to remind of check in", err.Error())
+		return nil, err
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,37 @@
+package booking_repository
+
+import (
+	"context"
+	"encoding/json"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (br *BookingRepository) GetInvoiceByBookingDetailID(ctx context.Context, bookingDetailID uint) (*entity.Invoice, error) {
+	db := br.db.GetTx(ctx)
+
+	var invoice model.Invoice
+	if err := db.WithContext(ctx).
+		Where("booking_detail_id = ?", bookingDetailID).
+		First(&invoice).Error; err != nil {
+		if br.db.ErrRecordNotFound(ctx, err) {
+			logger.Warn(ctx, "Invoice not found with booking detail id", bookingDetailID)
+			return nil, nil
+		}
+		logger.Error(ctx, "failed to get invoice by booking detail id", err.Error())
+		return nil, err
+	}
+
+	result := entity.Invoice{
+		BookingDetailID: invoice.BookingDetailID,
+		InvoiceCode:     invoice.InvoiceCode,
+		CreatedAt:       invoice.CreatedAt,
+	}
+	if err := json.Unmarshal(invoice.Detail, &result.DetailInvoice); err != nil {
+		logger.Error(ctx, "failed to unmarshal invoice detail", err.Error())
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,25 @@
+package booking_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (br *BookingRepository) GetPaymentByID(ctx context.Context, id uint) (*entity.Payment, error) {
+	db := br.db.GetTx(ctx)
+
+	var payment model.Payment
+	if err := db.WithContext(ctx).
+		Preload("BookingDetail").
+		Preload("RecordedByUser").
+		Where("id = ?", id).
+		First(&payment).Error; err != nil {
+		logger.Error(ctx, "failed to get payment by id", err.Error())
+		return nil, err
+	}
+
+	result := toPaymentEntity(payment)
+	return &result, nil
+}
@@ -0,0 +1,52 @@
+package booking_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (br *BookingRepository) GetPaymentsByBookingID(ctx context.Context, bookingID uint) ([]entity.Payment, error) {
+	db := br.db.GetTx(ctx)
+
+	var payments []model.Payment
+	if err := db.WithContext(ctx).
+		Preload("BookingDetail").
+		Preload("RecordedByUser").
+		Where("booking_id = ?", bookingID).
+		Order("paid_at ASC, id ASC").
+		Find(&payments).Error; err != nil {
+		logger.Error(ctx, "failed to get payments by booking id", err.Error())
+		return nil, err
+	}
+
+	result := make([]entity.Payment, 0, len(payments))
+	for _, payment := range payments {
+		result = append(result, toPaymentEntity(payment))
+	}
+
+	return result, nil
+}
+
+func toPaymentEntity(payment model.Payment) entity.Payment {
+	result := entity.Payment{
+		ID:              payment.ID,
+		BookingID:       payment.BookingID,
+		BookingDetailID: payment.BookingDetailID,
+		Amount:          payment.Amount,
+		Currency:        payment.Currency,
+		Method:          payment.Method,
+		Reference:       payment.Reference,
+		ReceiptUrl:      payment.ReceiptUrl,
+		Notes:           payment.Notes,
+		PaidAt:          payment.PaidAt,
+		RecordedBy:      payment.RecordedBy,
+		RecordedByName:  payment.RecordedByUser.FullName,
+		CreatedAt:       payment.CreatedAt,
+	}
+	if payment.BookingDetail != nil {
+		result.SubBookingID = payment.BookingDetail.SubBookingID
+	}
+	return result
+}
@@ -0,0 +1,22 @@
+package booking_repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+	"wtm-backend/pkg/logger"
+)
+
+func (br *BookingRepository) ReleaseCartHold(ctx context.Context, roomTypeID uint, checkIn, checkOut time.Time, bookingDetailID uint) error {
+	member := strconv.FormatUint(uint64(bookingDetailID), 10)
+
+	for night := checkIn; night.Before(checkOut); night = night.AddDate(0, 0, 1) {
+		key := cartHoldKey(roomTypeID, night)
+		if err := br.redisClient.RemoveHold(ctx, key, member); err != nil {
+			logger.Error(ctx, "failed to release cart hold", "key", key, err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
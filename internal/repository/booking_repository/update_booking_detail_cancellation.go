@@ -0,0 +1,30 @@
+package booking_repository
+
+import (
+	"context"
+	"fmt"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/currency"
+	"wtm-backend/pkg/logger"
+
+	"gorm.io/gorm"
+)
+
+func (br *BookingRepository) UpdateBookingDetailCancellation(ctx context.Context, bookingDetailID uint, penalty currency.Money, penaltyPercent float64, policyName string) error {
+	db := br.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).
+		Model(&model.BookingDetail{}).
+		Where("id = ?", bookingDetailID).
+		Updates(map[string]interface{}{
+			"cancellation_penalty":         penalty,
+			"cancellation_penalty_percent": penaltyPercent,
+			"cancellation_policy_name":     policyName,
+			"updated_at":                   gorm.Expr("NOW()"),
+		}).Error; err != nil {
+		logger.Error(ctx, "failed to update booking detail cancellation penalty: ", err.Error())
+		return fmt.Errorf("failed to update booking detail cancellation penalty: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package booking_repository
+
+import (
+	"context"
+	"fmt"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+
+	"gorm.io/gorm"
+)
+
+func (br *BookingRepository) UpdateBookingDetailExchangeRate(ctx context.Context, bookingDetailID uint, exchangeRate, markupPercent float64) error {
+	db := br.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).
+		Model(&model.BookingDetail{}).
+		Where("id = ?", bookingDetailID).
+		Updates(map[string]interface{}{
+			"exchange_rate":        exchangeRate,
+			"exchange_rate_markup": markupPercent,
+			"updated_at":           gorm.Expr("NOW()"),
+		}).Error; err != nil {
+		logger.Error(ctx, "failed to update booking detail exchange rate: ", err.Error())
+		return fmt.Errorf("failed to update booking detail exchange rate: %w", err)
+	}
+
+	return nil
+}
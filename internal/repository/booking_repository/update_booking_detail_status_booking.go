@@ -150,6 +150,7 @@ func (br *BookingRepository) UpdateBookingDetailStatusBooking(ctx context.Contex
 				if detail.Booking.ID != 0 {
 					dataResult.Booking.AgentName = detail.Booking.Agent.FullName
 					dataResult.Booking.AgentEmail = detail.Booking.Agent.Email
+					dataResult.Booking.AgentLanguage = detail.Booking.Agent.Language
 				}
 				result = append(result, dataResult)
 				break
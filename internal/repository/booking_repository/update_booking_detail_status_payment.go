@@ -8,14 +8,15 @@ import (
 	"wtm-backend/pkg/logger"
 )
 
-func (br *BookingRepository) UpdateBookingDetailStatusPayment(ctx context.Context, bookingDetailIDs []uint, statusID uint) error {
+func (br *BookingRepository) UpdateBookingDetailStatusPayment(ctx context.Context, bookingDetailIDs []uint, statusID uint, manual bool) error {
 	db := br.db.GetTx(ctx)
 	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// Step 1: Update BookingDetail yang status payment-nya berubah
 		if err := tx.Model(&model.BookingDetail{}).
 			Where("id IN ?", bookingDetailIDs).
 			Updates(map[string]interface{}{
-				"status_payment_id": statusID,
+				"status_payment_id":     statusID,
+				"status_payment_manual": manual,
 			}).Error; err != nil {
 			return err
 		}
@@ -29,21 +30,17 @@ func (br *BookingRepository) UpdateBookingDetailStatusPayment(ctx context.Contex
 			return err
 		}
 
-		// Step 3: Cek apakah masih ada detail unpaid
-		var unpaidCount int64
+		// Step 3: Ambil status payment semua detail, yang ditolak/dibatalkan tidak ikut selama masih ada detail lain
+		var details []model.BookingDetail
 		if err := tx.Model(&model.BookingDetail{}).
-			Where("booking_id = ? AND status_payment_id = ?", bookingID, constant.StatusPaymentUnpaidID).
-			Count(&unpaidCount).Error; err != nil {
+			Select("status_booking_id", "status_payment_id").
+			Where("booking_id = ?", bookingID).
+			Find(&details).Error; err != nil {
 			return err
 		}
 
 		// Step 4: Tentukan status parent
-		var parentStatus uint
-		if unpaidCount > 0 {
-			parentStatus = constant.StatusPaymentUnpaidID
-		} else {
-			parentStatus = statusID
-		}
+		parentStatus := rollUpStatusPayment(details)
 
 		// Step 5: Update Booking parent
 		if err := tx.Model(&model.Booking{}).
@@ -64,3 +61,35 @@ func (br *BookingRepository) UpdateBookingDetailStatusPayment(ctx context.Contex
 
 	return nil
 }
+
+// rollUpStatusPayment returns Paid when every sub-booking is paid, Unpaid when none has a payment
+// and Partially Paid otherwise. Rejected and cancelled sub-bookings only count when nothing else is left.
+func rollUpStatusPayment(details []model.BookingDetail) uint {
+	var active []model.BookingDetail
+	for _, detail := range details {
+		if detail.StatusBookingID != constant.StatusBookingRejectedID && detail.StatusBookingID != constant.StatusBookingCancelledID {
+			active = append(active, detail)
+		}
+	}
+	if len(active) == 0 {
+		active = details
+	}
+
+	var paid, unpaid int
+	for _, detail := range active {
+		switch detail.StatusPaymentID {
+		case constant.StatusPaymentPaidID:
+			paid++
+		case constant.StatusPaymentUnpaidID:
+			unpaid++
+		}
+	}
+	switch {
+	case paid == len(active):
+		return constant.StatusPaymentPaidID
+	case unpaid == len(active):
+		return constant.StatusPaymentUnpaidID
+	default:
+		return constant.StatusPaymentPartiallyPaidID
+	}
+}
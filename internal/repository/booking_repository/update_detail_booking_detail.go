@@ -6,17 +6,18 @@ import (
 	"fmt"
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/currency"
 	"wtm-backend/pkg/logger"
 
 	"gorm.io/gorm"
 )
 
-func (br *BookingRepository) UpdateDetailBookingDetail(ctx context.Context, bookingDetailID uint, room *entity.DetailRoom, promo *entity.DetailPromo, price float64, additionals []entity.BookingDetailAdditional) error {
+func (br *BookingRepository) UpdateDetailBookingDetail(ctx context.Context, bookingDetailID uint, room *entity.DetailRoom, promo *entity.DetailPromo, price currency.Money, additionals []entity.BookingDetailAdditional) error {
 	db := br.db.GetTx(ctx)
 
 	updates := make(map[string]interface{})
 
-	if price != 0 {
+	if !price.IsZero() {
 		updates["price"] = price
 	}
 
@@ -0,0 +1,25 @@
+package booking_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (br *BookingRepository) UpdateHotelConfirmationNumber(ctx context.Context, bookingDetailIDs []uint, confirmationNumber string) error {
+	if len(bookingDetailIDs) == 0 {
+		return nil
+	}
+
+	db := br.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).
+		Model(&model.BookingDetail{}).
+		Where("id IN ?", bookingDetailIDs).
+		Update("hotel_confirmation_number", confirmationNumber).Error; err != nil {
+		logger.Error(ctx, "failed to update hotel confirmation number", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package booking_repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+
+	"gorm.io/gorm"
+)
+
+func (br *BookingRepository) UpdateInvoiceDetail(ctx context.Context, bookingDetailID uint, detail entity.DetailInvoice) error {
+	db := br.db.GetTx(ctx)
+
+	detailJSON, err := json.Marshal(detail)
+	if err != nil {
+		return fmt.Errorf("failed to marshal detail invoice: %w", err)
+	}
+
+	if err := db.WithContext(ctx).
+		Model(&model.Invoice{}).
+		Where("booking_detail_id = ?", bookingDetailID).
+		Updates(map[string]interface{}{
+			"detail":     detailJSON,
+			"updated_at": gorm.Expr("NOW()"),
+		}).Error; err != nil {
+		logger.Error(ctx, "failed to update invoice detail: ", err.Error())
+		return fmt.Errorf("failed to update invoice detail: %w", err)
+	}
+
+	return nil
+}
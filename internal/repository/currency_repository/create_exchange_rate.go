@@ -0,0 +1,30 @@
+package currency_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (cr *CurrencyRepository) CreateExchangeRate(ctx context.Context, exchangeRate *entity.ExchangeRate) (*entity.ExchangeRate, error) {
+	db := cr.db.GetTx(ctx)
+
+	exchangeRateModel := model.ExchangeRate{
+		CurrencyCode:  exchangeRate.CurrencyCode,
+		Rate:          exchangeRate.Rate,
+		MarkupPercent: exchangeRate.MarkupPercent,
+		EffectiveDate: exchangeRate.EffectiveDate,
+	}
+
+	if err := db.WithContext(ctx).Create(&exchangeRateModel).Error; err != nil {
+		if cr.db.ErrDuplicateKey(ctx, err) {
+			logger.Warn(ctx, "Exchange rate already exists for currency and date", exchangeRate.CurrencyCode)
+			return nil, err
+		}
+		logger.Error(ctx, "Error creating exchange rate", err.Error())
+		return nil, err
+	}
+
+	return toExchangeRateEntity(ctx, exchangeRateModel)
+}
@@ -0,0 +1,18 @@
+package currency_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (cr *CurrencyRepository) DeleteExchangeRate(ctx context.Context, id uint) error {
+	db := cr.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).Where("id = ?", id).Unscoped().Delete(&model.ExchangeRate{}).Error; err != nil {
+		logger.Error(ctx, "Error deleting exchange rate", err.Error())
+		return err
+	}
+
+	return nil
+}
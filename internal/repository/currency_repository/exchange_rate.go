@@ -0,0 +1,20 @@
+package currency_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+func toExchangeRateEntity(ctx context.Context, exchangeRate model.ExchangeRate) (*entity.ExchangeRate, error) {
+	var entityExchangeRate entity.ExchangeRate
+	if err := utils.CopyStrict(&entityExchangeRate, exchangeRate); err != nil {
+		logger.Error(ctx, "Error copying exchange rate model to entity", err.Error())
+		return nil, err
+	}
+	entityExchangeRate.ExternalID = exchangeRate.ExternalID.ExternalID
+
+	return &entityExchangeRate, nil
+}
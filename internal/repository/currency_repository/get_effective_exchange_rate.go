@@ -0,0 +1,29 @@
+package currency_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (cr *CurrencyRepository) GetEffectiveExchangeRate(ctx context.Context, currencyCode string, date time.Time) (*entity.ExchangeRate, error) {
+	db := cr.db.GetTx(ctx)
+
+	var exchangeRate model.ExchangeRate
+	if err := db.WithContext(ctx).
+		Where("currency_code = ?", currencyCode).
+		Where("effective_date <= ?", date.Format(time.DateOnly)).
+		Order("effective_date DESC").
+		First(&exchangeRate).Error; err != nil {
+		if cr.db.ErrRecordNotFound(ctx, err) {
+			logger.Warn(ctx, "No exchange rate in effect for currency", currencyCode)
+			return nil, nil
+		}
+		logger.Error(ctx, "Error finding effective exchange rate", err.Error())
+		return nil, err
+	}
+
+	return toExchangeRateEntity(ctx, exchangeRate)
+}
@@ -0,0 +1,24 @@
+package currency_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (cr *CurrencyRepository) GetExchangeRateByID(ctx context.Context, id uint) (*entity.ExchangeRate, error) {
+	db := cr.db.GetTx(ctx)
+
+	var exchangeRate model.ExchangeRate
+	if err := db.WithContext(ctx).Where("id = ?", id).First(&exchangeRate).Error; err != nil {
+		if cr.db.ErrRecordNotFound(ctx, err) {
+			logger.Warn(ctx, "Exchange rate not found with id", id)
+			return nil, nil
+		}
+		logger.Error(ctx, "Error finding exchange rate by id", err.Error())
+		return nil, err
+	}
+
+	return toExchangeRateEntity(ctx, exchangeRate)
+}
@@ -0,0 +1,34 @@
+package currency_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (cr *CurrencyRepository) GetExchangeRates(ctx context.Context, currencyCode string) ([]entity.ExchangeRate, error) {
+	db := cr.db.GetTx(ctx)
+
+	query := db.WithContext(ctx).Model(&model.ExchangeRate{})
+	if currencyCode != "" {
+		query = query.Where("currency_code = ?", currencyCode)
+	}
+
+	var exchangeRates []model.ExchangeRate
+	if err := query.Order("currency_code ASC, effective_date DESC").Find(&exchangeRates).Error; err != nil {
+		logger.Error(ctx, "Error fetching exchange rates", err.Error())
+		return nil, err
+	}
+
+	entityExchangeRates := make([]entity.ExchangeRate, 0, len(exchangeRates))
+	for _, exchangeRate := range exchangeRates {
+		entityExchangeRate, err := toExchangeRateEntity(ctx, exchangeRate)
+		if err != nil {
+			return nil, err
+		}
+		entityExchangeRates = append(entityExchangeRates, *entityExchangeRate)
+	}
+
+	return entityExchangeRates, nil
+}
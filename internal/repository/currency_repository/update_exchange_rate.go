@@ -0,0 +1,29 @@
+package currency_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (cr *CurrencyRepository) UpdateExchangeRate(ctx context.Context, exchangeRate *entity.ExchangeRate) (*entity.ExchangeRate, error) {
+	db := cr.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).Model(&model.ExchangeRate{}).
+		Where("id = ?", exchangeRate.ID).
+		Updates(map[string]interface{}{
+			"rate":           exchangeRate.Rate,
+			"markup_percent": exchangeRate.MarkupPercent,
+			"effective_date": exchangeRate.EffectiveDate,
+		}).Error; err != nil {
+		if cr.db.ErrDuplicateKey(ctx, err) {
+			logger.Warn(ctx, "Exchange rate already exists for currency and date", exchangeRate.CurrencyCode)
+			return nil, err
+		}
+		logger.Error(ctx, "Error updating exchange rate", err.Error())
+		return nil, err
+	}
+
+	return cr.GetExchangeRateByID(ctx, exchangeRate.ID)
+}
@@ -14,6 +14,7 @@ type DBPostgre interface {
 	RollbackTrx(ctx context.Context, tx *gorm.DB) error
 	GetTx(ctx context.Context) *gorm.DB
 	WithTransaction(ctx context.Context, fn func(txCtx context.Context) error) error
+	AfterCommit(ctx context.Context, fn func(ctx context.Context))
 
 	// Error Handlers
 	ErrRecordNotFound(ctx context.Context, err error) bool
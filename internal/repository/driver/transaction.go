@@ -46,3 +46,7 @@ func (dr *DatabaseTransaction) WithTransaction(ctx context.Context, fn func(ctx
 
 	return nil
 }
+
+func (dr *DatabaseTransaction) AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	dr.db.AfterCommit(ctx, fn)
+}
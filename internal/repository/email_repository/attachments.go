@@ -0,0 +1,27 @@
+package email_repository
+
+import (
+	"encoding/json"
+	"wtm-backend/internal/domain/entity"
+
+	"gorm.io/datatypes"
+)
+
+// marshalAttachments stores the attachment metadata as jsonb, without the content
+func marshalAttachments(attachments []entity.EmailAttachment) (datatypes.JSON, error) {
+	if len(attachments) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(attachments)
+}
+
+func unmarshalAttachments(data datatypes.JSON) ([]entity.EmailAttachment, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var attachments []entity.EmailAttachment
+	if err := json.Unmarshal(data, &attachments); err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
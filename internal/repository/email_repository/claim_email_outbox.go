@@ -0,0 +1,68 @@
+package email_repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+func (er *EmailRepository) ClaimEmailOutbox(ctx context.Context, limit int, lease time.Duration) ([]entity.EmailOutbox, error) {
+	db := er.db.GetTx(ctx)
+
+	// Due emails and emails whose worker died before releasing them; SKIP LOCKED lets several instances claim side by side.
+	// Every claim counts as an attempt, an email that keeps crashing its worker still runs out of attempts.
+	lockedAt := time.Now()
+	var claimed []model.EmailOutbox
+	if err := db.WithContext(ctx).Raw(`
+		UPDATE email_outboxes SET status = ?, locked_at = ?, locked_until = ?, attempts = attempts + 1, updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM email_outboxes
+			WHERE deleted_at IS NULL
+			  AND ((status = ? AND next_attempt_at <= NOW()) OR (status = ? AND locked_until < NOW()))
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		constant.EmailOutboxProcessing, lockedAt, lockedAt.Add(lease),
+		constant.EmailOutboxPending, constant.EmailOutboxProcessing,
+		limit,
+	).Scan(&claimed).Error; err != nil {
+		logger.Error(ctx, "failed to claim email outbox", err.Error())
+		return nil, err
+	}
+
+	result := make([]entity.EmailOutbox, 0, len(claimed))
+	for _, outbox := range claimed {
+		attachments, err := unmarshalAttachments(outbox.Attachments)
+		if err != nil {
+			logger.Error(ctx, fmt.Sprintf("failed to unmarshal attachments of outbox %d", outbox.ID), err.Error())
+		}
+		// The stored lock time is the fencing token, it is rounded to the precision of the column
+		claimedAt := lockedAt
+		if outbox.LockedAt != nil {
+			claimedAt = *outbox.LockedAt
+		}
+		result = append(result, entity.EmailOutbox{
+			ID:            outbox.ID,
+			EmailLogID:    outbox.EmailLogID,
+			Scope:         outbox.Scope,
+			To:            outbox.To,
+			Subject:       outbox.Subject,
+			BodyHTML:      outbox.BodyHTML,
+			BodyText:      outbox.BodyText,
+			Status:        outbox.Status,
+			Attempts:      outbox.Attempts,
+			NextAttemptAt: outbox.NextAttemptAt,
+			LockedAt:      claimedAt,
+			LastError:     outbox.LastError,
+			Attachments:   attachments,
+		})
+	}
+
+	return result, nil
+}
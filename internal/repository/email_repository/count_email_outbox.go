@@ -0,0 +1,33 @@
+package email_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+func (er *EmailRepository) CountEmailOutbox(ctx context.Context) (map[string]int64, error) {
+	db := er.db.GetTx(ctx)
+
+	var rows []struct {
+		Status string
+		Total  int64
+	}
+	if err := db.WithContext(ctx).
+		Model(&model.EmailOutbox{}).
+		Select("status, COUNT(*) AS total").
+		Where("status <> ?", constant.EmailOutboxSent).
+		Group("status").
+		Scan(&rows).Error; err != nil {
+		logger.Error(ctx, "failed to count email outbox", err.Error())
+		return nil, err
+	}
+
+	result := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		result[row.Status] = row.Total
+	}
+
+	return result, nil
+}
@@ -28,6 +28,13 @@ func (er *EmailRepository) CreateEmailLog(ctx context.Context, log *entity.Email
 		modelEmailLog.Meta = metaJSON
 	}
 
+	attachments, err := marshalAttachments(log.Attachments)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal email attachments", err.Error())
+		return err
+	}
+	modelEmailLog.Attachments = attachments
+
 	modelEmailLog.StatusID = constant.StatusEmailPendingID
 
 	if err := db.WithContext(ctx).Create(&modelEmailLog).Error; err != nil {
@@ -0,0 +1,51 @@
+package email_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+func (er *EmailRepository) CreateEmailTemplateVersion(ctx context.Context, version *entity.EmailTemplateVersion) error {
+	db := er.db.GetTx(ctx)
+
+	// Lock the template row so concurrent edits get consecutive version numbers
+	var templateID uint
+	if err := db.WithContext(ctx).
+		Raw("SELECT id FROM email_templates WHERE id = ? FOR UPDATE", version.EmailTemplateID).
+		Scan(&templateID).Error; err != nil {
+		logger.Error(ctx, "failed to lock email template", err.Error())
+		return err
+	}
+
+	var latest int
+	if err := db.WithContext(ctx).
+		Model(&model.EmailTemplateVersion{}).
+		Where("email_template_id = ?", version.EmailTemplateID).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&latest).Error; err != nil {
+		logger.Error(ctx, "failed to get latest email template version", err.Error())
+		return err
+	}
+
+	var versionModel model.EmailTemplateVersion
+	if err := utils.CopyStrict(&versionModel, version); err != nil {
+		logger.Error(ctx, "failed to copy email template version entity to model", err.Error())
+		return err
+	}
+	versionModel.Version = latest + 1
+
+	if err := db.WithContext(ctx).Create(&versionModel).Error; err != nil {
+		logger.Error(ctx, "failed to create email template version", err.Error())
+		return err
+	}
+
+	version.ID = versionModel.ID
+	version.Version = versionModel.Version
+	version.CreatedAt = versionModel.CreatedAt
+	version.ExternalID = versionModel.ExternalID.ExternalID
+
+	return nil
+}
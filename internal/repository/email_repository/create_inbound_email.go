@@ -0,0 +1,37 @@
+package email_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (er *EmailRepository) CreateInboundEmail(ctx context.Context, inbound *entity.InboundEmail) error {
+	db := er.db.GetTx(ctx)
+
+	modelInbound := model.InboundEmail{
+		MessageID:          inbound.MessageID,
+		From:               inbound.From,
+		To:                 inbound.To,
+		Subject:            inbound.Subject,
+		Body:               inbound.Body,
+		BookingID:          inbound.BookingID,
+		SubBookingIDs:      inbound.SubBookingIDs,
+		Action:             inbound.Action,
+		ConfirmationNumber: inbound.ConfirmationNumber,
+		Status:             inbound.Status,
+		Note:               inbound.Note,
+	}
+
+	if err := db.WithContext(ctx).Create(&modelInbound).Error; err != nil {
+		logger.Error(ctx, "failed to create inbound email", err.Error())
+		return err
+	}
+
+	inbound.ID = modelInbound.ID
+	inbound.ExternalID = modelInbound.ExternalID.ExternalID
+	inbound.CreatedAt = modelInbound.CreatedAt
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package email_repository_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/internal/repository/email_repository"
+	"wtm-backend/pkg/constant"
+)
+
+// testDB connects to the migrated database of TEST_POSTGRES_DSN, every change of a test is rolled back at its end
+func testDB(t *testing.T) (*database.DBPostgre, context.Context) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN is not set")
+	}
+
+	gormDB, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	db := &database.DBPostgre{DB: gormDB}
+
+	tx, txCtx, err := db.BeginTrx(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.RollbackTrx(txCtx, tx)
+	})
+
+	return db, txCtx
+}
+
+func createOutbox(t *testing.T, db *database.DBPostgre, ctx context.Context, status string, attempts int, lockedUntil *time.Time) uint {
+	outbox := model.EmailOutbox{
+		Scope:         "hotel",
+		To:            "hotel@example.com",
+		Subject:       "New Booking Request",
+		Status:        status,
+		Attempts:      attempts,
+		NextAttemptAt: time.Now().Add(-time.Hour),
+		LockedUntil:   lockedUntil,
+	}
+	require.NoError(t, db.GetTx(ctx).Create(&outbox).Error)
+	return outbox.ID
+}
+
+func findClaimed(claimed []entity.EmailOutbox, id uint) *entity.EmailOutbox {
+	for i := range claimed {
+		if claimed[i].ID == id {
+			return &claimed[i]
+		}
+	}
+	return nil
+}
+
+func TestClaimEmailOutboxReclaimsExpiredLease(t *testing.T) {
+	db, ctx := testDB(t)
+	repo := email_repository.NewEmailRepository(db)
+
+	expiredAt := time.Now().Add(-time.Minute)
+	heldUntil := time.Now().Add(time.Hour)
+	expired := createOutbox(t, db, ctx, constant.EmailOutboxProcessing, 2, &expiredAt)
+	held := createOutbox(t, db, ctx, constant.EmailOutboxProcessing, 2, &heldUntil)
+
+	claimed, err := repo.ClaimEmailOutbox(ctx, 1000, time.Minute)
+	assert.NoError(t, err)
+
+	reclaimed := findClaimed(claimed, expired)
+	if assert.NotNil(t, reclaimed, "an email whose lease expired is claimed again") {
+		assert.Equal(t, 3, reclaimed.Attempts, "the reclaim counts as an attempt")
+		assert.Equal(t, constant.EmailOutboxProcessing, reclaimed.Status)
+	}
+	assert.Nil(t, findClaimed(claimed, held), "an email still leased by a worker is not claimed")
+
+	var stored model.EmailOutbox
+	assert.NoError(t, db.GetTx(ctx).First(&stored, expired).Error)
+	assert.Equal(t, 3, stored.Attempts)
+	if assert.NotNil(t, stored.LockedUntil) {
+		assert.True(t, stored.LockedUntil.After(time.Now()), "the reclaimed email gets a new lease")
+	}
+}
+
+func TestClaimEmailOutboxCountsAttempt(t *testing.T) {
+	db, ctx := testDB(t)
+	repo := email_repository.NewEmailRepository(db)
+
+	pending := createOutbox(t, db, ctx, constant.EmailOutboxPending, 0, nil)
+
+	claimed, err := repo.ClaimEmailOutbox(ctx, 1000, time.Minute)
+	assert.NoError(t, err)
+
+	outbox := findClaimed(claimed, pending)
+	if assert.NotNil(t, outbox) {
+		assert.Equal(t, 1, outbox.Attempts)
+	}
+
+	// A repeated claim within the lease does not take the email again
+	claimed, err = repo.ClaimEmailOutbox(ctx, 1000, time.Minute)
+	assert.NoError(t, err)
+	assert.Nil(t, findClaimed(claimed, pending))
+}
+
+func TestMarkEmailOutboxSentRequiresCurrentClaim(t *testing.T) {
+	db, ctx := testDB(t)
+	repo := email_repository.NewEmailRepository(db)
+
+	expiredAt := time.Now().Add(-time.Minute)
+	id := createOutbox(t, db, ctx, constant.EmailOutboxProcessing, 1, &expiredAt)
+	staleLockedAt := time.Now().Add(-2 * time.Minute).Truncate(time.Microsecond)
+	require.NoError(t, db.GetTx(ctx).Model(&model.EmailOutbox{}).Where("id = ?", id).Update("locked_at", staleLockedAt).Error)
+
+	claimed, err := repo.ClaimEmailOutbox(ctx, 1000, time.Minute)
+	require.NoError(t, err)
+	outbox := findClaimed(claimed, id)
+	require.NotNil(t, outbox)
+
+	// The worker whose lease expired finishes after the email was claimed again
+	assert.NoError(t, repo.MarkEmailOutboxSent(ctx, id, staleLockedAt))
+	var stored model.EmailOutbox
+	assert.NoError(t, db.GetTx(ctx).First(&stored, id).Error)
+	assert.Equal(t, constant.EmailOutboxProcessing, stored.Status, "a stale claim does not record the outcome")
+
+	assert.NoError(t, repo.MarkEmailOutboxSent(ctx, id, outbox.LockedAt))
+	assert.NoError(t, db.GetTx(ctx).First(&stored, id).Error)
+	assert.Equal(t, constant.EmailOutboxSent, stored.Status)
+}
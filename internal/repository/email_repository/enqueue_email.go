@@ -0,0 +1,47 @@
+package email_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+// emailTextFallback is the plain text body of the outbox emails, they are all written in HTML
+const emailTextFallback = "Please view this email in HTML format."
+
+func (er *EmailRepository) EnqueueEmail(ctx context.Context, emailLog entity.EmailLog, sendAt time.Time) error {
+	db := er.db.GetTx(ctx)
+
+	attachments, err := marshalAttachments(emailLog.Attachments)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal email attachments", err.Error())
+		return err
+	}
+
+	// An email held by the quiet hours of its recipient is sent at sendAt
+	if sendAt.IsZero() {
+		sendAt = time.Now()
+	}
+
+	modelOutbox := model.EmailOutbox{
+		EmailLogID:    &emailLog.ID,
+		Scope:         emailLog.Scope,
+		To:            emailLog.To,
+		Subject:       emailLog.Subject,
+		BodyHTML:      emailLog.Body,
+		BodyText:      emailTextFallback,
+		Status:        constant.EmailOutboxPending,
+		NextAttemptAt: sendAt,
+		Attachments:   attachments,
+	}
+
+	if err := db.WithContext(ctx).Create(&modelOutbox).Error; err != nil {
+		logger.Error(ctx, "failed to enqueue email", err.Error())
+		return err
+	}
+
+	return nil
+}
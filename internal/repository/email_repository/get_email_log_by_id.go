@@ -36,6 +36,18 @@ func (er *EmailRepository) GetEmailLogByID(ctx context.Context, id uint) (*entit
 		result.Meta = &meta
 	}
 
+	attachments, err := unmarshalAttachments(emailLog.Attachments)
+	if err != nil {
+		logger.Error(ctx, "failed to unmarshal email log attachments", err.Error())
+		return nil, err
+	}
+	result.Attachments = attachments
+
+	// Logs written before the template name was stored fall back to the current template
+	if result.TemplateName == "" {
+		result.TemplateName = emailLog.EmailTemplate.Name
+	}
+
 	// Safely get email type from template
 	result.EmailType = getEmailTypeFromTemplate(ctx, emailLog)
 
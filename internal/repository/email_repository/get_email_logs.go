@@ -10,6 +10,7 @@ import (
 	"wtm-backend/pkg/constant"
 	"wtm-backend/pkg/logger"
 
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -18,46 +19,7 @@ func (er *EmailRepository) GetEmailLogs(ctx context.Context, filter filter.Email
 	query := db.WithContext(ctx).
 		Model(&model.EmailLog{})
 
-	// Apply email type filter using subquery instead of JOIN to avoid Preload conflicts
-	if len(filter.EmailType) > 0 {
-		query = query.Where("email_logs.email_template_id IN (SELECT id FROM email_templates WHERE name IN ?)", filter.EmailType)
-	}
-
-	// Apply status filter
-	if len(filter.Status) > 0 {
-		statusIDs := make([]uint, 0)
-		for _, status := range filter.Status {
-			switch status {
-			case constant.StatusEmailPending:
-				statusIDs = append(statusIDs, constant.StatusEmailPendingID)
-			case constant.StatusEmailSuccess:
-				statusIDs = append(statusIDs, constant.StatusEmailSuccessID)
-			case constant.StatusEmailFailed:
-				statusIDs = append(statusIDs, constant.StatusEmailFailedID)
-			}
-		}
-		if len(statusIDs) > 0 {
-			query = query.Where("email_logs.status_id IN ?", statusIDs)
-		}
-	}
-
-	// Apply hotel name filter (search in meta JSON)
-	if filter.HotelName != "" {
-		query = query.Where("email_logs.meta->>'hotel_name' ILIKE ?", "%"+filter.HotelName+"%")
-	}
-
-	// Apply booking code filter (search in meta JSON)
-	if filter.BookingCode != "" {
-		query = query.Where("email_logs.meta->>'booking_code' ILIKE ?", "%"+filter.BookingCode+"%")
-	}
-
-	// Apply date range filter
-	if filter.DateFrom != nil && !filter.DateFrom.IsZero() {
-		query = query.Where("email_logs.created_at >= ?", filter.DateFrom)
-	}
-	if filter.DateTo != nil && !filter.DateTo.IsZero() {
-		query = query.Where("email_logs.created_at <= ?", filter.DateTo)
-	}
+	query = applyEmailLogFilter(query, filter)
 
 	// Count total records
 	var total int64
@@ -141,6 +103,9 @@ func (er *EmailRepository) GetEmailLogs(ctx context.Context, filter filter.Email
 			EmailTemplateID: emailLog.EmailTemplateID,
 			StatusID:        emailLog.StatusID,
 			CreatedAt:       emailLog.CreatedAt,
+			Scope:           emailLog.Scope,
+			TemplateName:    emailLog.TemplateName,
+			RetryCount:      emailLog.RetryCount,
 		}
 
 		// Copy meta if exists
@@ -172,6 +137,52 @@ func (er *EmailRepository) GetEmailLogs(ctx context.Context, filter filter.Email
 	return result, total, nil
 }
 
+// applyEmailLogFilter narrows an email log query down to the filter, pagination and sorting excluded
+func applyEmailLogFilter(query *gorm.DB, filter filter.EmailLogFilter) *gorm.DB {
+	// Apply email type filter using subquery instead of JOIN to avoid Preload conflicts
+	if len(filter.EmailType) > 0 {
+		query = query.Where("email_logs.email_template_id IN (SELECT id FROM email_templates WHERE name IN ?)", filter.EmailType)
+	}
+
+	// Apply status filter
+	if len(filter.Status) > 0 {
+		statusIDs := make([]uint, 0)
+		for _, status := range filter.Status {
+			switch status {
+			case constant.StatusEmailPending:
+				statusIDs = append(statusIDs, constant.StatusEmailPendingID)
+			case constant.StatusEmailSuccess:
+				statusIDs = append(statusIDs, constant.StatusEmailSuccessID)
+			case constant.StatusEmailFailed:
+				statusIDs = append(statusIDs, constant.StatusEmailFailedID)
+			}
+		}
+		if len(statusIDs) > 0 {
+			query = query.Where("email_logs.status_id IN ?", statusIDs)
+		}
+	}
+
+	// Apply hotel name filter (search in meta JSON)
+	if filter.HotelName != "" {
+		query = query.Where("email_logs.meta->>'hotel_name' ILIKE ?", "%"+filter.HotelName+"%")
+	}
+
+	// Apply booking code filter (search in meta JSON)
+	if filter.BookingCode != "" {
+		query = query.Where("email_logs.meta->>'booking_code' ILIKE ?", "%"+filter.BookingCode+"%")
+	}
+
+	// Apply date range filter
+	if filter.DateFrom != nil && !filter.DateFrom.IsZero() {
+		query = query.Where("email_logs.created_at >= ?", filter.DateFrom)
+	}
+	if filter.DateTo != nil && !filter.DateTo.IsZero() {
+		query = query.Where("email_logs.created_at <= ?", filter.DateTo)
+	}
+
+	return query
+}
+
 // getEmailTypeFromTemplate safely extracts email type from EmailTemplate
 // Returns empty string if template is not found or invalid
 // Uses recover to handle any potential nil pointer panics
@@ -4,15 +4,30 @@ import (
 	"context"
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/constant"
 	"wtm-backend/pkg/logger"
 	"wtm-backend/pkg/utils"
+
+	"gorm.io/gorm/clause"
 )
 
-func (er *EmailRepository) GetEmailTemplateByName(ctx context.Context, name string) (*entity.EmailTemplate, error) {
+func (er *EmailRepository) GetEmailTemplateByName(ctx context.Context, name, locale string) (*entity.EmailTemplate, error) {
 	db := er.db.GetTx(ctx)
 
+	if locale == "" {
+		locale = constant.DefaultLocale
+	}
+
+	// The variant in locale, else the default locale, else any variant of the template
 	var emailTemplate model.EmailTemplate
-	if err := db.WithContext(ctx).Where("name = ?", name).First(&emailTemplate).Error; err != nil {
+	if err := db.WithContext(ctx).
+		Where("name = ?", name).
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:                "CASE WHEN locale = ? THEN 0 WHEN locale = ? THEN 1 ELSE 2 END, id",
+			Vars:               []interface{}{locale, constant.DefaultLocale},
+			WithoutParentheses: true,
+		}}).
+		First(&emailTemplate).Error; err != nil {
 		logger.Error(ctx, "Failed to get email template by name", err.Error())
 		return nil, err
 	}
@@ -0,0 +1,23 @@
+package email_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (er *EmailRepository) GetEmailTemplateLocales(ctx context.Context, name string) ([]string, error) {
+	db := er.db.GetTx(ctx)
+
+	var locales []string
+	if err := db.WithContext(ctx).
+		Model(&model.EmailTemplate{}).
+		Where("name = ?", name).
+		Order("locale").
+		Pluck("locale", &locales).Error; err != nil {
+		logger.Error(ctx, "failed to get email template locales", err.Error())
+		return nil, err
+	}
+
+	return locales, nil
+}
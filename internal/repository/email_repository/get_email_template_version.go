@@ -0,0 +1,34 @@
+package email_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+func (er *EmailRepository) GetEmailTemplateVersion(ctx context.Context, templateID uint, version int) (*entity.EmailTemplateVersion, error) {
+	db := er.db.GetTx(ctx)
+
+	var versionModel model.EmailTemplateVersion
+	if err := db.WithContext(ctx).
+		Where("email_template_id = ? AND version = ?", templateID, version).
+		First(&versionModel).Error; err != nil {
+		if er.db.ErrRecordNotFound(ctx, err) {
+			logger.Warn(ctx, "Email template version not found", version)
+			return nil, nil
+		}
+		logger.Error(ctx, "failed to get email template version", err.Error())
+		return nil, err
+	}
+
+	var result entity.EmailTemplateVersion
+	if err := utils.CopyStrict(&result, &versionModel); err != nil {
+		logger.Error(ctx, "failed to copy email template version model to entity", err.Error())
+		return nil, err
+	}
+	result.ExternalID = versionModel.ExternalID.ExternalID
+
+	return &result, nil
+}
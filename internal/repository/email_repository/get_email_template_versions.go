@@ -0,0 +1,35 @@
+package email_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+func (er *EmailRepository) GetEmailTemplateVersions(ctx context.Context, templateID uint) ([]entity.EmailTemplateVersion, error) {
+	db := er.db.GetTx(ctx)
+
+	var versions []model.EmailTemplateVersion
+	if err := db.WithContext(ctx).
+		Where("email_template_id = ?", templateID).
+		Order("version DESC").
+		Find(&versions).Error; err != nil {
+		logger.Error(ctx, "failed to get email template versions", err.Error())
+		return nil, err
+	}
+
+	result := make([]entity.EmailTemplateVersion, 0, len(versions))
+	for _, version := range versions {
+		var item entity.EmailTemplateVersion
+		if err := utils.CopyStrict(&item, &version); err != nil {
+			logger.Error(ctx, "failed to copy email template version model to entity", err.Error())
+			return nil, err
+		}
+		item.ExternalID = version.ExternalID.ExternalID
+		result = append(result, item)
+	}
+
+	return result, nil
+}
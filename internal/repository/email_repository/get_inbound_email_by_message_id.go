@@ -0,0 +1,27 @@
+package email_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (er *EmailRepository) GetInboundEmailByMessageID(ctx context.Context, messageID string) (*entity.InboundEmail, error) {
+	db := er.db.GetTx(ctx)
+
+	var inbound model.InboundEmail
+	if err := db.WithContext(ctx).
+		Preload("Booking").
+		Where("message_id = ?", messageID).
+		First(&inbound).Error; err != nil {
+		if er.db.ErrRecordNotFound(ctx, err) {
+			return nil, nil
+		}
+		logger.Error(ctx, "failed to get inbound email by message id", err.Error())
+		return nil, err
+	}
+
+	result := toEntityInboundEmail(inbound)
+	return &result, nil
+}
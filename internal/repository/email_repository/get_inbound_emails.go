@@ -0,0 +1,58 @@
+package email_repository
+
+import (
+	"context"
+	"strings"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/internal/repository/filter"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+func (er *EmailRepository) GetInboundEmails(ctx context.Context, filter filter.InboundEmailFilter) ([]entity.InboundEmail, int64, error) {
+	db := er.db.GetTx(ctx)
+	query := db.WithContext(ctx).
+		Model(&model.InboundEmail{})
+
+	if filter.BookingCode != "" {
+		query = query.Where("booking_id IN (SELECT id FROM bookings WHERE booking_code = ?)", filter.BookingCode)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if strings.TrimSpace(filter.Search) != "" {
+		safeSearch := "%" + utils.EscapeAndNormalizeSearch(filter.Search) + "%"
+		query = query.Where(`"from" ILIKE ? OR subject ILIKE ?`, safeSearch, safeSearch)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		logger.Error(ctx, "failed to count inbound emails", err.Error())
+		return nil, 0, err
+	}
+
+	if filter.Limit > 0 {
+		if filter.Page < 1 {
+			filter.Page = 1
+		}
+		offset := (filter.Page - 1) * filter.Limit
+		query = query.Limit(filter.Limit).Offset(offset)
+	}
+
+	var inbounds []model.InboundEmail
+	if err := query.
+		Preload("Booking").
+		Order("created_at DESC").
+		Find(&inbounds).Error; err != nil {
+		logger.Error(ctx, "failed to get inbound emails", err.Error())
+		return nil, 0, err
+	}
+
+	result := make([]entity.InboundEmail, 0, len(inbounds))
+	for _, inbound := range inbounds {
+		result = append(result, toEntityInboundEmail(inbound))
+	}
+
+	return result, total, nil
+}
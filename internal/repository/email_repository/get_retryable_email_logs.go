@@ -0,0 +1,75 @@
+package email_repository
+
+import (
+	"context"
+	"encoding/json"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/internal/repository/filter"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+// GetRetryableEmailLogs returns the oldest email logs matching the filter that are not already waiting in the outbox.
+// maxRetries of 0 means no limit on the retries already done, filter.Limit caps the number of logs returned.
+func (er *EmailRepository) GetRetryableEmailLogs(ctx context.Context, filter filter.EmailLogFilter, maxRetries int) ([]entity.EmailLog, error) {
+	db := er.db.GetTx(ctx)
+	query := db.WithContext(ctx).
+		Model(&model.EmailLog{})
+
+	query = applyEmailLogFilter(query, filter)
+
+	// Emails already queued are sent by the outbox workers, retrying them would send twice
+	query = query.Where("NOT EXISTS (SELECT 1 FROM email_outboxes WHERE email_outboxes.email_log_id = email_logs.id AND email_outboxes.status IN ? AND email_outboxes.deleted_at IS NULL)",
+		[]string{constant.EmailOutboxPending, constant.EmailOutboxProcessing})
+
+	if maxRetries > 0 {
+		query = query.Where("email_logs.retry_count < ?", maxRetries)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var emailLogs []model.EmailLog
+	if err := query.Preload("EmailTemplate").Order("email_logs.created_at asc").Find(&emailLogs).Error; err != nil {
+		logger.Error(ctx, "failed to get retryable email logs", err.Error())
+		return nil, err
+	}
+
+	result := make([]entity.EmailLog, 0, len(emailLogs))
+	for _, emailLog := range emailLogs {
+		log := entity.EmailLog{
+			ID:              emailLog.ID,
+			To:              emailLog.To,
+			Subject:         emailLog.Subject,
+			Body:            emailLog.Body,
+			EmailTemplateID: emailLog.EmailTemplateID,
+			StatusID:        emailLog.StatusID,
+			CreatedAt:       emailLog.CreatedAt,
+			Scope:           emailLog.Scope,
+			TemplateName:    emailLog.TemplateName,
+			RetryCount:      emailLog.RetryCount,
+		}
+		// Logs written before the template name was stored fall back to the current template
+		if log.TemplateName == "" {
+			log.TemplateName = emailLog.EmailTemplate.Name
+		}
+		attachments, err := unmarshalAttachments(emailLog.Attachments)
+		if err != nil {
+			logger.Error(ctx, "failed to unmarshal email log attachments", err.Error())
+			return nil, err
+		}
+		log.Attachments = attachments
+		if emailLog.Meta != nil {
+			var meta entity.MetadataEmailLog
+			if err := json.Unmarshal(emailLog.Meta, &meta); err != nil {
+				logger.Error(ctx, "failed to unmarshal email log meta", err.Error())
+				return nil, err
+			}
+			log.Meta = &meta
+		}
+		result = append(result, log)
+	}
+
+	return result, nil
+}
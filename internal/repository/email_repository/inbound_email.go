@@ -0,0 +1,29 @@
+package email_repository
+
+import (
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+)
+
+func toEntityInboundEmail(inbound model.InboundEmail) entity.InboundEmail {
+	result := entity.InboundEmail{
+		ID:                 inbound.ID,
+		ExternalID:         inbound.ExternalID.ExternalID,
+		MessageID:          inbound.MessageID,
+		From:               inbound.From,
+		To:                 inbound.To,
+		Subject:            inbound.Subject,
+		Body:               inbound.Body,
+		BookingID:          inbound.BookingID,
+		SubBookingIDs:      inbound.SubBookingIDs,
+		Action:             inbound.Action,
+		ConfirmationNumber: inbound.ConfirmationNumber,
+		Status:             inbound.Status,
+		Note:               inbound.Note,
+		CreatedAt:          inbound.CreatedAt,
+	}
+	if inbound.Booking != nil {
+		result.BookingCode = inbound.Booking.BookingCode
+	}
+	return result
+}
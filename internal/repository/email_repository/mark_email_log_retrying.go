@@ -0,0 +1,31 @@
+package email_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+
+	"gorm.io/gorm"
+)
+
+// MarkEmailLogRetrying moves an email log back to pending and counts the retry, only while it still has the status and
+// retry count it was read with. It returns false when another retry (e.g. the auto retry of another replica) got it first
+func (er *EmailRepository) MarkEmailLogRetrying(ctx context.Context, id uint, statusID uint, retryCount int) (bool, error) {
+	db := er.db.GetTx(ctx)
+
+	result := db.WithContext(ctx).
+		Model(&model.EmailLog{}).
+		Where("id = ? AND status_id = ? AND retry_count = ?", id, statusID, retryCount).
+		Updates(map[string]interface{}{
+			"status_id":   constant.StatusEmailPendingID,
+			"retry_count": gorm.Expr("retry_count + 1"),
+			"updated_at":  gorm.Expr("NOW()"),
+		})
+	if result.Error != nil {
+		logger.Error(ctx, "failed to mark email log retrying", result.Error.Error())
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,64 @@
+package email_repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+
+	"gorm.io/gorm"
+)
+
+func (er *EmailRepository) MarkEmailOutboxFailed(ctx context.Context, outboxID uint, lockedAt time.Time, lastError string, nextAttemptAt time.Time, dead bool) error {
+	db := er.db.GetTx(ctx)
+
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		status := constant.EmailOutboxPending
+		if dead {
+			status = constant.EmailOutboxDead
+		}
+		// Only the claim still holding the email records the outcome
+		result := tx.Model(&model.EmailOutbox{}).
+			Where("id = ? AND locked_at = ?", outboxID, lockedAt).
+			Updates(map[string]interface{}{
+				"status":          status,
+				"next_attempt_at": nextAttemptAt,
+				"locked_until":    nil,
+				"last_error":      lastError,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			logger.Warn(ctx, fmt.Sprintf("email outbox %d was claimed again before it was marked failed", outboxID))
+			return nil
+		}
+
+		// The email log stays pending while attempts are left
+		if !dead {
+			return nil
+		}
+		var outbox model.EmailOutbox
+		if err := tx.Select("id", "email_log_id").First(&outbox, outboxID).Error; err != nil {
+			return err
+		}
+		if outbox.EmailLogID == nil {
+			return nil
+		}
+		return tx.Model(&model.EmailLog{}).
+			Where("id = ?", *outbox.EmailLogID).
+			Updates(map[string]interface{}{
+				"status_id":  constant.StatusEmailFailedID,
+				"meta":       gorm.Expr("COALESCE(meta, '{}'::jsonb) || jsonb_build_object('notes', ?::text)", fmt.Sprintf("Failed to send email: %s", lastError)),
+				"updated_at": gorm.Expr("NOW()"),
+			}).Error
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to mark email outbox failed", err.Error())
+		return fmt.Errorf("failed to mark email outbox failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package email_repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+
+	"gorm.io/gorm"
+)
+
+func (er *EmailRepository) MarkEmailOutboxSent(ctx context.Context, outboxID uint, lockedAt time.Time) error {
+	db := er.db.GetTx(ctx)
+
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Only the claim still holding the email records the outcome
+		result := tx.Model(&model.EmailOutbox{}).
+			Where("id = ? AND locked_at = ?", outboxID, lockedAt).
+			Updates(map[string]interface{}{
+				"status":       constant.EmailOutboxSent,
+				"sent_at":      gorm.Expr("NOW()"),
+				"locked_until": nil,
+				"last_error":   "",
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			logger.Warn(ctx, fmt.Sprintf("email outbox %d was claimed again before it was marked sent", outboxID))
+			return nil
+		}
+
+		var outbox model.EmailOutbox
+		if err := tx.Select("id", "email_log_id").First(&outbox, outboxID).Error; err != nil {
+			return err
+		}
+		if outbox.EmailLogID == nil {
+			return nil
+		}
+		return tx.Model(&model.EmailLog{}).
+			Where("id = ?", *outbox.EmailLogID).
+			Updates(map[string]interface{}{
+				"status_id":  constant.StatusEmailSuccessID,
+				"updated_at": gorm.Expr("NOW()"),
+			}).Error
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to mark email outbox sent", err.Error())
+		return fmt.Errorf("failed to mark email outbox sent: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package email_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/domain/entity"
+)
+
+func (er *EmailRepository) QueueEmail(ctx context.Context, emailLog *entity.EmailLog, sendAt time.Time) error {
+	if err := er.CreateEmailLog(ctx, emailLog); err != nil {
+		return err
+	}
+	return er.EnqueueEmail(ctx, *emailLog, sendAt)
+}
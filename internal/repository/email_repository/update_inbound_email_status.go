@@ -0,0 +1,24 @@
+package email_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (er *EmailRepository) UpdateInboundEmailStatus(ctx context.Context, id uint, status, note string) error {
+	db := er.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).
+		Model(&model.InboundEmail{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status": status,
+			"note":   note,
+		}).Error; err != nil {
+		logger.Error(ctx, "failed to update inbound email status", err.Error())
+		return err
+	}
+
+	return nil
+}
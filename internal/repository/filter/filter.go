@@ -45,6 +45,12 @@ type EmailLogFilter struct {
 	DateTo      *time.Time
 }
 
+type InboundEmailFilter struct {
+	dto.PaginationRequest
+	BookingCode string
+	Status      string
+}
+
 type BannerFilter struct {
 	dto.PaginationRequest
 	IsActive *bool
@@ -86,6 +92,10 @@ type HotelFilterForAgent struct {
 	DateTo   *time.Time
 	MinGuest int
 	Currency string // Agent's currency preference - used to filter hotels that have prices in this currency
+
+	// Exchange rate in effect for Currency, derives prices not entered in it (0 = not derived)
+	ExchangeRate       float64 // IDR per 1 unit of Currency
+	ExchangeRateMarkup float64 // Markup percentage
 	dto.PaginationRequest
 }
 
@@ -0,0 +1,36 @@
+package hotel_repository
+
+import (
+	"context"
+	"encoding/json"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func toCancellationPolicyEntity(ctx context.Context, policy model.CancellationPolicy) entity.CancellationPolicy {
+	result := entity.CancellationPolicy{
+		ID:          policy.ID,
+		Name:        policy.Name,
+		Description: policy.Description,
+	}
+	if len(policy.Tiers) > 0 {
+		if err := json.Unmarshal(policy.Tiers, &result.Tiers); err != nil {
+			logger.Error(ctx, "Failed to unmarshal cancellation policy tiers", err.Error())
+		}
+	}
+	return result
+}
+
+func toCancellationPolicyModel(policy *entity.CancellationPolicy) (*model.CancellationPolicy, error) {
+	tiers, err := json.Marshal(policy.Tiers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CancellationPolicy{
+		Name:        policy.Name,
+		Description: policy.Description,
+		Tiers:       tiers,
+	}, nil
+}
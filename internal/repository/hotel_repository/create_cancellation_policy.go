@@ -0,0 +1,25 @@
+package hotel_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/logger"
+)
+
+func (hr *HotelRepository) CreateCancellationPolicy(ctx context.Context, policy *entity.CancellationPolicy) error {
+	db := hr.db.GetTx(ctx)
+
+	policyModel, err := toCancellationPolicyModel(policy)
+	if err != nil {
+		logger.Error(ctx, "Failed to convert cancellation policy tiers to JSON", err.Error())
+		return err
+	}
+
+	if err := db.WithContext(ctx).Create(policyModel).Error; err != nil {
+		logger.Error(ctx, "Failed to create cancellation policy", err.Error())
+		return err
+	}
+
+	policy.ID = policyModel.ID
+	return nil
+}
@@ -0,0 +1,25 @@
+package hotel_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/logger"
+)
+
+func (hr *HotelRepository) CreateRoomRatePlan(ctx context.Context, ratePlan *entity.RoomRatePlan) error {
+	db := hr.db.GetTx(ctx)
+
+	ratePlanModel, err := toRoomRatePlanModel(ratePlan)
+	if err != nil {
+		logger.Error(ctx, "Failed to convert rate plan prices to JSON", err.Error())
+		return err
+	}
+
+	if err := db.WithContext(ctx).Create(ratePlanModel).Error; err != nil {
+		logger.Error(ctx, "Failed to create room rate plan", err.Error())
+		return err
+	}
+
+	ratePlan.ID = ratePlanModel.ID
+	return nil
+}
@@ -0,0 +1,34 @@
+package hotel_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+// DeleteCancellationPolicy deletes the policy and detaches it from hotels and rate plans,
+// which fall back to the hotel policy or CancellationPeriod.
+func (hr *HotelRepository) DeleteCancellationPolicy(ctx context.Context, id uint) error {
+	db := hr.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).Model(&model.Hotel{}).
+		Where("cancellation_policy_id = ?", id).
+		Update("cancellation_policy_id", nil).Error; err != nil {
+		logger.Error(ctx, "Failed to detach cancellation policy from hotels", err.Error())
+		return err
+	}
+
+	if err := db.WithContext(ctx).Model(&model.RoomRatePlan{}).
+		Where("cancellation_policy_id = ?", id).
+		Update("cancellation_policy_id", nil).Error; err != nil {
+		logger.Error(ctx, "Failed to detach cancellation policy from rate plans", err.Error())
+		return err
+	}
+
+	if err := db.WithContext(ctx).Delete(&model.CancellationPolicy{}, id).Error; err != nil {
+		logger.Error(ctx, "Failed to delete cancellation policy", err.Error())
+		return err
+	}
+
+	return nil
+}
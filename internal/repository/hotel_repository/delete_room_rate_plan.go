@@ -0,0 +1,18 @@
+package hotel_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (hr *HotelRepository) DeleteRoomRatePlan(ctx context.Context, id uint) error {
+	db := hr.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).Delete(&model.RoomRatePlan{}, id).Error; err != nil {
+		logger.Error(ctx, "Failed to delete room rate plan", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package hotel_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (hr *HotelRepository) DeleteRoomRestrictions(ctx context.Context, roomTypeID uint, month time.Time) error {
+	db := hr.db.GetTx(ctx)
+
+	startDate := month
+	endDate := startDate.AddDate(0, 1, 0)
+
+	if err := db.WithContext(ctx).
+		Where("date >= ? AND date < ?", startDate.Format(time.DateOnly), endDate.Format(time.DateOnly)).
+		Where("room_type_id = ?", roomTypeID).
+		Unscoped().Delete(&model.RoomRestriction{}).Error; err != nil {
+		logger.Error(ctx, "Failed to delete room restrictions", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package hotel_repository
+
+import (
+	"fmt"
+	"time"
+	"wtm-backend/internal/repository/filter"
+	"wtm-backend/pkg/currency"
+)
+
+// effectiveRoomPriceExpr returns the SQL expression of a room price (alias rp) used by the min price queries,
+// always in the agent's currency so it can be compared with the price filter.
+// Without a stay it is the base price. With DateFrom/DateTo it is the average nightly rate of the stay,
+// taking the same rate plan as pricing.RatePlanFor on every night.
+func effectiveRoomPriceExpr(f filter.HotelFilterForAgent) (string, []interface{}) {
+	code := currency.NormalizeCurrencyCode(f.Currency)
+	if code == "" {
+		code = "IDR"
+	}
+
+	basePrice, baseArgs := agentCurrencyPriceExpr("rp.prices", "rp.price", code, f)
+	if f.DateFrom == nil || f.DateTo == nil || f.DateFrom.IsZero() || !f.DateTo.After(*f.DateFrom) {
+		return basePrice, baseArgs
+	}
+
+	planPrice, planArgs := agentCurrencyPriceExpr("rrp.prices", "NULL", code, f)
+	expr := fmt.Sprintf(`(
+				SELECT AVG(COALESCE(
+					(
+						SELECT %s
+						FROM room_rate_plans rrp
+						WHERE rrp.room_price_id = rp.id
+						AND rrp.deleted_at IS NULL
+						AND rrp.is_active = true
+						AND night::date BETWEEN rrp.start_date AND rrp.end_date
+						AND (COALESCE(cardinality(rrp.days_of_week), 0) = 0 OR EXTRACT(DOW FROM night)::int = ANY(rrp.days_of_week))
+						ORDER BY rrp.priority DESC, COALESCE(cardinality(rrp.days_of_week), 0) > 0 DESC, rrp.id DESC
+						LIMIT 1
+					),
+					%s
+				))
+				FROM generate_series(?::date, ?::date - INTERVAL '1 day', INTERVAL '1 day') AS night
+			)`, planPrice, basePrice)
+
+	args := append(append(planArgs, baseArgs...), f.DateFrom.Format(time.DateOnly), f.DateTo.Format(time.DateOnly))
+	return expr, args
+}
+
+// agentCurrencyPriceExpr returns the price in code of a prices jsonb column. A price not entered in code is
+// derived from the IDR price with the exchange rate of the filter, as pricing.DerivePrices does.
+// legacyIDR is the deprecated IDR price column used when the prices have no IDR entry, "NULL" when there is none.
+// Without a price in code it is NULL, so amounts in other currencies never reach the price filter.
+func agentCurrencyPriceExpr(pricesColumn, legacyIDR string, code string, f filter.HotelFilterForAgent) (string, []interface{}) {
+	idrPrice := fmt.Sprintf("COALESCE((%s->>'IDR')::numeric, %s)", pricesColumn, legacyIDR)
+	if code == "IDR" {
+		return idrPrice, nil
+	}
+	if f.ExchangeRate <= 0 {
+		return fmt.Sprintf("(%s->>?)::numeric", pricesColumn), []interface{}{code}
+	}
+
+	expr := fmt.Sprintf("COALESCE((%s->>?)::numeric, ROUND(%s / ? * ?, %d))", pricesColumn, idrPrice, currency.GetDecimalPlaces(code))
+	return expr, []interface{}{code, f.ExchangeRate, 1 + f.ExchangeRateMarkup/100}
+}
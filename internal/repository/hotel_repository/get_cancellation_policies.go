@@ -0,0 +1,26 @@
+package hotel_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (hr *HotelRepository) GetCancellationPolicies(ctx context.Context) ([]entity.CancellationPolicy, error) {
+	db := hr.db.GetTx(ctx)
+
+	var policies []model.CancellationPolicy
+	if err := db.WithContext(ctx).
+		Order("name ASC").
+		Find(&policies).Error; err != nil {
+		logger.Error(ctx, "Failed to get cancellation policies", err.Error())
+		return nil, err
+	}
+
+	result := make([]entity.CancellationPolicy, 0, len(policies))
+	for _, policy := range policies {
+		result = append(result, toCancellationPolicyEntity(ctx, policy))
+	}
+	return result, nil
+}
@@ -0,0 +1,23 @@
+package hotel_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (hr *HotelRepository) GetCancellationPolicyByID(ctx context.Context, id uint) (*entity.CancellationPolicy, error) {
+	db := hr.db.GetTx(ctx)
+
+	var policy model.CancellationPolicy
+	if err := db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&policy).Error; err != nil {
+		logger.Error(ctx, "Failed to get cancellation policy by id", err.Error())
+		return nil, err
+	}
+
+	result := toCancellationPolicyEntity(ctx, policy)
+	return &result, nil
+}
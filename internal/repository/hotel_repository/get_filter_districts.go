@@ -12,7 +12,9 @@ import (
 func (hr *HotelRepository) GetFilterDistricts(ctx context.Context, filter filter.HotelFilterForAgent) ([]string, error) {
 	db := hr.db.GetTx(ctx)
 
-	var args []interface{}
+	// The effective price is selected in the min price subquery, so its args come first
+	priceExpr, priceArgs := effectiveRoomPriceExpr(filter)
+	args := append([]interface{}{}, priceArgs...)
 	var hotelConditions []string
 	var roomConditions []string
 	var priceHaving string
@@ -59,14 +61,14 @@ func (hr *HotelRepository) GetFilterDistricts(ctx context.Context, filter filter
 
 	// 🔍 Filter harga
 	if filter.PriceMin != nil && filter.PriceMax != nil {
-		priceHaving = "HAVING MIN(rp.price) BETWEEN ? AND ?"
-		args = append(args, *filter.PriceMin, *filter.PriceMax)
+		priceHaving = "HAVING MIN(" + priceExpr + ") BETWEEN ? AND ?"
+		args = append(append(args, priceArgs...), *filter.PriceMin, *filter.PriceMax)
 	} else if filter.PriceMin != nil {
-		priceHaving = "HAVING MIN(rp.price) >= ?"
-		args = append(args, *filter.PriceMin)
+		priceHaving = "HAVING MIN(" + priceExpr + ") >= ?"
+		args = append(append(args, priceArgs...), *filter.PriceMin)
 	} else if filter.PriceMax != nil {
-		priceHaving = "HAVING MIN(rp.price) <= ?"
-		args = append(args, *filter.PriceMax)
+		priceHaving = "HAVING MIN(" + priceExpr + ") <= ?"
+		args = append(append(args, priceArgs...), *filter.PriceMax)
 	}
 
 	// 🔍 Filter province
@@ -97,6 +99,7 @@ func (hr *HotelRepository) GetFilterDistricts(ctx context.Context, filter filter
 	// Build query
 	query := hr.buildBaseHotelQuery(
 		`SELECT DISTINCT h.addr_city`,
+		priceExpr,
 		roomConditions,
 		priceHaving,
 		hotelConditions,
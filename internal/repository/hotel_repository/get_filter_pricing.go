@@ -13,7 +13,9 @@ import (
 func (hr *HotelRepository) GetFilterPricing(ctx context.Context, filter filter.HotelFilterForAgent) (*entity.FilterRangePrice, error) {
 	db := hr.db.GetTx(ctx)
 
-	var args []interface{}
+	// The effective price is selected in the min price subquery, so its args come first
+	priceExpr, priceArgs := effectiveRoomPriceExpr(filter)
+	args := append([]interface{}{}, priceArgs...)
 	var hotelConditions []string
 	var roomConditions []string
 
@@ -91,6 +93,7 @@ func (hr *HotelRepository) GetFilterPricing(ctx context.Context, filter filter.H
 	// Build query
 	query := hr.buildBaseHotelQuery(
 		`SELECT MIN(mp.min_price) AS min_price, MAX(mp.min_price) AS max_price`,
+		priceExpr,
 		roomConditions,
 		"", // no price HAVING
 		hotelConditions,
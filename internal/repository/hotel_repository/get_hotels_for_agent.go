@@ -9,6 +9,7 @@ import (
 	"wtm-backend/pkg/constant"
 	"wtm-backend/pkg/currency"
 	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/pricing"
 	"wtm-backend/pkg/utils"
 
 	"github.com/lib/pq"
@@ -17,7 +18,9 @@ import (
 func (hr *HotelRepository) GetHotelsForAgent(ctx context.Context, filter filter.HotelFilterForAgent) ([]entity.CustomHotel, int64, error) {
 	db := hr.db.GetTx(ctx)
 
-	var args []interface{}
+	// The effective price is selected in the min price subquery, so its args come first
+	priceExpr, priceArgs := effectiveRoomPriceExpr(filter)
+	args := append([]interface{}{}, priceArgs...)
 	var hotelConditions []string
 	var roomConditions []string
 	var priceHaving string
@@ -64,14 +67,14 @@ func (hr *HotelRepository) GetHotelsForAgent(ctx context.Context, filter filter.
 
 	// 🔍 Filter harga
 	if filter.PriceMin != nil && filter.PriceMax != nil {
-		priceHaving = "HAVING MIN(rp.price) BETWEEN ? AND ?"
-		args = append(args, *filter.PriceMin, *filter.PriceMax)
+		priceHaving = "HAVING MIN(" + priceExpr + ") BETWEEN ? AND ?"
+		args = append(append(args, priceArgs...), *filter.PriceMin, *filter.PriceMax)
 	} else if filter.PriceMin != nil {
-		priceHaving = "HAVING MIN(rp.price) >= ?"
-		args = append(args, *filter.PriceMin)
+		priceHaving = "HAVING MIN(" + priceExpr + ") >= ?"
+		args = append(append(args, priceArgs...), *filter.PriceMin)
 	} else if filter.PriceMax != nil {
-		priceHaving = "HAVING MIN(rp.price) <= ?"
-		args = append(args, *filter.PriceMax)
+		priceHaving = "HAVING MIN(" + priceExpr + ") <= ?"
+		args = append(append(args, priceArgs...), *filter.PriceMax)
 	}
 
 	// 🔍 Filter province
@@ -106,6 +109,7 @@ func (hr *HotelRepository) GetHotelsForAgent(ctx context.Context, filter filter.
 	// Build base query (tanpa LastInternalID)
 	baseQuery := hr.buildBaseHotelQuery(
 		`SELECT h.id, h.name, h.addr_province, h.addr_city, h.addr_sub_district, h.photos, h.rating, h.created_at, mp.min_price`,
+		priceExpr,
 		roomConditions,
 		priceHaving,
 		hotelConditions,
@@ -200,16 +204,28 @@ func (hr *HotelRepository) GetHotelsForAgent(ctx context.Context, filter filter.
 
 		// Query all room_prices for these hotels
 		type RoomPriceRow struct {
+			ID      uint
 			HotelID uint
 			Prices  []byte
 		}
 		var roomPrices []RoomPriceRow
 		err := db.Table("room_prices rp").
-			Select("rt.hotel_id, rp.prices").
+			Select("rp.id, rt.hotel_id, rp.prices").
 			Joins("JOIN room_types rt ON rt.id = rp.room_type_id").
 			Where("rt.hotel_id IN ? AND rp.is_show = true AND rp.prices IS NOT NULL AND rp.prices != '{}'::jsonb", hotelIDs).
 			Scan(&roomPrices).Error
 
+		// With a stay the prices are the average nightly rate with rate plans applied
+		withStay := filter.DateFrom != nil && filter.DateTo != nil && filter.DateTo.After(*filter.DateFrom)
+		var ratePlans []entity.RoomRatePlan
+		if err == nil && withStay {
+			roomPriceIDs := make([]uint, 0, len(roomPrices))
+			for _, rp := range roomPrices {
+				roomPriceIDs = append(roomPriceIDs, rp.ID)
+			}
+			ratePlans, err = hr.GetRoomRatePlans(ctx, roomPriceIDs, *filter.DateFrom, *filter.DateTo)
+		}
+
 		if err == nil {
 			// Group prices by hotel_id and find minimum for each currency
 			hotelPricesMap := make(map[uint]map[string]float64)
@@ -220,6 +236,20 @@ func (hr *HotelRepository) GetHotelsForAgent(ctx context.Context, filter filter.
 					continue
 				}
 
+				if withStay {
+					nights := int(filter.DateTo.Sub(*filter.DateFrom).Hours() / 24)
+					roomPrice := entity.RoomPrice{ID: rp.ID, Prices: prices}
+					prices = make(map[string]float64, len(roomPrice.Prices))
+					for curr := range roomPrice.Prices {
+						rates, err := pricing.NightlyRates(roomPrice, ratePlans, curr, *filter.DateFrom, *filter.DateTo)
+						if err != nil {
+							logger.Error(ctx, "Failed to price room stay", err.Error())
+							continue
+						}
+						prices[curr] = pricing.Total(rates).Div(nights).Float64()
+					}
+				}
+
 				if hotelPricesMap[rp.HotelID] == nil {
 					hotelPricesMap[rp.HotelID] = make(map[string]float64)
 				}
@@ -304,6 +334,7 @@ func (hr *HotelRepository) GetHotelsForAgent(ctx context.Context, filter filter.
 // buildBaseHotelQuery builds the core query structure reused across all filter functions
 func (hr *HotelRepository) buildBaseHotelQuery(
 	selectClause string,
+	priceExpr string,
 	roomConditions []string,
 	priceHaving string,
 	hotelConditions []string,
@@ -317,10 +348,10 @@ func (hr *HotelRepository) buildBaseHotelQuery(
 	queryBuilder.WriteString(selectClause)
 	queryBuilder.WriteString("\n\t\tFROM hotels h")
 
-	// Subquery untuk minimum price (ALWAYS the same)
+	// Subquery untuk minimum price (priceExpr from effectiveRoomPriceExpr)
 	queryBuilder.WriteString(`
 		JOIN ( 
-			SELECT rt.hotel_id, MIN(rp.price) AS min_price
+			SELECT rt.hotel_id, MIN(` + priceExpr + `) AS min_price
 			FROM room_types rt
 			JOIN room_prices rp ON rt.id = rp.room_type_id
 			JOIN bed_type_rooms btr ON btr.room_type_id = rt.id
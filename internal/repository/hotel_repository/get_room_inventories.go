@@ -0,0 +1,72 @@
+package hotel_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+// bookedUnitsSQL counts the units of the sub-bookings holding the night of room type rt, for the nights that have no
+// ledger row yet. Its arguments are the in cart status of the booking and the active statuses of the sub-booking.
+const bookedUnitsSQL = `(
+	SELECT COALESCE(SUM(bd.quantity), 0)
+	FROM booking_details bd
+	JOIN bookings b ON b.id = bd.booking_id AND b.deleted_at IS NULL
+	JOIN room_prices rp ON rp.id = bd.room_price_id
+	WHERE rp.room_type_id = rt.id
+	AND bd.deleted_at IS NULL
+	AND b.status_booking_id <> ?
+	AND bd.status_booking_id IN ?
+	AND bd.check_in_date::date <= night::date
+	AND bd.check_out_date::date > night::date
+)`
+
+// activeBookingStatuses are the sub-booking statuses that take units out of the inventory
+var activeBookingStatuses = []int{constant.StatusBookingWaitingApprovalID, constant.StatusBookingConfirmedID}
+
+func (hr *HotelRepository) GetRoomInventories(ctx context.Context, roomTypeIDs []uint, startDate, endDate time.Time) ([]entity.RoomInventory, error) {
+	db := hr.db.GetTx(ctx)
+
+	if len(roomTypeIDs) == 0 || !endDate.After(startDate) {
+		return nil, nil
+	}
+
+	// Nights without a ledger row are read as the row ensureRoomInventory would create, nothing is written here
+	query := `
+		SELECT rt.id AS room_type_id, night::date AS date,
+			COALESCE(ri.total_unit, rt.total_unit) AS total_unit,
+			COALESCE(ri.booked_unit, ` + bookedUnitsSQL + `) AS booked_unit
+		FROM room_types rt
+		CROSS JOIN generate_series(?::date, ?::date - INTERVAL '1 day', INTERVAL '1 day') AS night
+		LEFT JOIN room_inventories ri ON ri.room_type_id = rt.id AND ri.date = night::date AND ri.deleted_at IS NULL
+		WHERE rt.id IN ? AND rt.deleted_at IS NULL
+		ORDER BY rt.id ASC, night ASC
+	`
+
+	var results []model.RoomInventory
+	if err := db.WithContext(ctx).Raw(query,
+		constant.StatusBookingInCartID,
+		activeBookingStatuses,
+		startDate.Format(time.DateOnly),
+		endDate.Format(time.DateOnly),
+		roomTypeIDs,
+	).Scan(&results).Error; err != nil {
+		logger.Error(ctx, "Failed to fetch room inventory", err.Error())
+		return nil, err
+	}
+
+	inventories := make([]entity.RoomInventory, 0, len(results))
+	for _, ri := range results {
+		inventories = append(inventories, entity.RoomInventory{
+			RoomTypeID: ri.RoomTypeID,
+			Date:       ri.Date,
+			TotalUnit:  ri.TotalUnit,
+			BookedUnit: ri.BookedUnit,
+		})
+	}
+
+	return inventories, nil
+}
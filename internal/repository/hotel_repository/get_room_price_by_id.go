@@ -19,6 +19,11 @@ func (hr *HotelRepository) GetRoomPriceByID(ctx context.Context, id uint) (*enti
 		Preload("RoomType.Hotel").
 		Preload("RoomType.BedTypes").
 		First(&rp).Error; err != nil {
+		if hr.db.ErrRecordNotFound(ctx, err) {
+			logger.Warn(ctx, "Room price not found with Id", id)
+			return nil, nil
+		}
+		logger.Error(ctx, "Error finding room price by Id", err.Error())
 		return nil, err
 	}
 
@@ -0,0 +1,24 @@
+package hotel_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (hr *HotelRepository) GetRoomRatePlanByID(ctx context.Context, id uint) (*entity.RoomRatePlan, error) {
+	db := hr.db.GetTx(ctx)
+
+	var ratePlan model.RoomRatePlan
+	if err := db.WithContext(ctx).
+		Preload("RoomPrice").
+		Where("id = ?", id).
+		First(&ratePlan).Error; err != nil {
+		logger.Error(ctx, "Failed to get room rate plan by id", err.Error())
+		return nil, err
+	}
+
+	result := toRoomRatePlanEntities(ctx, []model.RoomRatePlan{ratePlan})
+	return &result[0], nil
+}
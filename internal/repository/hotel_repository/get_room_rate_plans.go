@@ -0,0 +1,61 @@
+package hotel_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/currency"
+	"wtm-backend/pkg/logger"
+)
+
+func (hr *HotelRepository) GetRoomRatePlans(ctx context.Context, roomPriceIDs []uint, startDate, endDate time.Time) ([]entity.RoomRatePlan, error) {
+	db := hr.db.GetTx(ctx)
+
+	if len(roomPriceIDs) == 0 || !endDate.After(startDate) {
+		return nil, nil
+	}
+
+	var ratePlans []model.RoomRatePlan
+	if err := db.WithContext(ctx).
+		Preload("RoomPrice").
+		Where("room_price_id IN ?", roomPriceIDs).
+		Where("is_active = ?", true).
+		Where("start_date < ? AND end_date >= ?", endDate.Format(time.DateOnly), startDate.Format(time.DateOnly)).
+		Find(&ratePlans).Error; err != nil {
+		logger.Error(ctx, "Failed to get room rate plans", err.Error())
+		return nil, err
+	}
+
+	return toRoomRatePlanEntities(ctx, ratePlans), nil
+}
+
+func toRoomRatePlanEntities(ctx context.Context, ratePlans []model.RoomRatePlan) []entity.RoomRatePlan {
+	result := make([]entity.RoomRatePlan, 0, len(ratePlans))
+	for _, rp := range ratePlans {
+		ratePlan := entity.RoomRatePlan{
+			ID:          rp.ID,
+			RoomPriceID: rp.RoomPriceID,
+			IsBreakfast: rp.RoomPrice.IsBreakfast,
+			Name:        rp.Name,
+			StartDate:   rp.StartDate,
+			EndDate:     rp.EndDate,
+			Priority:    rp.Priority,
+			IsActive:    rp.IsActive,
+
+			CancellationPolicyID: rp.CancellationPolicyID,
+		}
+		for _, day := range rp.DaysOfWeek {
+			ratePlan.DaysOfWeek = append(ratePlan.DaysOfWeek, int(day))
+		}
+		if len(rp.Prices) > 0 {
+			prices, err := currency.JSONToPrices(rp.Prices)
+			if err != nil {
+				logger.Error(ctx, "Failed to convert rate plan prices JSONB to map", err.Error())
+			}
+			ratePlan.Prices = prices
+		}
+		result = append(result, ratePlan)
+	}
+	return result
+}
@@ -0,0 +1,25 @@
+package hotel_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (hr *HotelRepository) GetRoomRatePlansByRoomTypeID(ctx context.Context, roomTypeID uint) ([]entity.RoomRatePlan, error) {
+	db := hr.db.GetTx(ctx)
+
+	var ratePlans []model.RoomRatePlan
+	if err := db.WithContext(ctx).
+		Preload("RoomPrice").
+		Joins("JOIN room_prices rp ON rp.id = room_rate_plans.room_price_id").
+		Where("rp.room_type_id = ?", roomTypeID).
+		Order("room_rate_plans.start_date ASC, room_rate_plans.priority DESC").
+		Find(&ratePlans).Error; err != nil {
+		logger.Error(ctx, "Failed to get room rate plans by room type id", err.Error())
+		return nil, err
+	}
+
+	return toRoomRatePlanEntities(ctx, ratePlans), nil
+}
@@ -0,0 +1,40 @@
+package hotel_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+func (hr *HotelRepository) GetRoomRestrictions(ctx context.Context, roomTypeIDs []uint, startDate, endDate time.Time) ([]entity.RoomRestriction, error) {
+	db := hr.db.GetTx(ctx)
+
+	if len(roomTypeIDs) == 0 {
+		return nil, nil
+	}
+
+	var results []model.RoomRestriction
+	if err := db.WithContext(ctx).Model(&model.RoomRestriction{}).
+		Where("room_type_id IN ?", roomTypeIDs).
+		Where("date >= ? AND date < ?", startDate.Format(time.DateOnly), endDate.Format(time.DateOnly)).
+		Order("room_type_id ASC, date ASC").
+		Find(&results).Error; err != nil {
+		logger.Error(ctx, "Failed to fetch room restrictions", err.Error())
+		return nil, err
+	}
+
+	entities := make([]entity.RoomRestriction, 0, len(results))
+	for _, r := range results {
+		var restriction entity.RoomRestriction
+		if err := utils.CopyPatch(&restriction, &r); err != nil {
+			logger.Error(ctx, "Failed to copy room restriction model to entity", err.Error())
+			return nil, err
+		}
+		entities = append(entities, restriction)
+	}
+
+	return entities, nil
+}
@@ -11,7 +11,7 @@ import (
 func (hr *HotelRepository) GetRoomTypeByHotelID(ctx context.Context, hotelID uint) ([]entity.RoomType, error) {
 	db := hr.db.GetTx(ctx)
 	// Select default fields
-	selectFields := []string{"id", "name"}
+	selectFields := []string{"id", "name", "total_unit"}
 
 	// Initialize query with default fields
 	query := db.WithContext(ctx).Model(&model.RoomType{}).Select(selectFields)
@@ -0,0 +1,36 @@
+package hotel_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (hr *HotelRepository) InsertRoomRestrictions(ctx context.Context, restrictions []entity.RoomRestriction) error {
+	db := hr.db.GetTx(ctx)
+
+	if len(restrictions) == 0 {
+		return nil
+	}
+
+	roomRestrictions := make([]model.RoomRestriction, 0, len(restrictions))
+	for _, r := range restrictions {
+		roomRestrictions = append(roomRestrictions, model.RoomRestriction{
+			RoomTypeID:        r.RoomTypeID,
+			Date:              r.Date,
+			MinStay:           r.MinStay,
+			MaxStay:           r.MaxStay,
+			ClosedToArrival:   r.ClosedToArrival,
+			ClosedToDeparture: r.ClosedToDeparture,
+			ReleaseDays:       r.ReleaseDays,
+		})
+	}
+
+	if err := db.WithContext(ctx).Create(&roomRestrictions).Error; err != nil {
+		logger.Error(ctx, "Failed to insert room restrictions", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package hotel_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+
+	"gorm.io/gorm"
+)
+
+func (hr *HotelRepository) ReleaseRoomInventory(ctx context.Context, roomTypeID uint, checkIn, checkOut time.Time, quantity int) error {
+	db := hr.db.GetTx(ctx)
+
+	if quantity <= 0 || !checkOut.After(checkIn) {
+		return nil
+	}
+
+	if err := db.WithContext(ctx).Model(&model.RoomInventory{}).
+		Where("room_type_id = ?", roomTypeID).
+		Where("date >= ? AND date < ?", checkIn.Format(time.DateOnly), checkOut.Format(time.DateOnly)).
+		Updates(map[string]interface{}{
+			"booked_unit": gorm.Expr("GREATEST(booked_unit - ?, 0)", quantity),
+			"updated_at":  gorm.Expr("NOW()"),
+		}).Error; err != nil {
+		logger.Error(ctx, "Failed to release room inventory", err.Error())
+		return err
+	}
+
+	return nil
+}
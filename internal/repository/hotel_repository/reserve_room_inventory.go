@@ -0,0 +1,87 @@
+package hotel_repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+
+	"gorm.io/gorm"
+)
+
+func (hr *HotelRepository) ReserveRoomInventory(ctx context.Context, roomTypeID uint, checkIn, checkOut time.Time, quantity int) error {
+	db := hr.db.GetTx(ctx)
+
+	nights := int(checkOut.Sub(checkIn).Hours() / 24)
+	if nights <= 0 || quantity <= 0 {
+		return fmt.Errorf("invalid stay to reserve")
+	}
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := ensureRoomInventory(ctx, tx, []uint{roomTypeID}, checkIn, checkOut); err != nil {
+			return err
+		}
+
+		// The capacity check lives in the WHERE clause so concurrent checkouts cannot both take the last unit
+		res := tx.Model(&model.RoomInventory{}).
+			Where("room_type_id = ?", roomTypeID).
+			Where("date >= ? AND date < ?", checkIn.Format(time.DateOnly), checkOut.Format(time.DateOnly)).
+			Where("booked_unit + ? <= total_unit", quantity).
+			Updates(map[string]interface{}{
+				"booked_unit": gorm.Expr("booked_unit + ?", quantity),
+				"updated_at":  gorm.Expr("NOW()"),
+			})
+		if err := res.Error; err != nil {
+			logger.Error(ctx, "Failed to reserve room inventory", err.Error())
+			return err
+		}
+
+		if res.RowsAffected == int64(nights) {
+			return nil
+		}
+
+		var soldOut model.RoomInventory
+		if err := tx.Model(&model.RoomInventory{}).
+			Where("room_type_id = ?", roomTypeID).
+			Where("date >= ? AND date < ?", checkIn.Format(time.DateOnly), checkOut.Format(time.DateOnly)).
+			Where("booked_unit + ? > total_unit", quantity).
+			Order("date ASC").
+			First(&soldOut).Error; err != nil {
+			logger.Error(ctx, "Failed to find sold out night", err.Error())
+			return fmt.Errorf("not enough rooms available for the selected dates")
+		}
+
+		remaining := max(soldOut.TotalUnit-soldOut.BookedUnit, 0)
+		logger.Warn(ctx, "Room inventory exhausted", "roomTypeID", roomTypeID, "date", soldOut.Date.Format(time.DateOnly), "remaining", remaining)
+		return fmt.Errorf("not enough rooms available on %s: %d requested, %d left", soldOut.Date.Format(time.DateOnly), quantity, remaining)
+	})
+}
+
+// ensureRoomInventory creates the missing ledger rows for every night in [startDate, endDate).
+// New rows start from RoomType.TotalUnit and count the sub-bookings that already hold the night,
+// so bookings made before the ledger existed are not oversold.
+func ensureRoomInventory(ctx context.Context, db *gorm.DB, roomTypeIDs []uint, startDate, endDate time.Time) error {
+	query := `
+		INSERT INTO room_inventories (external_id, created_at, updated_at, room_type_id, date, total_unit, booked_unit)
+		SELECT gen_random_uuid()::text, NOW(), NOW(), rt.id, night::date, rt.total_unit, ` + bookedUnitsSQL + `
+		FROM room_types rt
+		CROSS JOIN generate_series(?::date, ?::date - INTERVAL '1 day', INTERVAL '1 day') AS night
+		WHERE rt.id IN ? AND rt.deleted_at IS NULL
+		ON CONFLICT (room_type_id, date) DO NOTHING
+	`
+
+	if err := db.WithContext(ctx).Exec(query,
+		constant.StatusBookingInCartID,
+		activeBookingStatuses,
+		startDate.Format(time.DateOnly),
+		endDate.Format(time.DateOnly),
+		roomTypeIDs,
+	).Error; err != nil {
+		logger.Error(ctx, "Failed to initialize room inventory", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package hotel_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (hr *HotelRepository) UpdateCancellationPolicy(ctx context.Context, policy *entity.CancellationPolicy) error {
+	db := hr.db.GetTx(ctx)
+
+	policyModel, err := toCancellationPolicyModel(policy)
+	if err != nil {
+		logger.Error(ctx, "Failed to convert cancellation policy tiers to JSON", err.Error())
+		return err
+	}
+
+	if err := db.WithContext(ctx).Model(&model.CancellationPolicy{}).
+		Where("id = ?", policy.ID).
+		Select("name", "description", "tiers").
+		Updates(policyModel).Error; err != nil {
+		logger.Error(ctx, "Failed to update cancellation policy", err.Error())
+		return err
+	}
+
+	return nil
+}
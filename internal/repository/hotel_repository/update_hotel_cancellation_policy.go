@@ -0,0 +1,21 @@
+package hotel_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+// UpdateHotelCancellationPolicy attaches a policy to the hotel, nil detaches it.
+func (hr *HotelRepository) UpdateHotelCancellationPolicy(ctx context.Context, hotelID uint, policyID *uint) error {
+	db := hr.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).Model(&model.Hotel{}).
+		Where("id = ?", hotelID).
+		Update("cancellation_policy_id", policyID).Error; err != nil {
+		logger.Error(ctx, "Failed to update hotel cancellation policy", err.Error())
+		return err
+	}
+
+	return nil
+}
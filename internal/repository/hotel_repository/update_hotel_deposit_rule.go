@@ -0,0 +1,23 @@
+package hotel_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (hr *HotelRepository) UpdateHotelDepositRule(ctx context.Context, hotelID uint, depositPercent float64, balanceDueDays int) error {
+	db := hr.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).Model(&model.Hotel{}).
+		Where("id = ?", hotelID).
+		Updates(map[string]interface{}{
+			"deposit_percent":  depositPercent,
+			"balance_due_days": balanceDueDays,
+		}).Error; err != nil {
+		logger.Error(ctx, "Failed to update hotel deposit rule", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package hotel_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+
+	"gorm.io/gorm"
+)
+
+func (hr *HotelRepository) UpdateRoomInventoryTotalUnit(ctx context.Context, roomTypeID uint, totalUnit int) (*entity.RoomInventory, error) {
+	db := hr.db.GetTx(ctx)
+	today := time.Now().Format(time.DateOnly)
+
+	// Past nights keep the allotment they were sold with. Nights already booked beyond the new total are left as
+	// they are, the update locks the rows so no checkout can book past it meanwhile.
+	if err := db.WithContext(ctx).Model(&model.RoomInventory{}).
+		Where("room_type_id = ?", roomTypeID).
+		Where("date >= ?", today).
+		Where("booked_unit <= ?", totalUnit).
+		Updates(map[string]interface{}{
+			"total_unit": totalUnit,
+			"updated_at": gorm.Expr("NOW()"),
+		}).Error; err != nil {
+		logger.Error(ctx, "Failed to update room inventory total unit", err.Error())
+		return nil, err
+	}
+
+	var overbooked model.RoomInventory
+	if err := db.WithContext(ctx).Model(&model.RoomInventory{}).
+		Where("room_type_id = ?", roomTypeID).
+		Where("date >= ?", today).
+		Where("booked_unit > ?", totalUnit).
+		Order("date ASC").
+		First(&overbooked).Error; err != nil {
+		if hr.db.ErrRecordNotFound(ctx, err) {
+			return nil, nil
+		}
+		logger.Error(ctx, "Failed to find overbooked room inventory", err.Error())
+		return nil, err
+	}
+
+	return &entity.RoomInventory{
+		RoomTypeID: overbooked.RoomTypeID,
+		Date:       overbooked.Date,
+		TotalUnit:  overbooked.TotalUnit,
+		BookedUnit: overbooked.BookedUnit,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package hotel_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/currency"
+	"wtm-backend/pkg/logger"
+
+	"github.com/lib/pq"
+)
+
+func (hr *HotelRepository) UpdateRoomRatePlan(ctx context.Context, ratePlan *entity.RoomRatePlan) error {
+	db := hr.db.GetTx(ctx)
+
+	ratePlanModel, err := toRoomRatePlanModel(ratePlan)
+	if err != nil {
+		logger.Error(ctx, "Failed to convert rate plan prices to JSON", err.Error())
+		return err
+	}
+
+	// Select all editable columns so zero values (inactive, priority 0, every day) are saved too
+	if err := db.WithContext(ctx).Model(&model.RoomRatePlan{}).
+		Where("id = ?", ratePlan.ID).
+		Select("room_price_id", "name", "start_date", "end_date", "days_of_week", "prices", "priority", "is_active", "cancellation_policy_id").
+		Updates(ratePlanModel).Error; err != nil {
+		logger.Error(ctx, "Failed to update room rate plan", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func toRoomRatePlanModel(ratePlan *entity.RoomRatePlan) (*model.RoomRatePlan, error) {
+	prices, err := currency.PricesToJSON(ratePlan.Prices)
+	if err != nil {
+		return nil, err
+	}
+
+	daysOfWeek := pq.Int64Array{}
+	for _, day := range ratePlan.DaysOfWeek {
+		daysOfWeek = append(daysOfWeek, int64(day))
+	}
+
+	return &model.RoomRatePlan{
+		RoomPriceID: ratePlan.RoomPriceID,
+		Name:        ratePlan.Name,
+		StartDate:   ratePlan.StartDate,
+		EndDate:     ratePlan.EndDate,
+		DaysOfWeek:  daysOfWeek,
+		Prices:      prices,
+		Priority:    ratePlan.Priority,
+		IsActive:    ratePlan.IsActive,
+
+		CancellationPolicyID: ratePlan.CancellationPolicyID,
+	}, nil
+}
@@ -0,0 +1,25 @@
+package notification_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+// ClaimDigest marks the digest of the user sent at now, when its previous digest is before scheduled.
+// Only one replica gets true for the same digest.
+func (nr *NotificationRepository) ClaimDigest(ctx context.Context, userID uint, scheduled, now time.Time) (bool, error) {
+	db := nr.db.GetTx(ctx)
+
+	result := db.WithContext(ctx).
+		Model(&model.UserNotificationPreference{}).
+		Where("user_id = ? AND (last_digest_at IS NULL OR last_digest_at < ?)", userID, scheduled).
+		Update("last_digest_at", now)
+	if result.Error != nil {
+		logger.Error(ctx, "Failed to claim notification digest", result.Error.Error())
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,22 @@
+package notification_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (nr *NotificationRepository) CountUnreadNotifications(ctx context.Context, userID uint) (int64, error) {
+	db := nr.db.GetTx(ctx)
+
+	var count int64
+	if err := db.WithContext(ctx).
+		Model(&model.Notification{}).
+		Where("user_id = ? AND is_read = ?", userID, false).
+		Count(&count).Error; err != nil {
+		logger.Error(ctx, "failed to count unread notifications", err.Error())
+		return 0, err
+	}
+
+	return count, nil
+}
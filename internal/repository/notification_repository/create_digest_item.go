@@ -0,0 +1,30 @@
+package notification_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (nr *NotificationRepository) CreateDigestItem(ctx context.Context, item *entity.NotificationDigestItem) error {
+	db := nr.db.GetTx(ctx)
+
+	modelItem := model.NotificationDigestItem{
+		UserID:      item.UserID,
+		Title:       item.Title,
+		Message:     item.Message,
+		RedirectURL: item.RedirectURL,
+		Type:        item.Type,
+		Web:         item.Web,
+		Email:       item.Email,
+	}
+	if err := db.WithContext(ctx).Create(&modelItem).Error; err != nil {
+		logger.Error(ctx, "Failed to create notification digest item", err.Error())
+		return err
+	}
+	item.ID = modelItem.ID
+	item.CreatedAt = modelItem.CreatedAt
+
+	return nil
+}
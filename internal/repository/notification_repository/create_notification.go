@@ -21,6 +21,7 @@ func (nr *NotificationRepository) CreateNotification(ctx context.Context, notifi
 		logger.Error(ctx, "Failed to create notification", err.Error())
 		return err
 	}
+	notification.ID = notificationModel.ID
 
 	return nil
 }
@@ -0,0 +1,26 @@
+package notification_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+func (nr *NotificationRepository) GetDigestPreferences(ctx context.Context) ([]entity.UserNotificationPreference, error) {
+	db := nr.db.GetTx(ctx)
+
+	var preferences []model.UserNotificationPreference
+	if err := db.WithContext(ctx).Where("digest_mode = ?", constant.DigestModeDaily).Find(&preferences).Error; err != nil {
+		logger.Error(ctx, "Failed to get digest preferences", err.Error())
+		return nil, err
+	}
+
+	result := make([]entity.UserNotificationPreference, 0, len(preferences))
+	for _, preference := range preferences {
+		result = append(result, *toEntityNotificationPreference(preference))
+	}
+
+	return result, nil
+}
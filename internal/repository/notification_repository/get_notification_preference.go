@@ -0,0 +1,36 @@
+package notification_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (nr *NotificationRepository) GetNotificationPreference(ctx context.Context, userID uint) (*entity.UserNotificationPreference, error) {
+	db := nr.db.GetTx(ctx)
+
+	var preference model.UserNotificationPreference
+	if err := db.WithContext(ctx).Where("user_id = ?", userID).First(&preference).Error; err != nil {
+		if nr.db.ErrRecordNotFound(ctx, err) {
+			return nil, nil
+		}
+		logger.Error(ctx, "Failed to get notification preference", err.Error())
+		return nil, err
+	}
+
+	return toEntityNotificationPreference(preference), nil
+}
+
+func toEntityNotificationPreference(preference model.UserNotificationPreference) *entity.UserNotificationPreference {
+	return &entity.UserNotificationPreference{
+		UserID:            preference.UserID,
+		DigestMode:        preference.DigestMode,
+		DigestHour:        preference.DigestHour,
+		LastDigestAt:      preference.LastDigestAt,
+		QuietHoursEnabled: preference.QuietHoursEnabled,
+		QuietHoursStart:   preference.QuietHoursStart,
+		QuietHoursEnd:     preference.QuietHoursEnd,
+		Timezone:          preference.Timezone,
+	}
+}
@@ -0,0 +1,38 @@
+package notification_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (nr *NotificationRepository) GetPendingDigestItems(ctx context.Context, userID uint) ([]entity.NotificationDigestItem, error) {
+	db := nr.db.GetTx(ctx)
+
+	var items []model.NotificationDigestItem
+	if err := db.WithContext(ctx).
+		Where("user_id = ? AND digested_at IS NULL", userID).
+		Order("created_at ASC").
+		Find(&items).Error; err != nil {
+		logger.Error(ctx, "Failed to get pending digest items", err.Error())
+		return nil, err
+	}
+
+	result := make([]entity.NotificationDigestItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, entity.NotificationDigestItem{
+			ID:          item.ID,
+			UserID:      item.UserID,
+			Title:       item.Title,
+			Message:     item.Message,
+			RedirectURL: item.RedirectURL,
+			Type:        item.Type,
+			Web:         item.Web,
+			Email:       item.Email,
+			CreatedAt:   item.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
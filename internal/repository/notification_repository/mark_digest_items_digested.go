@@ -0,0 +1,25 @@
+package notification_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (nr *NotificationRepository) MarkDigestItemsDigested(ctx context.Context, ids []uint, digestedAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	db := nr.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).
+		Model(&model.NotificationDigestItem{}).
+		Where("id IN ?", ids).
+		Update("digested_at", digestedAt).Error; err != nil {
+		logger.Error(ctx, "Failed to mark digest items digested", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -1,13 +1,18 @@
 package notification_repository
 
-import "wtm-backend/internal/infrastructure/database"
+import (
+	"wtm-backend/internal/infrastructure/cache"
+	"wtm-backend/internal/infrastructure/database"
+)
 
 type NotificationRepository struct {
-	db *database.DBPostgre
+	db    *database.DBPostgre
+	redis *cache.RedisClient
 }
 
-func NewNotificationRepository(db *database.DBPostgre) *NotificationRepository {
+func NewNotificationRepository(db *database.DBPostgre, redis *cache.RedisClient) *NotificationRepository {
 	return &NotificationRepository{
-		db: db,
+		db:    db,
+		redis: redis,
 	}
 }
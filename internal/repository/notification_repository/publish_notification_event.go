@@ -0,0 +1,37 @@
+package notification_repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+// PublishNotificationEvent publishes the unread count of the user, with the notification when one was created,
+// to every replica through Redis
+func (nr *NotificationRepository) PublishNotificationEvent(ctx context.Context, userID uint, notification *entity.Notification) error {
+	unreadCount, err := nr.CountUnreadNotifications(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	event := entity.NotificationEvent{
+		UserID:       userID,
+		Type:         constant.NotificationEventUnreadCount,
+		Notification: notification,
+		UnreadCount:  unreadCount,
+	}
+	if notification != nil {
+		event.Type = constant.NotificationEventCreated
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal notification event", err.Error())
+		return err
+	}
+
+	return nr.redis.Publish(ctx, fmt.Sprintf("%s%d", constant.NotificationChannelPrefix, userID), payload)
+}
@@ -0,0 +1,51 @@
+package notification_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (nr *NotificationRepository) UpsertNotificationPreference(ctx context.Context, preference *entity.UserNotificationPreference) error {
+	db := nr.db.GetTx(ctx)
+
+	values := map[string]interface{}{
+		"digest_mode":         preference.DigestMode,
+		"digest_hour":         preference.DigestHour,
+		"last_digest_at":      preference.LastDigestAt,
+		"quiet_hours_enabled": preference.QuietHoursEnabled,
+		"quiet_hours_start":   preference.QuietHoursStart,
+		"quiet_hours_end":     preference.QuietHoursEnd,
+		"timezone":            preference.Timezone,
+	}
+
+	result := db.WithContext(ctx).
+		Model(&model.UserNotificationPreference{}).
+		Where("user_id = ?", preference.UserID).
+		Updates(values)
+	if result.Error != nil {
+		logger.Error(ctx, "Failed to update notification preference", result.Error.Error())
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	modelPreference := model.UserNotificationPreference{
+		UserID:            preference.UserID,
+		DigestMode:        preference.DigestMode,
+		DigestHour:        preference.DigestHour,
+		LastDigestAt:      preference.LastDigestAt,
+		QuietHoursEnabled: preference.QuietHoursEnabled,
+		QuietHoursStart:   preference.QuietHoursStart,
+		QuietHoursEnd:     preference.QuietHoursEnd,
+		Timezone:          preference.Timezone,
+	}
+	if err := db.WithContext(ctx).Create(&modelPreference).Error; err != nil {
+		logger.Error(ctx, "Failed to create notification preference", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package notification_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (nr *NotificationRepository) UpsertNotificationSettings(ctx context.Context, userID uint, settings []entity.UserNotificationSetting) error {
+	db := nr.db.GetTx(ctx)
+
+	for _, setting := range settings {
+		result := db.WithContext(ctx).
+			Model(&model.UserNotificationSetting{}).
+			Where("user_id = ? AND channel = ? AND type = ?", userID, setting.Channel, setting.Type).
+			Update("is_enabled", setting.IsEnabled)
+		if result.Error != nil {
+			logger.Error(ctx, "Failed to update notification setting", result.Error.Error())
+			return result.Error
+		}
+		if result.RowsAffected > 0 {
+			continue
+		}
+
+		modelSetting := model.UserNotificationSetting{
+			UserID:    userID,
+			Channel:   setting.Channel,
+			Type:      setting.Type,
+			IsEnabled: setting.IsEnabled,
+		}
+		if err := db.WithContext(ctx).Create(&modelSetting).Error; err != nil {
+			logger.Error(ctx, "Failed to create notification setting", err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package promo_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+// GetPromoAgentIDs returns the active agents in the promo groups of the promo
+func (pr *PromoRepository) GetPromoAgentIDs(ctx context.Context, promoID uint) ([]uint, error) {
+	db := pr.db.GetTx(ctx)
+
+	var agentIDs []uint
+	err := db.WithContext(ctx).
+		Model(&model.User{}).
+		Joins("JOIN detail_promo_groups ON detail_promo_groups.promo_group_id = users.promo_group_id").
+		Where("detail_promo_groups.promo_id = ?", promoID).
+		Where("users.role_id = ? AND users.status_id = ?", constant.RoleAgentID, constant.StatusUserActiveID).
+		Distinct().
+		Pluck("users.id", &agentIDs).Error
+	if err != nil {
+		logger.Error(ctx, "Error getting agents of promo", err.Error())
+		return nil, err
+	}
+
+	return agentIDs, nil
+}
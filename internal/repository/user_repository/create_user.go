@@ -19,15 +19,12 @@ func (ur *UserRepository) CreateUser(ctx context.Context, user *entity.User) (*e
 		return nil, err
 	}
 
-	// Cek apakah role-nya agent
-	if modelUser.RoleID == constant.RoleAgentID { // misalnya agentRoleID := 2
-		defaultSettings := []model.UserNotificationSetting{
-			{Channel: "email", Type: "booking", IsEnabled: true},
-			{Channel: "email", Type: "reject", IsEnabled: true},
-			{Channel: "web", Type: "booking", IsEnabled: true},
-			{Channel: "web", Type: "reject", IsEnabled: true},
-		}
-		modelUser.UserNotificationSettings = defaultSettings
+	// Agent menerima notifikasi event agent, role lain menerima event admin sesuai permission role-nya
+	for _, typeNotif := range constant.NotificationTypesOf(modelUser.RoleID) {
+		modelUser.UserNotificationSettings = append(modelUser.UserNotificationSettings,
+			model.UserNotificationSetting{Channel: constant.ConstEmail, Type: typeNotif, IsEnabled: true},
+			model.UserNotificationSetting{Channel: constant.ConstWeb, Type: typeNotif, IsEnabled: true},
+		)
 	}
 
 	err := db.WithContext(ctx).Create(&modelUser).Error
@@ -0,0 +1,29 @@
+package user_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+func (ur *UserRepository) GetRolesByIDs(ctx context.Context, roleIDs []uint) ([]entity.Role, error) {
+	db := ur.db.GetTx(ctx)
+
+	var roles []model.Role
+	if err := db.WithContext(ctx).
+		Where("id IN ?", roleIDs).
+		Order("id ASC").
+		Find(&roles).Error; err != nil {
+		logger.Error(ctx, "Error retrieving roles", err.Error())
+		return nil, err
+	}
+
+	var entityRoles []entity.Role
+	if err := utils.CopyPatch(&entityRoles, &roles); err != nil {
+		logger.Error(ctx, "Error copying roles model to entity", err.Error())
+		return nil, err
+	}
+	return entityRoles, nil
+}
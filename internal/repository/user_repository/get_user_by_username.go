@@ -40,6 +40,7 @@ func (ur *UserRepository) GetUserByUsername(ctx context.Context, username string
 
 	if user.Role != nil {
 		entityUser.RoleName = user.Role.Role
+		entityUser.TwoFactorRequired = user.Role.TwoFactorRequired
 		if user.StatusID == constant.StatusUserActiveID {
 			for _, permission := range user.Role.Permissions {
 				entityUser.Permissions = append(entityUser.Permissions, permission.Permission)
@@ -0,0 +1,46 @@
+package user_repository
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+// GetUsersByPermission returns the active users whose role holds the permission, super admins hold every permission
+func (ur *UserRepository) GetUsersByPermission(ctx context.Context, permission string) ([]entity.User, error) {
+	db := ur.db.GetTx(ctx)
+
+	rolesWithPermission := db.WithContext(ctx).
+		Model(&model.RolePermission{}).
+		Select("role_permissions.role_id").
+		Joins("JOIN permissions ON permissions.id = role_permissions.permission_id AND permissions.deleted_at IS NULL").
+		Where("permissions.permission = ?", permission)
+
+	var users []model.User
+	err := db.WithContext(ctx).
+		Model(&model.User{}).
+		Preload("UserNotificationSettings").
+		Select("id, full_name, email, language, role_id, status_id").
+		Where("status_id = ?", constant.StatusUserActiveID).
+		Where("role_id = ? OR role_id IN (?)", constant.RoleSuperAdminID, rolesWithPermission).
+		Find(&users).Error
+	if err != nil {
+		logger.Error(ctx, "Error to get users by permission", permission, err.Error())
+		return nil, err
+	}
+
+	entityUsers := make([]entity.User, 0, len(users))
+	for _, user := range users {
+		var entityUser entity.User
+		if err := utils.CopyPatch(&entityUser, user); err != nil {
+			logger.Error(ctx, "Error copying user model to entity", err.Error())
+			return nil, err
+		}
+		entityUsers = append(entityUsers, entityUser)
+	}
+
+	return entityUsers, nil
+}
@@ -0,0 +1,26 @@
+package user_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (ur *UserRepository) IncrementFailedLogin(ctx context.Context, userID uint) (int, error) {
+	db := ur.db.GetTx(ctx)
+
+	var user model.User
+	if err := db.WithContext(ctx).
+		Model(&user).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "failed_login_attempts"}}}).
+		Where("id = ?", userID).
+		Update("failed_login_attempts", gorm.Expr("failed_login_attempts + 1")).Error; err != nil {
+		logger.Error(ctx, "Error incrementing failed login attempts", err.Error())
+		return 0, err
+	}
+
+	return user.FailedLoginAttempts, nil
+}
@@ -0,0 +1,29 @@
+package user_repository
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+
+	"gorm.io/gorm"
+)
+
+// LockUser refuses the logins of the user until the given time and starts counting wrong passwords again
+func (ur *UserRepository) LockUser(ctx context.Context, userID uint, until time.Time) error {
+	db := ur.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).
+		Model(&model.User{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"locked_until":          until,
+			"failed_login_attempts": 0,
+			"lockout_count":         gorm.Expr("lockout_count + 1"),
+		}).Error; err != nil {
+		logger.Error(ctx, "Error locking user", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package user_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+// ResetFailedLogins unlocks the user and forgets its wrong passwords and previous lockouts
+func (ur *UserRepository) ResetFailedLogins(ctx context.Context, userID uint) error {
+	db := ur.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).
+		Model(&model.User{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"locked_until":          nil,
+			"failed_login_attempts": 0,
+			"lockout_count":         0,
+		}).Error; err != nil {
+		logger.Error(ctx, "Error resetting failed logins of user", err.Error())
+		return err
+	}
+
+	return nil
+}
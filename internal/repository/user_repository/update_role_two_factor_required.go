@@ -0,0 +1,21 @@
+package user_repository
+
+import (
+	"context"
+	"wtm-backend/internal/infrastructure/database/model"
+	"wtm-backend/pkg/logger"
+)
+
+func (ur *UserRepository) UpdateRoleTwoFactorRequired(ctx context.Context, roleID uint, required bool) error {
+	db := ur.db.GetTx(ctx)
+
+	if err := db.WithContext(ctx).
+		Model(&model.Role{}).
+		Where("id = ?", roleID).
+		Update("two_factor_required", required).Error; err != nil {
+		logger.Error(ctx, "Error updating two-factor policy of role", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ func (ur *UserRepository) UpdateUser(ctx context.Context, user *entity.User) (*e
 		"phone":            modelUser.Phone,
 		"kakao_talk_id":    modelUser.KakaoTalkID,
 		"currency":         modelUser.Currency,
+		"language":         modelUser.Language,
 		"agent_company_id": modelUser.AgentCompanyID,
 		"certificate":      modelUser.Certificate,
 		"photo_selfie":     modelUser.PhotoSelfie,
@@ -1,8 +1,34 @@
 package auth_usecase
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
 	"wtm-backend/config"
 	"wtm-backend/internal/domain"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/jwt"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/totp"
+	"wtm-backend/pkg/utils"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// maxDeviceLength is the length of the device name stored with a session
+const maxDeviceLength = 100
+
+var (
+	errInvalidRefreshToken = errors.New("invalid refresh token")
+	errRefreshTokenReused  = errors.New("refresh token reused")
+	errUserNotActive       = errors.New("user is not active")
+	errInvalidTwoFactor    = errors.New("invalid two-factor code")
+
+	errTooManyTwoFactorAttempts = validation.Errors{"challenge_token": validation.NewInternalError(errors.New("too many invalid codes, please log in again"))}
 )
 
 type AuthUsecase struct {
@@ -37,3 +63,207 @@ func NewAuthUsecase(
 		dbTrx:       dbTrx,
 	}
 }
+
+// resolveProfilePhoto replaces the storage key of the profile photo of the user with its URL
+func (au *AuthUsecase) resolveProfilePhoto(ctx context.Context, user *entity.User) error {
+	if user.PhotoSelfie == "" {
+		return nil
+	}
+
+	bucketName := fmt.Sprintf("%s-%s", constant.ConstUser, constant.ConstPublic)
+	photoProfile, err := au.fileStorage.GetFile(ctx, bucketName, user.PhotoSelfie)
+	if err != nil {
+		logger.Error(ctx, "Error getting user profile photo", err.Error())
+		return fmt.Errorf("failed to get user profile photo: %s", err.Error())
+	}
+
+	user.PhotoSelfie = photoProfile
+	return nil
+}
+
+// issueTokens signs an access token and the next refresh token of the session for the user. The refresh token is
+// stored hashed and expires with the session.
+func (au *AuthUsecase) issueTokens(ctx context.Context, user *entity.User, session entity.UserSession) (*authdto.LoginResponse, string, error) {
+	token, err := jwt.GenerateAccessToken(user, session.SessionID, au.config.JWTSecret, au.config.DurationAccessToken)
+	if err != nil {
+		logger.Error(ctx, "Error generating access token", err.Error())
+		return nil, "", err
+	}
+
+	refreshToken, err := jwt.GenerateRefreshToken(user, session.SessionID, au.config.RefreshSecret, time.Until(session.ExpiresAt))
+	if err != nil {
+		logger.Error(ctx, "Error generating refresh token", err.Error())
+		return nil, "", err
+	}
+
+	storedToken := entity.RefreshToken{
+		UserSessionID: session.ID,
+		TokenHash:     utils.HashToken(refreshToken),
+		ExpiresAt:     session.ExpiresAt,
+	}
+	if err := au.authRepo.CreateRefreshToken(ctx, &storedToken); err != nil {
+		logger.Error(ctx, "Error storing refresh token", err.Error())
+		return nil, "", errors.New("failed to store refresh token")
+	}
+
+	resp := &authdto.LoginResponse{
+		Token: token,
+		User: &authdto.DataUser{
+			RoleID:      user.RoleID,
+			Role:        user.RoleName,
+			Permissions: user.Permissions,
+			PhotoURL:    user.PhotoSelfie,
+			FullName:    user.FullName,
+		},
+	}
+
+	return resp, refreshToken, nil
+}
+
+// startSession creates a session for the client of a login and issues its first tokens, within the transaction of ctx
+func (au *AuthUsecase) startSession(ctx context.Context, user *entity.User, device, ipAddress, userAgent string) (*authdto.LoginResponse, string, error) {
+	// Setiap login menjadi sesi sendiri, login di perangkat lain tidak mengakhiri sesi ini
+	now := time.Now()
+	if device == "" {
+		device = utils.DeviceFromUserAgent(userAgent)
+	}
+	if runes := []rune(device); len(runes) > maxDeviceLength {
+		device = string(runes[:maxDeviceLength])
+	}
+	session := entity.UserSession{
+		UserID:     user.ID,
+		Device:     device,
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(au.config.DurationRefreshToken),
+	}
+
+	if err := au.authRepo.CreateSession(ctx, &session); err != nil {
+		logger.Error(ctx, "Error creating session", err.Error())
+		return nil, "", errors.New("failed to create session")
+	}
+
+	return au.issueTokens(ctx, user, session)
+}
+
+// newTwoFactorSecret replaces the authenticator of the user with a pending one and returns what an authenticator
+// app needs to generate its codes
+func (au *AuthUsecase) newTwoFactorSecret(ctx context.Context, user *entity.User) (*authdto.TwoFactorSetupResponse, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		logger.Error(ctx, "Error generating two-factor secret", err.Error())
+		return nil, err
+	}
+
+	encrypted, err := utils.EncryptString(secret, au.config.TwoFactorSecretKey)
+	if err != nil {
+		logger.Error(ctx, "Error encrypting two-factor secret", err.Error())
+		return nil, err
+	}
+
+	if err := au.authRepo.SaveTwoFactorSecret(ctx, user.ID, encrypted); err != nil {
+		return nil, errors.New("failed to save two-factor secret")
+	}
+
+	return &authdto.TwoFactorSetupResponse{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(au.config.TwoFactorIssuer, user.Username, secret),
+	}, nil
+}
+
+// checkTwoFactorCode returns the period of the code when it was generated by the authenticator, without using it
+func (au *AuthUsecase) checkTwoFactorCode(ctx context.Context, twoFactor *entity.UserTwoFactor, code string) (int64, bool, error) {
+	secret, err := utils.DecryptString(twoFactor.Secret, au.config.TwoFactorSecretKey)
+	if err != nil {
+		logger.Error(ctx, "Error decrypting two-factor secret", err.Error())
+		return 0, false, err
+	}
+
+	step, ok := totp.Verify(secret, code, time.Now())
+	return step, ok, nil
+}
+
+// useTwoFactorCode accepts a code of the enabled authenticator, each code only once
+func (au *AuthUsecase) useTwoFactorCode(ctx context.Context, twoFactor *entity.UserTwoFactor, code string) (bool, error) {
+	step, ok, err := au.checkTwoFactorCode(ctx, twoFactor, code)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	return au.authRepo.UseTwoFactorStep(ctx, twoFactor.UserID, step)
+}
+
+// newRecoveryCodes replaces the recovery codes of the user, the plain codes are only returned here
+func (au *AuthUsecase) newRecoveryCodes(ctx context.Context, userID uint) ([]string, error) {
+	codes, err := totp.GenerateRecoveryCodes(au.config.TwoFactorRecoveryCodes)
+	if err != nil {
+		logger.Error(ctx, "Error generating recovery codes", err.Error())
+		return nil, err
+	}
+
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	if err := au.authRepo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, errors.New("failed to save recovery codes")
+	}
+
+	return codes, nil
+}
+
+func hashRecoveryCode(code string) string {
+	return utils.HashToken(totp.NormalizeRecoveryCode(code))
+}
+
+// apiKeyScopesOf returns the scopes the user can grant to an API key: the current permissions of the role with the
+// implicit ones, every permission for a superadmin
+func (au *AuthUsecase) apiKeyScopesOf(ctx context.Context, userID uint) ([]string, error) {
+	user, err := au.userRepo.GetUserWithPermissionsByID(ctx, userID)
+	if err != nil {
+		logger.Error(ctx, "Error getting user", err.Error())
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	var scopes []string
+	if user.RoleID == constant.RoleSuperAdminID {
+		permissions, err := au.userRepo.GetAllPermissions(ctx)
+		if err != nil {
+			logger.Error(ctx, "Error getting permissions", err.Error())
+			return nil, err
+		}
+		for _, permission := range permissions {
+			scopes = append(scopes, permission.Permission)
+		}
+	} else {
+		scopes = append(scopes, user.Permissions...)
+		scopes = append(scopes, constant.RoleImplicitPermissions[user.RoleID]...)
+	}
+
+	slices.Sort(scopes)
+	return slices.Compact(scopes), nil
+}
+
+func toAPIKeyResponse(apiKey entity.APIKey) authdto.APIKeyResponse {
+	resp := authdto.APIKeyResponse{
+		ID:         apiKey.KeyID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Scopes:     apiKey.Scopes,
+		RateLimit:  apiKey.RateLimit,
+		CreatedAt:  apiKey.CreatedAt.Format(time.RFC3339),
+		LastUsedIP: apiKey.LastUsedIP,
+	}
+	if apiKey.LastUsedAt != nil {
+		resp.LastUsedAt = apiKey.LastUsedAt.Format(time.RFC3339)
+	}
+	if apiKey.ExpiresAt != nil {
+		resp.ExpiresAt = apiKey.ExpiresAt.Format(time.RFC3339)
+	}
+	return resp
+}
@@ -0,0 +1,93 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// apiKeyPrefixLength is how much of a key is kept in plain text to recognize it
+const apiKeyPrefixLength = len(constant.APIKeyPrefix) + 8
+
+// CreateAPIKey creates a personal API key of the logged in user, the key itself is only returned here
+func (au *AuthUsecase) CreateAPIKey(ctx context.Context, req *authdto.CreateAPIKeyRequest) (*authdto.CreateAPIKeyResponse, error) {
+	dataUser, err := au.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error to get user from context", err.Error())
+		return nil, errors.New("failed to get user from context")
+	}
+
+	availableScopes, err := au.apiKeyScopesOf(ctx, dataUser.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var scopes []string
+	for _, scope := range req.Scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if !slices.Contains(availableScopes, scope) {
+			return nil, validation.Errors{"scopes": validation.NewInternalError(fmt.Errorf("scope %q is not available, must be one of: %s", scope, strings.Join(availableScopes, ", ")))}
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	rateLimit := req.RateLimit
+	if rateLimit == 0 {
+		rateLimit = au.config.APIKeyDefaultRateLimit
+	}
+	if rateLimit > au.config.APIKeyMaxRateLimit {
+		return nil, validation.Errors{"rate_limit": validation.NewInternalError(fmt.Errorf("rate limit must be at most %d requests per minute", au.config.APIKeyMaxRateLimit))}
+	}
+
+	activeKeys, err := au.authRepo.GetActiveAPIKeysByUserID(ctx, dataUser.ID)
+	if err != nil {
+		logger.Error(ctx, "Error to get api keys", err.Error())
+		return nil, err
+	}
+	if len(activeKeys) >= au.config.APIKeyMaxPerUser {
+		return nil, validation.Errors{"api_keys": validation.NewInternalError(fmt.Errorf("at most %d api keys can be active, revoke one first", au.config.APIKeyMaxPerUser))}
+	}
+
+	token, err := utils.GenerateSecureToken()
+	if err != nil {
+		logger.Error(ctx, "Error generating api key", err.Error())
+		return nil, errors.New("failed to generate api key")
+	}
+	key := constant.APIKeyPrefix + token
+
+	apiKey := entity.APIKey{
+		UserID:    dataUser.ID,
+		Name:      strings.TrimSpace(req.Name),
+		Prefix:    key[:apiKeyPrefixLength],
+		KeyHash:   utils.HashToken(key),
+		Scopes:    scopes,
+		RateLimit: rateLimit,
+	}
+	if req.ExpiresInDays > 0 {
+		expiresAt := time.Now().AddDate(0, 0, req.ExpiresInDays)
+		apiKey.ExpiresAt = &expiresAt
+	}
+
+	if err := au.authRepo.CreateAPIKey(ctx, &apiKey); err != nil {
+		return nil, errors.New("failed to create api key")
+	}
+
+	logger.Info(ctx, fmt.Sprintf("User %d created api key %s with scopes %s", dataUser.ID, apiKey.KeyID, strings.Join(scopes, ",")))
+
+	return &authdto.CreateAPIKeyResponse{
+		APIKeyResponse: toAPIKeyResponse(apiKey),
+		Key:            key,
+	}, nil
+}
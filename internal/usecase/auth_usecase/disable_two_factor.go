@@ -0,0 +1,59 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// DisableTwoFactor removes the authenticator of the logged in user, unless the role of the user requires it
+func (au *AuthUsecase) DisableTwoFactor(ctx context.Context, req *authdto.DisableTwoFactorRequest) error {
+	dataUser, err := au.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error to get user from context", err.Error())
+		return errors.New("failed to get user from context")
+	}
+
+	user, err := au.userRepo.GetUserWithPermissionsByID(ctx, dataUser.ID)
+	if err != nil {
+		logger.Error(ctx, "Error getting user", err.Error())
+		return err
+	}
+	if user == nil {
+		return errors.New("user not found")
+	}
+
+	if user.TwoFactorRequired {
+		return validation.Errors{"two_factor": validation.NewInternalError(errors.New("two-factor is required for your role"))}
+	}
+
+	if !utils.ComparePassword(ctx, user.Password, req.Password) {
+		return validation.Errors{"password": validation.NewInternalError(errors.New("invalid password"))}
+	}
+
+	twoFactor, err := au.authRepo.GetTwoFactor(ctx, user.ID)
+	if err != nil {
+		return errors.New("failed to get two-factor")
+	}
+	if twoFactor == nil || twoFactor.EnabledAt == nil {
+		return validation.Errors{"two_factor": validation.NewInternalError(errors.New("two-factor is not enabled"))}
+	}
+
+	used, err := au.useTwoFactorCode(ctx, twoFactor, req.Code)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return validation.Errors{"code": validation.NewInternalError(errors.New("invalid code"))}
+	}
+
+	if err := au.authRepo.DeleteTwoFactor(ctx, user.ID); err != nil {
+		return errors.New("failed to disable two-factor")
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/pkg/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+func (au *AuthUsecase) ForceLogout(ctx context.Context, req *authdto.ForceLogoutRequest) error {
+	dataUser, err := au.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error to get user from context", err.Error())
+		return errors.New("failed to get user from context")
+	}
+
+	if req.UserID == dataUser.ID {
+		return validation.Errors{"user_id": validation.NewInternalError(errors.New("use logout to end your own sessions"))}
+	}
+
+	user, err := au.userRepo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		logger.Error(ctx, "Error to get user by ID", err.Error())
+		return err
+	}
+	if user == nil {
+		return validation.Errors{"user_id": validation.NewInternalError(errors.New("user not found"))}
+	}
+
+	revoked, err := au.authRepo.RevokeSessionsByUserID(ctx, user.ID, "")
+	if err != nil {
+		logger.Error(ctx, "Error to revoke sessions", err.Error())
+		return err
+	}
+
+	logger.Info(ctx, fmt.Sprintf("User %d forced %d sessions of user %d to log out", dataUser.ID, revoked, user.ID))
+	return nil
+}
@@ -48,9 +48,16 @@ func (au *AuthUsecase) ForgotPassword(ctx context.Context, request *dtoauth.Forg
 		return nil, err
 	}
 	hashed := utils.HashToken(token)
-	// Store the hashed token in the database
-	if err := au.authRepo.CreatePasswordResetToken(ctx, user.ID, hashed, durationExpiration); err != nil {
-		logger.Error(ctx, "Error creating password reset token:", err.Error())
+	// Store the hashed token and queue the password reset email together
+	err = au.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := au.authRepo.CreatePasswordResetToken(txCtx, user.ID, hashed, durationExpiration); err != nil {
+			logger.Error(ctx, "Error creating password reset token:", err.Error())
+			return err
+		}
+
+		return au.queueEmailNotification(txCtx, user.FullName, user.Email, user.Language, token, user.RoleID, durationExpiration)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -60,28 +67,21 @@ func (au *AuthUsecase) ForgotPassword(ctx context.Context, request *dtoauth.Forg
 		return nil, err
 	}
 
-	// Send the password reset email
-	go func() {
-		newCtx, cancel := context.WithTimeout(context.Background(), au.config.DurationCtxTOSlow)
-		defer cancel()
-		au.sendEmailNotification(newCtx, user.FullName, user.Email, token, user.RoleID, au.config.DurationLinkExpiration)
-	}()
-
 	return nil, nil
 }
 
-func (au *AuthUsecase) sendEmailNotification(ctx context.Context, name, email, token string, roleID uint, expiry time.Duration) {
+func (au *AuthUsecase) queueEmailNotification(ctx context.Context, name, email, locale, token string, roleID uint, expiry time.Duration) error {
 	var statusEmail = constant.EmailForgotPassword
 
-	emailTemplate, err := au.emailRepo.GetEmailTemplateByName(ctx, statusEmail)
+	emailTemplate, err := au.emailRepo.GetEmailTemplateByName(ctx, statusEmail, locale)
 	if err != nil {
 		logger.Error(ctx, "Error getting email template by name:", err.Error())
-		return
+		return nil
 	}
 
 	if emailTemplate == nil {
 		logger.Error(ctx, "Email template not found for status:", statusEmail)
-		return
+		return nil
 	}
 
 	var url string
@@ -105,11 +105,9 @@ func (au *AuthUsecase) sendEmailNotification(ctx context.Context, name, email, t
 	bodyHTML, err := utils.ParseTemplate(emailTemplate.Body, data)
 	if err != nil {
 		logger.Error(ctx, "Error parsing body HTML:", err.Error())
-		return
+		return nil
 	}
 
-	bodyText := "Please view this email in HTML format." // Optional fallback
-
 	emailTo := email
 
 	emailLog := entity.EmailLog{
@@ -117,33 +115,17 @@ func (au *AuthUsecase) sendEmailNotification(ctx context.Context, name, email, t
 		Subject:         subjectParsed,
 		Body:            bodyHTML,
 		EmailTemplateID: uint(emailTemplate.ID),
+		TemplateName:    emailTemplate.Name,
+		Scope:           string(constant.ScopeAgent),
 	}
 	metadataLog := entity.MetadataEmailLog{AgentName: name}
 	emailLog.Meta = &metadataLog
-	var dataEmail bool
-	statusEmailID := constant.StatusEmailSuccessID
-	if err = au.emailRepo.CreateEmailLog(ctx, &emailLog); err != nil {
-		logger.Error(ctx, "Failed to create email log:", err)
-		dataEmail = false
-	} else {
-		dataEmail = true
-	}
-
-	err = au.emailSender.Send(ctx, constant.ScopeAgent, emailTo, subjectParsed, bodyHTML, bodyText)
-	if err != nil {
-		logger.Error(ctx, "Error sending email:", err.Error())
-		statusEmailID = constant.StatusEmailFailedID
-		metadataLog.Notes = fmt.Sprintf("Error sending email: %s", err.Error())
-		emailLog.Meta = &metadataLog
-	}
 
-	if dataEmail {
-		emailLog.StatusID = uint(statusEmailID)
-		if err := au.emailRepo.UpdateStatusEmailLog(ctx, &emailLog); err != nil {
-			logger.Error(ctx, "Failed to update email log:", err.Error())
-		}
+	if err = au.emailRepo.QueueEmail(ctx, &emailLog, time.Time{}); err != nil {
+		logger.Error(ctx, "Failed to queue email:", err.Error())
+		return err
 	}
-
+	return nil
 }
 
 type EmailData struct {
@@ -0,0 +1,43 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/pkg/logger"
+)
+
+func (au *AuthUsecase) GetTwoFactorStatus(ctx context.Context) (*authdto.TwoFactorStatusResponse, error) {
+	dataUser, err := au.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error to get user from context", err.Error())
+		return nil, errors.New("failed to get user from context")
+	}
+
+	// Kebijakan role dibaca dari database, token bisa saja dibuat sebelum kebijakan berubah
+	user, err := au.userRepo.GetUserWithPermissionsByID(ctx, dataUser.ID)
+	if err != nil {
+		logger.Error(ctx, "Error getting user", err.Error())
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	twoFactor, err := au.authRepo.GetTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, errors.New("failed to get two-factor")
+	}
+
+	resp := &authdto.TwoFactorStatusResponse{
+		Enabled:  twoFactor != nil && twoFactor.EnabledAt != nil,
+		Required: user.TwoFactorRequired,
+	}
+	if resp.Enabled {
+		if resp.RecoveryCodesRemaining, err = au.authRepo.CountRecoveryCodes(ctx, user.ID); err != nil {
+			return nil, errors.New("failed to count recovery codes")
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,37 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/pkg/logger"
+)
+
+func (au *AuthUsecase) ListAPIKeys(ctx context.Context) (*authdto.ListAPIKeysResponse, error) {
+	dataUser, err := au.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error to get user from context", err.Error())
+		return nil, errors.New("failed to get user from context")
+	}
+
+	apiKeys, err := au.authRepo.GetActiveAPIKeysByUserID(ctx, dataUser.ID)
+	if err != nil {
+		logger.Error(ctx, "Error to get api keys", err.Error())
+		return nil, err
+	}
+
+	scopes, err := au.apiKeyScopesOf(ctx, dataUser.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &authdto.ListAPIKeysResponse{
+		APIKeys:         make([]authdto.APIKeyResponse, 0, len(apiKeys)),
+		AvailableScopes: scopes,
+	}
+	for _, apiKey := range apiKeys {
+		resp.APIKeys = append(resp.APIKeys, toAPIKeyResponse(apiKey))
+	}
+
+	return resp, nil
+}
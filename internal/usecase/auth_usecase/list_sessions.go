@@ -0,0 +1,39 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/pkg/logger"
+)
+
+func (au *AuthUsecase) ListSessions(ctx context.Context) (*authdto.ListSessionsResponse, error) {
+	dataUser, err := au.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error to get user from context", err.Error())
+		return nil, errors.New("failed to get user from context")
+	}
+
+	sessions, err := au.authRepo.GetActiveSessionsByUserID(ctx, dataUser.ID)
+	if err != nil {
+		logger.Error(ctx, "Error to get sessions", err.Error())
+		return nil, err
+	}
+
+	resp := &authdto.ListSessionsResponse{Sessions: make([]authdto.SessionResponse, 0, len(sessions))}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, authdto.SessionResponse{
+			ID:         session.SessionID,
+			Device:     session.Device,
+			IPAddress:  session.IPAddress,
+			UserAgent:  session.UserAgent,
+			CreatedAt:  session.CreatedAt.Format(time.RFC3339),
+			LastSeenAt: session.LastSeenAt.Format(time.RFC3339),
+			ExpiresAt:  session.ExpiresAt.Format(time.RFC3339),
+			IsCurrent:  session.SessionID == dataUser.SessionID,
+		})
+	}
+
+	return resp, nil
+}
@@ -4,9 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
+	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/authdto"
 	"wtm-backend/pkg/constant"
-	"wtm-backend/pkg/jwt"
 	"wtm-backend/pkg/logger"
 	"wtm-backend/pkg/utils"
 )
@@ -31,53 +32,177 @@ func (au *AuthUsecase) Login(ctx context.Context, req *authdto.LoginRequest) (*a
 		return nil, "", errors.New("user is not active")
 	}
 
+	if user.LockedUntil != nil && time.Now().Before(*user.LockedUntil) {
+		logger.Warn(ctx, "User is locked", user.ID)
+		return nil, "", fmt.Errorf("account is locked after too many failed attempts, please try again in %s", utils.HumanizeDuration(time.Until(*user.LockedUntil)))
+	}
+
 	// Verifikasi password
 	if !utils.ComparePassword(ctx, user.Password, req.Password) {
 		logger.Warn(ctx, "Password is invalid")
+		au.recordFailedLogin(ctx, user, req.IPAddress)
 		return nil, "", errors.New("invalid Password")
 	}
 
-	if user.PhotoSelfie != "" {
-		bucketName := fmt.Sprintf("%s-%s", constant.ConstUser, constant.ConstPublic)
-		photoProfile, err := au.fileStorage.GetFile(ctx, bucketName, user.PhotoSelfie)
-		if err != nil {
-			logger.Error(ctx, "Error getting user profile photo", err.Error())
-			return nil, "", fmt.Errorf("failed to get user profile photo: %s", err.Error())
+	// Password benar, hitungan password salah dan lockout sebelumnya dimulai dari nol lagi
+	if user.FailedLoginAttempts > 0 || user.LockoutCount > 0 {
+		if err := au.userRepo.ResetFailedLogins(ctx, user.ID); err != nil {
+			logger.Error(ctx, "Error resetting failed logins", err.Error())
 		}
-
-		user.PhotoSelfie = photoProfile
 	}
 
-	// Generate JWT token
-	token, err := jwt.GenerateAccessToken(user, au.config.JWTSecret, au.config.DurationAccessToken)
+	// Akun dengan 2FA, atau yang rolenya mewajibkan 2FA, baru mendapat token setelah kode TOTP diverifikasi
+	twoFactor, err := au.authRepo.GetTwoFactor(ctx, user.ID)
 	if err != nil {
-		logger.Error(ctx, "Error generating access token", err.Error())
+		return nil, "", errors.New("failed to get two-factor")
+	}
+	twoFactorEnabled := twoFactor != nil && twoFactor.EnabledAt != nil
+	if twoFactorEnabled || user.TwoFactorRequired {
+		return au.challengeTwoFactor(ctx, user, req, !twoFactorEnabled)
+	}
+
+	if err := au.resolveProfilePhoto(ctx, user); err != nil {
 		return nil, "", err
 	}
 
-	// Generate refresh token
-	refreshToken, err := jwt.GenerateRefreshToken(user, au.config.RefreshSecret, au.config.DurationRefreshToken)
+	var resp *authdto.LoginResponse
+	var refreshToken string
+	err = au.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		resp, refreshToken, err = au.startSession(txCtx, user, req.Device, req.IPAddress, req.UserAgent)
+		return err
+	})
 	if err != nil {
-		logger.Error(ctx, "Error generating refresh token", err.Error())
 		return nil, "", err
 	}
 
-	// Set access token in Redis
-	if err := au.authRepo.SetAccessToken(ctx, user.ID, token, au.config.DurationAccessToken); err != nil {
-		logger.Error(ctx, "Error setting access token in Redis", err.Error())
-		return nil, "", errors.New("failed to set access token")
+	return resp, refreshToken, nil
+}
+
+// challengeTwoFactor holds the login until VerifyTwoFactorLogin receives a code, the client only gets a challenge token
+func (au *AuthUsecase) challengeTwoFactor(ctx context.Context, user *entity.User, req *authdto.LoginRequest, setupRequired bool) (*authdto.LoginResponse, string, error) {
+	token, err := utils.GenerateSecureToken()
+	if err != nil {
+		logger.Error(ctx, "Error generating two-factor challenge token", err.Error())
+		return nil, "", errors.New("failed to generate challenge token")
 	}
 
-	resp := &authdto.LoginResponse{
-		Token: token,
-		User: authdto.DataUser{
-			RoleID:      user.RoleID,
-			Role:        user.RoleName,
-			Permissions: user.Permissions,
-			PhotoURL:    user.PhotoSelfie,
-			FullName:    user.FullName,
-		},
+	challenge := entity.TwoFactorChallenge{
+		UserID:    user.ID,
+		Device:    req.Device,
+		IPAddress: req.IPAddress,
+		UserAgent: req.UserAgent,
+	}
+	if err := au.authRepo.SetTwoFactorChallenge(ctx, token, &challenge, au.config.TwoFactorChallengeTTL); err != nil {
+		return nil, "", errors.New("failed to create two-factor challenge")
 	}
 
-	return resp, refreshToken, nil
+	return &authdto.LoginResponse{
+		TwoFactorRequired:      true,
+		TwoFactorSetupRequired: setupRequired,
+		ChallengeToken:         token,
+	}, "", nil
+}
+
+// recordFailedLogin counts a wrong password of the user and locks the account after too many in a row. Every lockout
+// since the last successful login lasts twice as long as the previous one, the owner is told by email.
+func (au *AuthUsecase) recordFailedLogin(ctx context.Context, user *entity.User, ipAddress string) {
+	if au.config.LoginMaxAttempts <= 0 {
+		return
+	}
+
+	attempts, err := au.userRepo.IncrementFailedLogin(ctx, user.ID)
+	if err != nil || attempts < au.config.LoginMaxAttempts {
+		return
+	}
+
+	lockedFor := lockoutDuration(au.config.LoginLockoutDuration, au.config.LoginLockoutMaxDuration, user.LockoutCount)
+	err = au.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := au.userRepo.LockUser(txCtx, user.ID, time.Now().Add(lockedFor)); err != nil {
+			return err
+		}
+
+		return au.queueAccountLockedEmail(txCtx, user, attempts, ipAddress, lockedFor)
+	})
+	if err != nil {
+		logger.Error(ctx, "Error locking user", err.Error())
+		return
+	}
+
+	logger.Warn(ctx, fmt.Sprintf("User %d locked for %s after %d failed login attempts from %s", user.ID, lockedFor, attempts, ipAddress))
+}
+
+// lockoutDuration doubles the first lockout for every previous lockout, up to longest
+func lockoutDuration(first, longest time.Duration, previousLockouts int) time.Duration {
+	duration := first
+	for i := 0; i < previousLockouts && duration < longest; i++ {
+		duration *= 2
+	}
+	if duration > longest {
+		duration = longest
+	}
+	return duration
+}
+
+func (au *AuthUsecase) queueAccountLockedEmail(ctx context.Context, user *entity.User, attempts int, ipAddress string, lockedFor time.Duration) error {
+	emailTemplate, err := au.emailRepo.GetEmailTemplateByName(ctx, constant.EmailAccountLocked, user.Language)
+	if err != nil {
+		logger.Error(ctx, "Error getting email template by name:", err.Error())
+		return nil
+	}
+
+	if emailTemplate == nil {
+		logger.Error(ctx, "Email template not found for status:", constant.EmailAccountLocked)
+		return nil
+	}
+
+	url := au.config.URLFEAdmin
+	if user.RoleID == constant.RoleAgentID {
+		url = au.config.URLFEAgent
+	}
+
+	data := AccountLockedEmailData{
+		FullName:           user.FullName,
+		Attempts:           attempts,
+		IPAddress:          ipAddress,
+		AttemptedAt:        time.Now().In(constant.AsiaJakarta).Format("02 Jan 2006 15:04"),
+		LockedFor:          utils.HumanizeDuration(lockedFor),
+		ForgotPasswordLink: fmt.Sprintf("%s/forgot-password", url),
+	}
+
+	subjectParsed, err := utils.ParseTemplate(emailTemplate.Subject, data)
+	if err != nil {
+		logger.Error(ctx, "Error parsing subject:", err.Error())
+		return nil
+	}
+
+	bodyHTML, err := utils.ParseTemplate(emailTemplate.Body, data)
+	if err != nil {
+		logger.Error(ctx, "Error parsing body HTML:", err.Error())
+		return nil
+	}
+
+	emailLog := entity.EmailLog{
+		To:              user.Email,
+		Subject:         subjectParsed,
+		Body:            bodyHTML,
+		EmailTemplateID: uint(emailTemplate.ID),
+		TemplateName:    emailTemplate.Name,
+		Scope:           string(constant.ScopeAgent),
+	}
+	emailLog.Meta = &entity.MetadataEmailLog{AgentName: user.FullName}
+
+	if err = au.emailRepo.QueueEmail(ctx, &emailLog, time.Time{}); err != nil {
+		logger.Error(ctx, "Failed to queue email:", err.Error())
+		return err
+	}
+	return nil
+}
+
+type AccountLockedEmailData struct {
+	FullName           string
+	Attempts           int
+	IPAddress          string
+	AttemptedAt        string
+	LockedFor          string // e.g. "15 minutes"
+	ForgotPasswordLink string
 }
@@ -13,9 +13,10 @@ func (au *AuthUsecase) Logout(ctx context.Context) error {
 		return errors.New("failed to get user from context")
 	}
 
-	if err := au.authRepo.DeleteAccessToken(ctx, dataUser.ID); err != nil {
-		logger.Error(ctx, "Error to delete access token", err.Error())
-		return errors.New("failed to delete access token")
+	// Hanya sesi ini yang berakhir, sesi di perangkat lain tetap aktif
+	if _, err := au.authRepo.RevokeSession(ctx, dataUser.ID, dataUser.SessionID); err != nil {
+		logger.Error(ctx, "Error to revoke session", err.Error())
+		return errors.New("failed to revoke session")
 	}
 
 	return nil
@@ -3,50 +3,102 @@ package auth_usecase
 import (
 	"context"
 	"errors"
+	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/pkg/constant"
 	"wtm-backend/pkg/jwt"
 	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
 )
 
-func (au *AuthUsecase) RefreshToken(ctx context.Context, refreshToken string) (*authdto.LoginResponse, error) {
+func (au *AuthUsecase) RefreshToken(ctx context.Context, refreshToken string) (*authdto.LoginResponse, string, error) {
 
 	dataClaim, err := jwt.ParseToken(ctx, refreshToken, au.config.RefreshSecret)
 	if err != nil {
 		logger.Error(ctx, "Error parsing refresh token", err.Error())
-		return nil, errors.New("invalid refresh token")
+		return nil, "", errInvalidRefreshToken
 	}
 
 	if dataClaim.Type != "refresh" {
 		logger.Warn(ctx,
 			"Refresh token is not valid")
-		return nil, errors.New("invalid refresh token")
+		return nil, "", errInvalidRefreshToken
 	}
 
-	dataUser := au.middleware.GenerateUserFromClaimToken(dataClaim)
-
-	// Generate new JWT token
-	token, err := jwt.GenerateAccessToken(dataUser, au.config.JWTSecret, au.config.DurationAccessToken)
+	storedToken, err := au.authRepo.GetRefreshTokenByHash(ctx, utils.HashToken(refreshToken))
 	if err != nil {
-		logger.Error(ctx, "Error generating access token", err.Error())
-		return nil, err
+		logger.Error(ctx, "Error getting refresh token", err.Error())
+		return nil, "", err
+	}
+	if storedToken == nil || storedToken.SessionID != dataClaim.SessionID {
+		logger.Warn(ctx, "Refresh token is unknown")
+		return nil, "", errInvalidRefreshToken
 	}
 
-	// Set access token in Redis
-	if err := au.authRepo.SetAccessToken(ctx, dataUser.ID, token, au.config.DurationAccessToken); err != nil {
-		logger.Error(ctx, "Error setting access token in Redis", err.Error())
-		return nil, errors.New("failed to set access token")
+	// Refresh token hanya sekali pakai, token yang dipakai lagi berarti bocor dan seluruh sesinya dicabut
+	if storedToken.UsedAt != nil {
+		au.revokeRefreshTokenSession(ctx, storedToken)
+		return nil, "", errInvalidRefreshToken
 	}
 
-	resp := &authdto.LoginResponse{
-		Token: token,
-		User: authdto.DataUser{
-			RoleID:      dataUser.RoleID,
-			Role:        dataUser.RoleName,
-			Permissions: dataUser.Permissions,
-			PhotoURL:    dataUser.PhotoSelfie,
-			FullName:    dataUser.FullName,
-		},
+	var resp *authdto.LoginResponse
+	var nextRefreshToken string
+	err = au.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		used, err := au.authRepo.UseRefreshToken(txCtx, storedToken.ID)
+		if err != nil {
+			return err
+		}
+		if !used {
+			return errRefreshTokenReused
+		}
+
+		session, err := au.authRepo.GetActiveSession(txCtx, storedToken.SessionID)
+		if err != nil {
+			logger.Error(ctx, "Error getting session", err.Error())
+			return err
+		}
+		if session == nil {
+			logger.Warn(ctx, "Session of refresh token is not active")
+			return errInvalidRefreshToken
+		}
+
+		// Status, role dan permission dibaca ulang, perubahan dari admin berlaku sejak refresh berikutnya
+		user, err := au.userRepo.GetUserWithPermissionsByID(txCtx, session.UserID)
+		if err != nil {
+			logger.Error(ctx, "Error getting user", err.Error())
+			return err
+		}
+		if user == nil || user.StatusID != constant.StatusUserActiveID {
+			logger.Warn(ctx, "User of refresh token is not active", session.UserID)
+			return errUserNotActive
+		}
+
+		if err := au.resolveProfilePhoto(ctx, user); err != nil {
+			return err
+		}
+
+		resp, nextRefreshToken, err = au.issueTokens(txCtx, user, *session)
+		return err
+	})
+
+	switch {
+	case errors.Is(err, errRefreshTokenReused):
+		au.revokeRefreshTokenSession(ctx, storedToken)
+		return nil, "", errInvalidRefreshToken
+	case errors.Is(err, errUserNotActive):
+		au.revokeRefreshTokenSession(ctx, storedToken)
+		return nil, "", err
+	case err != nil:
+		return nil, "", err
 	}
 
-	return resp, nil
+	return resp, nextRefreshToken, nil
+}
+
+// revokeRefreshTokenSession ends the session of the refresh token, with every refresh token issued to it
+func (au *AuthUsecase) revokeRefreshTokenSession(ctx context.Context, storedToken *entity.RefreshToken) {
+	logger.Warn(ctx, "Revoking session of refresh token", "sessionID", storedToken.SessionID, "userID", storedToken.UserID)
+	if _, err := au.authRepo.RevokeSession(ctx, storedToken.UserID, storedToken.SessionID); err != nil {
+		logger.Error(ctx, "Error revoking session of refresh token", err.Error())
+	}
 }
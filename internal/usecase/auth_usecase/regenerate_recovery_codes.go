@@ -0,0 +1,42 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/pkg/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// RegenerateRecoveryCodes replaces every recovery code of the logged in user, used or not
+func (au *AuthUsecase) RegenerateRecoveryCodes(ctx context.Context, req *authdto.TwoFactorCodeRequest) (*authdto.TwoFactorRecoveryCodesResponse, error) {
+	dataUser, err := au.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error to get user from context", err.Error())
+		return nil, errors.New("failed to get user from context")
+	}
+
+	twoFactor, err := au.authRepo.GetTwoFactor(ctx, dataUser.ID)
+	if err != nil {
+		return nil, errors.New("failed to get two-factor")
+	}
+	if twoFactor == nil || twoFactor.EnabledAt == nil {
+		return nil, validation.Errors{"two_factor": validation.NewInternalError(errors.New("two-factor is not enabled"))}
+	}
+
+	used, err := au.useTwoFactorCode(ctx, twoFactor, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !used {
+		return nil, validation.Errors{"code": validation.NewInternalError(errors.New("invalid code"))}
+	}
+
+	recoveryCodes, err := au.newRecoveryCodes(ctx, dataUser.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authdto.TwoFactorRecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
@@ -36,6 +36,12 @@ func (au *AuthUsecase) ResetPassword(ctx context.Context, request *authdto.Reset
 			return err
 		}
 
+		// Pemilik akun sudah membuktikan emailnya, akun yang terkunci karena percobaan login dibuka lagi
+		if err := au.userRepo.ResetFailedLogins(nCtx, user.ID); err != nil {
+			logger.Error(ctx, "Error resetting failed logins:", err.Error())
+			return err
+		}
+
 		return nil
 	})
 }
@@ -0,0 +1,25 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"wtm-backend/pkg/logger"
+)
+
+func (au *AuthUsecase) RevokeOtherSessions(ctx context.Context) error {
+	dataUser, err := au.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error to get user from context", err.Error())
+		return errors.New("failed to get user from context")
+	}
+
+	revoked, err := au.authRepo.RevokeSessionsByUserID(ctx, dataUser.ID, dataUser.SessionID)
+	if err != nil {
+		logger.Error(ctx, "Error to revoke sessions", err.Error())
+		return err
+	}
+
+	logger.Info(ctx, fmt.Sprintf("Revoked %d other sessions of user %d", revoked, dataUser.ID))
+	return nil
+}
@@ -0,0 +1,29 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"wtm-backend/pkg/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+func (au *AuthUsecase) RevokeSession(ctx context.Context, sessionID string) error {
+	dataUser, err := au.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error to get user from context", err.Error())
+		return errors.New("failed to get user from context")
+	}
+
+	revoked, err := au.authRepo.RevokeSession(ctx, dataUser.ID, sessionID)
+	if err != nil {
+		logger.Error(ctx, "Error to revoke session", err.Error())
+		return err
+	}
+
+	if !revoked {
+		return validation.Errors{"id": validation.NewInternalError(errors.New("session not found"))}
+	}
+
+	return nil
+}
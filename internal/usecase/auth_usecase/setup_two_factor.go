@@ -0,0 +1,29 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/pkg/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// SetupTwoFactor starts the enrollment of an authenticator for the logged in user, completed by VerifyTwoFactorSetup
+func (au *AuthUsecase) SetupTwoFactor(ctx context.Context) (*authdto.TwoFactorSetupResponse, error) {
+	dataUser, err := au.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error to get user from context", err.Error())
+		return nil, errors.New("failed to get user from context")
+	}
+
+	twoFactor, err := au.authRepo.GetTwoFactor(ctx, dataUser.ID)
+	if err != nil {
+		return nil, errors.New("failed to get two-factor")
+	}
+	if twoFactor != nil && twoFactor.EnabledAt != nil {
+		return nil, validation.Errors{"two_factor": validation.NewInternalError(errors.New("two-factor is already enabled"))}
+	}
+
+	return au.newTwoFactorSecret(ctx, dataUser)
+}
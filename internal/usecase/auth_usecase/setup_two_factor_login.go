@@ -0,0 +1,41 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/pkg/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// SetupTwoFactorLogin enrolls an authenticator during a login of a user whose role requires two-factor, the
+// enrollment is completed by VerifyTwoFactorLogin
+func (au *AuthUsecase) SetupTwoFactorLogin(ctx context.Context, req *authdto.TwoFactorChallengeRequest) (*authdto.TwoFactorSetupResponse, error) {
+	challenge, _, err := au.authRepo.GetTwoFactorChallenge(ctx, req.ChallengeToken)
+	if err != nil {
+		return nil, errors.New("failed to get two-factor challenge")
+	}
+	if challenge == nil {
+		return nil, validation.Errors{"challenge_token": validation.NewInternalError(errors.New("login expired, please log in again"))}
+	}
+
+	user, err := au.userRepo.GetUserWithPermissionsByID(ctx, challenge.UserID)
+	if err != nil {
+		logger.Error(ctx, "Error getting user", err.Error())
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	twoFactor, err := au.authRepo.GetTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, errors.New("failed to get two-factor")
+	}
+	if twoFactor != nil && twoFactor.EnabledAt != nil {
+		return nil, validation.Errors{"challenge_token": validation.NewInternalError(errors.New("two-factor is already enabled"))}
+	}
+
+	return au.newTwoFactorSecret(ctx, user)
+}
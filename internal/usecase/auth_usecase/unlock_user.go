@@ -0,0 +1,36 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/pkg/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+func (au *AuthUsecase) UnlockUser(ctx context.Context, req *authdto.UnlockUserRequest) error {
+	dataUser, err := au.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error to get user from context", err.Error())
+		return errors.New("failed to get user from context")
+	}
+
+	user, err := au.userRepo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		logger.Error(ctx, "Error to get user by ID", err.Error())
+		return err
+	}
+	if user == nil {
+		return validation.Errors{"user_id": validation.NewInternalError(errors.New("user not found"))}
+	}
+
+	if err := au.userRepo.ResetFailedLogins(ctx, user.ID); err != nil {
+		logger.Error(ctx, "Error to unlock user", err.Error())
+		return err
+	}
+
+	logger.Info(ctx, fmt.Sprintf("User %d unlocked user %d", dataUser.ID, user.ID))
+	return nil
+}
@@ -0,0 +1,137 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// VerifyTwoFactorLogin completes a login held by a two-factor challenge. A pending enrollment is enabled by its
+// first code, the recovery codes of it are returned once with the tokens.
+func (au *AuthUsecase) VerifyTwoFactorLogin(ctx context.Context, req *authdto.VerifyTwoFactorLoginRequest) (*authdto.LoginResponse, string, error) {
+	challenge, _, err := au.authRepo.GetTwoFactorChallenge(ctx, req.ChallengeToken)
+	if err != nil {
+		return nil, "", errors.New("failed to get two-factor challenge")
+	}
+	if challenge == nil {
+		return nil, "", validation.Errors{"challenge_token": validation.NewInternalError(errors.New("login expired, please log in again"))}
+	}
+
+	// Percobaan dihitung atomik sebelum kode diperiksa, tebakan paralel tidak bisa melewati batas
+	attempts, _, err := au.authRepo.HitRateLimit(ctx, "two_factor_challenge:"+utils.HashToken(req.ChallengeToken), au.config.TwoFactorChallengeTTL)
+	if err != nil {
+		return nil, "", errors.New("failed to count two-factor attempts")
+	}
+	if attempts > int64(au.config.TwoFactorMaxAttempts) {
+		au.dropTwoFactorChallenge(ctx, req.ChallengeToken)
+		return nil, "", errTooManyTwoFactorAttempts
+	}
+
+	user, err := au.userRepo.GetUserWithPermissionsByID(ctx, challenge.UserID)
+	if err != nil {
+		logger.Error(ctx, "Error getting user", err.Error())
+		return nil, "", err
+	}
+	if user == nil || user.StatusID != constant.StatusUserActiveID {
+		logger.Warn(ctx, "User of two-factor challenge is not active", challenge.UserID)
+		au.dropTwoFactorChallenge(ctx, req.ChallengeToken)
+		return nil, "", errUserNotActive
+	}
+
+	twoFactor, err := au.authRepo.GetTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, "", errors.New("failed to get two-factor")
+	}
+	if twoFactor == nil {
+		return nil, "", validation.Errors{"code": validation.NewInternalError(errors.New("set up an authenticator first"))}
+	}
+
+	if err := au.resolveProfilePhoto(ctx, user); err != nil {
+		return nil, "", err
+	}
+
+	var resp *authdto.LoginResponse
+	var refreshToken string
+	var recoveryCodes []string
+	err = au.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		switch {
+		case req.RecoveryCode != "":
+			if twoFactor.EnabledAt == nil {
+				return errInvalidTwoFactor
+			}
+			used, err := au.authRepo.UseRecoveryCode(txCtx, user.ID, hashRecoveryCode(req.RecoveryCode))
+			if err != nil {
+				return err
+			}
+			if !used {
+				return errInvalidTwoFactor
+			}
+		case twoFactor.EnabledAt == nil:
+			// Pendaftaran authenticator yang diwajibkan role selesai dengan kode pertamanya
+			step, ok, err := au.checkTwoFactorCode(ctx, twoFactor, req.Code)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return errInvalidTwoFactor
+			}
+			enabled, err := au.authRepo.EnableTwoFactor(txCtx, user.ID, step)
+			if err != nil {
+				return err
+			}
+			if !enabled {
+				return errInvalidTwoFactor
+			}
+			if recoveryCodes, err = au.newRecoveryCodes(txCtx, user.ID); err != nil {
+				return err
+			}
+		default:
+			used, err := au.useTwoFactorCode(txCtx, twoFactor, req.Code)
+			if err != nil {
+				return err
+			}
+			if !used {
+				return errInvalidTwoFactor
+			}
+		}
+
+		resp, refreshToken, err = au.startSession(txCtx, user, challenge.Device, challenge.IPAddress, challenge.UserAgent)
+		return err
+	})
+	if errors.Is(err, errInvalidTwoFactor) {
+		return nil, "", au.failTwoFactorChallenge(ctx, req.ChallengeToken, challenge, attempts)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	au.dropTwoFactorChallenge(ctx, req.ChallengeToken)
+
+	resp.RecoveryCodes = recoveryCodes
+	return resp, refreshToken, nil
+}
+
+// failTwoFactorChallenge answers a wrong code, already counted against the challenge, the login has to start over
+// after too many
+func (au *AuthUsecase) failTwoFactorChallenge(ctx context.Context, token string, challenge *entity.TwoFactorChallenge, attempts int64) error {
+	logger.Warn(ctx, "Invalid two-factor code", "userID", challenge.UserID, "attempts", attempts)
+
+	if attempts >= int64(au.config.TwoFactorMaxAttempts) {
+		au.dropTwoFactorChallenge(ctx, token)
+		return errTooManyTwoFactorAttempts
+	}
+
+	return validation.Errors{"code": validation.NewInternalError(errors.New("invalid code"))}
+}
+
+func (au *AuthUsecase) dropTwoFactorChallenge(ctx context.Context, token string) {
+	if err := au.authRepo.DeleteTwoFactorChallenge(ctx, token); err != nil {
+		logger.Error(ctx, "Error deleting two-factor challenge", err.Error())
+	}
+}
@@ -0,0 +1,54 @@
+package auth_usecase
+
+import (
+	"context"
+	"errors"
+	"wtm-backend/internal/dto/authdto"
+	"wtm-backend/pkg/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// VerifyTwoFactorSetup enables the pending authenticator of the logged in user with its first code
+func (au *AuthUsecase) VerifyTwoFactorSetup(ctx context.Context, req *authdto.TwoFactorCodeRequest) (*authdto.TwoFactorRecoveryCodesResponse, error) {
+	dataUser, err := au.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error to get user from context", err.Error())
+		return nil, errors.New("failed to get user from context")
+	}
+
+	twoFactor, err := au.authRepo.GetTwoFactor(ctx, dataUser.ID)
+	if err != nil {
+		return nil, errors.New("failed to get two-factor")
+	}
+	if twoFactor == nil || twoFactor.EnabledAt != nil {
+		return nil, validation.Errors{"two_factor": validation.NewInternalError(errors.New("no authenticator to verify, set it up first"))}
+	}
+
+	step, ok, err := au.checkTwoFactorCode(ctx, twoFactor, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, validation.Errors{"code": validation.NewInternalError(errors.New("invalid code"))}
+	}
+
+	var recoveryCodes []string
+	err = au.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		enabled, err := au.authRepo.EnableTwoFactor(txCtx, dataUser.ID, step)
+		if err != nil {
+			return err
+		}
+		if !enabled {
+			return validation.Errors{"two_factor": validation.NewInternalError(errors.New("two-factor is already enabled"))}
+		}
+
+		recoveryCodes, err = au.newRecoveryCodes(txCtx, dataUser.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authdto.TwoFactorRecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
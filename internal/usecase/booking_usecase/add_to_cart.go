@@ -8,12 +8,14 @@ import (
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/bookingdto"
 	"wtm-backend/pkg/constant"
-	"wtm-backend/pkg/currency"
 	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/pricing"
 )
 
 func (bu *BookingUsecase) AddToCart(ctx context.Context, req *bookingdto.AddToCartRequest) error {
-	return bu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+	// The hold lives in Redis, it is dropped again when the transaction of its booking details does not commit
+	var releaseHold func()
+	err := bu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
 
 		// Get agent Id from context
 		userCtx, err := bu.middleware.GenerateUserFromContext(txCtx)
@@ -35,6 +37,9 @@ func (bu *BookingUsecase) AddToCart(ctx context.Context, req *bookingdto.AddToCa
 			logger.Error(ctx, "failed to get room price by id", err.Error())
 			return fmt.Errorf("room price not found: %s", err.Error())
 		}
+		if roomPrice == nil {
+			return fmt.Errorf("room price not found")
+		}
 
 		checkInDate, err := time.Parse(time.DateOnly, req.CheckInDate)
 		if err != nil {
@@ -173,11 +178,28 @@ func (bu *BookingUsecase) AddToCart(ctx context.Context, req *bookingdto.AddToCa
 			return fmt.Errorf("check-out date must be after check-in date")
 		}
 
-		if promo != nil {
-			if promo.Duration > nights {
-				logger.Error(ctx, "This promo is not valid for the selected stay duration")
-				return fmt.Errorf("this promo is not valid for the selected stay duration")
-			}
+		// Validate stay restrictions (min/max stay, closed to arrival/departure, release days)
+		if err := bu.validateStayRestrictions(txCtx, roomPrice.RoomTypeID, roomPrice.RoomType.Name, checkInDate, checkOutDate); err != nil {
+			logger.Error(ctx, "stay restriction violated", err.Error())
+			return err
+		}
+
+		// Validate remaining room inventory for every night of the stay, minus units held in other carts
+		if err := bu.checkRoomAvailability(txCtx, roomPrice.RoomTypeID, roomPrice.RoomType.Name, checkInDate, checkOutDate, req.Quantity); err != nil {
+			return err
+		}
+
+		// Get agent's currency preference and promo group
+		user, err := bu.userRepo.GetUserByID(txCtx, agentID)
+		if err != nil {
+			logger.Error(ctx, "failed to get user for currency", err.Error())
+			return fmt.Errorf("failed to get user: %s", err.Error())
+		}
+
+		// Validate the promo and additionals picked for the room, as the quote does
+		if err := validateCartSelection(user, roomPrice, checkInDate, nights, req.PromoID, promo, req.RoomTypeAdditionalIDs, additionals); err != nil {
+			logger.Error(ctx, "invalid cart selection", err.Error())
+			return err
 		}
 
 		// Join selected "Other Preferences" into a comma-separated string snapshot
@@ -193,12 +215,6 @@ func (bu *BookingUsecase) AddToCart(ctx context.Context, req *bookingdto.AddToCa
 		// Trim additional notes (admin-only field)
 		additionalNotes := strings.TrimSpace(req.AdditionalNotes)
 
-		// Get agent's currency preference
-		user, err := bu.userRepo.GetUserByID(txCtx, agentID)
-		if err != nil {
-			logger.Error(ctx, "failed to get user for currency", err.Error())
-			return fmt.Errorf("failed to get user: %s", err.Error())
-		}
 		agentCurrency := "IDR" // Default fallback
 		if user != nil && user.Currency != "" {
 			agentCurrency = user.Currency
@@ -257,25 +273,14 @@ func (bu *BookingUsecase) AddToCart(ctx context.Context, req *bookingdto.AddToCa
 			return fmt.Errorf("failed to create booking detail: %s", err.Error())
 		}
 
-		// 6. Create BookingDetailAdditionals
+		// 6. Create BookingDetailAdditionals, priced in the agent's currency
+		exchangeRate, err := bu.exchangeRate(txCtx, agentCurrency)
+		if err != nil {
+			return err
+		}
 		for _, add := range additionals {
-			var price *float64
-
-			// Convert price to agent's currency if it's a price-based additional
-			if add.Category == constant.AdditionalServiceCategoryPrice {
-				if len(add.Prices) > 0 {
-					// Use Prices map for multi-currency support
-					if convertedPrice, _, _ := currency.GetPriceForCurrency(add.Prices, agentCurrency); convertedPrice > 0 {
-						price = &convertedPrice
-					} else if add.Price != nil && *add.Price > 0 {
-						// Fallback to Price field if Prices not available (backward compatibility)
-						price = add.Price
-					}
-				} else if add.Price != nil && *add.Price > 0 {
-					// Backward compatibility: use Price field if Prices not set
-					price = add.Price
-				}
-			}
+			// Price-based additionals in the agent's currency, missing currency derived from the exchange rate
+			price := pricing.AdditionalPrice(add, agentCurrency, exchangeRate)
 
 			additional := &entity.BookingDetailAdditional{
 				BookingDetailIDs:     bookingDetailIds,
@@ -291,8 +296,24 @@ func (bu *BookingUsecase) AddToCart(ctx context.Context, req *bookingdto.AddToCa
 			}
 		}
 
+		// 7. Hold the units while they sit in the cart, last so nothing but the commit can fail after it
+		if err := bu.holdCartUnits(txCtx, roomPrice.RoomTypeID, roomPrice.RoomType.Name, checkInDate, checkOutDate, bookingDetailIds); err != nil {
+			return err
+		}
+		releaseHold = func() {
+			for _, id := range bookingDetailIds {
+				if err := bu.bookingRepo.ReleaseCartHold(ctx, roomPrice.RoomTypeID, checkInDate, checkOutDate, id); err != nil {
+					logger.Error(ctx, "failed to release cart hold", "bookingDetailID", id, err.Error())
+				}
+			}
+		}
+
 		return nil
 	})
+	if err != nil && releaseHold != nil {
+		releaseHold()
+	}
+	return err
 }
 
 func (bu *BookingUsecase) generateDetailPromo(promo *entity.Promo) (entity.DetailPromo, error) {
@@ -0,0 +1,353 @@
+package booking_usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/currency"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/utils"
+)
+
+func (bu *BookingUsecase) AmendBooking(ctx context.Context, req *bookingdto.AmendBookingRequest) (*bookingdto.AmendBookingResponse, error) {
+	// Get agent Id from context
+	userCtx, err := bu.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to get user from context", err.Error())
+		return nil, fmt.Errorf("failed to get user from context: %s", err.Error())
+	}
+
+	if userCtx == nil {
+		logger.Error(ctx, "user context is nil")
+		return nil, fmt.Errorf("user not found in context")
+	}
+
+	agentID := userCtx.ID
+
+	var amended entity.BookingDetail
+	var revision entity.BookingDetailRevision
+	var totalPrice currency.Money
+	err = bu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		detailID, err := bu.bookingRepo.GetIDBySubBookingID(txCtx, req.SubBookingID)
+		if err != nil {
+			logger.Error(ctx, "failed to get ID by sub booking ID", err.Error())
+			return err
+		}
+
+		details, err := bu.bookingRepo.GetBookingDetailsByIDs(txCtx, []uint{detailID})
+		if err != nil {
+			logger.Error(ctx, "failed to get booking detail", err.Error())
+			return err
+		}
+		if len(details) == 0 {
+			return fmt.Errorf("booking detail not found")
+		}
+		current := details[0]
+
+		if current.Booking.AgentID != agentID || current.Booking.StatusBookingID == constant.StatusBookingInCartID ||
+			(current.StatusBookingID != constant.StatusBookingWaitingApprovalID && current.StatusBookingID != constant.StatusBookingConfirmedID) {
+			logger.Error(ctx, "booking detail not found for the agent or not waiting approval or confirmed")
+			return fmt.Errorf("this booking is not valid for amending")
+		}
+
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		if current.CheckInDate.Before(today) {
+			return fmt.Errorf("a stay that has already started cannot be amended")
+		}
+
+		// Start from the current values, the request only carries what changes
+		amended = current
+		if req.CheckInDate != "" {
+			if amended.CheckInDate, err = time.Parse(time.DateOnly, req.CheckInDate); err != nil {
+				logger.Error(ctx, "failed to parse check-in date", err.Error())
+				return fmt.Errorf("invalid check-in date: %s", err.Error())
+			}
+		}
+		if req.CheckOutDate != "" {
+			if amended.CheckOutDate, err = time.Parse(time.DateOnly, req.CheckOutDate); err != nil {
+				logger.Error(ctx, "failed to parse check-out date", err.Error())
+				return fmt.Errorf("invalid check-out date: %s", err.Error())
+			}
+		}
+		if req.Quantity > 0 {
+			amended.Quantity = req.Quantity
+		}
+		if amended.CheckInDate.Before(today) {
+			return fmt.Errorf("check-in date must not be in the past")
+		}
+		nights := int(amended.CheckOutDate.Sub(amended.CheckInDate).Hours() / 24)
+		if nights <= 0 {
+			return fmt.Errorf("check-out date must be after check-in date")
+		}
+
+		roomPriceID := current.RoomPriceID
+		if req.RoomPriceID > 0 {
+			roomPriceID = req.RoomPriceID
+		}
+		roomPrice, err := bu.hotelRepo.GetRoomPriceByID(txCtx, roomPriceID)
+		if err != nil {
+			logger.Error(ctx, "failed to get room price by id", err.Error())
+			return fmt.Errorf("room price not found: %s", err.Error())
+		}
+		if roomPrice == nil {
+			return fmt.Errorf("room price not found")
+		}
+		if roomPrice.RoomType.HotelID != current.RoomPrice.RoomType.HotelID {
+			return fmt.Errorf("room price must belong to %s, cancel and book again to change hotel", current.RoomPrice.RoomType.Hotel.Name)
+		}
+		amended.RoomPriceID = roomPrice.ID
+		amended.RoomPrice = *roomPrice
+
+		if limit := roomPrice.RoomType.BookingLimitPerBooking; limit != nil && *limit > 0 && amended.Quantity > *limit {
+			return fmt.Errorf("booking limit exceeded: maximum %d rooms allowed per booking for %s, but %d rooms requested", *limit, roomPrice.RoomType.Name, amended.Quantity)
+		}
+
+		if err := bu.validateStayRestrictions(txCtx, roomPrice.RoomTypeID, roomPrice.RoomType.Name, amended.CheckInDate, amended.CheckOutDate); err != nil {
+			logger.Error(ctx, "stay restriction violated", err.Error())
+			return err
+		}
+
+		// Give the current nights back first, so nights kept by the amendment don't count against it
+		if err := bu.releaseRoomInventory(txCtx, []entity.BookingDetail{current}); err != nil {
+			return err
+		}
+		if err := bu.checkRoomAvailability(txCtx, roomPrice.RoomTypeID, roomPrice.RoomType.Name, amended.CheckInDate, amended.CheckOutDate, amended.Quantity); err != nil {
+			return err
+		}
+		if err := bu.reserveRoomInventory(txCtx, []entity.BookingDetail{amended}); err != nil {
+			return err
+		}
+
+		// The promo of the booking is kept and applied again to the amended stay
+		var detailPromo entity.DetailPromo
+		if current.PromoID != nil {
+			promo, err := bu.promoRepo.GetPromoByID(txCtx, *current.PromoID, nil)
+			if err != nil {
+				logger.Error(ctx, "failed to get promo by id", err.Error())
+				return fmt.Errorf("promo not found: %s", err.Error())
+			}
+			if promo.Duration > nights {
+				return fmt.Errorf("promo %s is not valid for the amended stay duration", promo.Name)
+			}
+			amended.Promo = promo
+			if detailPromo, err = bu.generateDetailPromo(promo); err != nil {
+				logger.Error(ctx, "failed to generate detail promo", err.Error())
+			}
+		}
+		amended.DetailPromos = detailPromo
+
+		bookingCurrency := current.Currency
+		if bookingCurrency == "" {
+			bookingCurrency = "IDR" // Default fallback
+		}
+		exchangeRate, err := bu.exchangeRate(txCtx, bookingCurrency)
+		if err != nil {
+			return err
+		}
+		quote, err := bu.quoteBookingDetail(txCtx, amended, bookingCurrency, exchangeRate, quoteAdditionals(current))
+		if err != nil {
+			return err
+		}
+		amended.Price = quote.RoomTotal
+		// The rate is only kept when a price was converted with it
+		exchangeRate = quote.ExchangeRate
+		amended.ExchangeRate, amended.ExchangeRateMarkup = 0, 0
+		if exchangeRate != nil {
+			amended.ExchangeRate = exchangeRate.Rate
+			amended.ExchangeRateMarkup = exchangeRate.MarkupPercent
+		}
+		totalPrice = quote.GrandTotal
+
+		// New dates may fall under another policy, the snapshot follows the amended stay
+		cancellationPolicy, err := bu.cancellationPolicy(txCtx, amended)
+		if err != nil {
+			return err
+		}
+		amended.DetailRooms = entity.DetailRoom{
+			HotelName:          roomPrice.RoomType.Hotel.Name,
+			RoomTypeName:       roomPrice.RoomType.Name,
+			Capacity:           roomPrice.RoomType.MaxOccupancy,
+			IsAPI:              roomPrice.RoomType.Hotel.IsAPI,
+			CancelledDate:      freeCancellationDate(cancellationPolicy, amended.CheckInDate),
+			CancellationPolicy: &cancellationPolicy,
+		}
+		amended.StatusBookingID = constant.StatusBookingWaitingApprovalID
+
+		revision = entity.BookingDetailRevision{
+			BookingDetailID: current.ID,
+			RoomPriceID:     current.RoomPriceID,
+			CheckInDate:     current.CheckInDate,
+			CheckOutDate:    current.CheckOutDate,
+			Quantity:        current.Quantity,
+			PromoID:         current.PromoID,
+			DetailPromos:    current.DetailPromos,
+			DetailRooms:     current.DetailRooms,
+			Price:           current.Price,
+			Currency:        current.Currency,
+			StatusBookingID: current.StatusBookingID,
+			Reason:          strings.TrimSpace(req.Reason),
+			AmendedBy:       userCtx.ID,
+		}
+		if err := bu.bookingRepo.CreateBookingDetailRevision(txCtx, &revision); err != nil {
+			return err
+		}
+
+		if err := bu.bookingRepo.AmendBookingDetail(txCtx, &amended); err != nil {
+			return err
+		}
+		if err := bu.bookingRepo.UpdateBookingStatus(txCtx, current.BookingID, constant.StatusBookingWaitingApprovalID); err != nil {
+			logger.Error(ctx, "failed to update booking status", err.Error())
+			return fmt.Errorf("failed to update booking status: %s", err.Error())
+		}
+
+		// Reissue the invoice under the same invoice code
+		user, err := bu.userRepo.GetUserByID(txCtx, current.Booking.AgentID)
+		if err != nil {
+			logger.Error(ctx, "failed to get user", err.Error())
+			return fmt.Errorf("failed to get user: %s", err.Error())
+		}
+		detailInvoice := entity.DetailInvoice{
+			CompanyAgent:       user.AgentCompanyName,
+			Agent:              user.FullName,
+			Email:              user.Email,
+			Hotel:              amended.DetailRooms.HotelName,
+			Guest:              amended.Guest,
+			CheckIn:            amended.CheckInDate.Format(time.DateOnly),
+			CheckOut:           amended.CheckOutDate.Format(time.DateOnly),
+			SubBookingID:       amended.SubBookingID,
+			BedType:            amended.BedType,
+			AdditionalNotes:    amended.AdditionalNotes,
+			DescriptionInvoice: append(quote.Items, otherPreferenceItems(amended.OtherPreferences, bookingCurrency)...),
+			Promo:              detailPromo,
+			TotalPrice:         totalPrice,
+			Currency:           bookingCurrency,
+		}
+		if exchangeRate != nil {
+			detailInvoice.ExchangeRate = exchangeRate.Rate
+			detailInvoice.ExchangeRateMarkup = exchangeRate.MarkupPercent
+			detailInvoice.ExchangeRateDate = exchangeRate.EffectiveDate.Format(time.DateOnly)
+		}
+		if err := bu.bookingRepo.UpdateInvoiceDetail(txCtx, current.ID, detailInvoice); err != nil {
+			return err
+		}
+
+		return bu.queueEmailNotificationHotelAmend(txCtx, amended, revision)
+	})
+	if err != nil {
+		logger.Error(ctx, "transaction failed in amend booking", err.Error())
+		return nil, err
+	}
+
+	return &bookingdto.AmendBookingResponse{
+		SubBookingID:  amended.SubBookingID,
+		Revision:      revision.Revision,
+		HotelName:     amended.DetailRooms.HotelName,
+		RoomTypeName:  amended.DetailRooms.RoomTypeName,
+		CheckInDate:   amended.CheckInDate.Format(time.DateOnly),
+		CheckOutDate:  amended.CheckOutDate.Format(time.DateOnly),
+		Quantity:      amended.Quantity,
+		Currency:      totalPrice.Currency(),
+		Price:         amended.Price,
+		TotalPrice:    totalPrice,
+		StatusBooking: constant.StatusBookingWaitingApproval,
+	}, nil
+}
+
+// otherPreferenceItems lists the selected "Other Preferences" as informational invoice lines (no charge)
+func otherPreferenceItems(otherPreferences string, currencyCode string) []entity.DescriptionInvoice {
+	var items []entity.DescriptionInvoice
+	for _, p := range strings.Split(otherPreferences, ",") {
+		if name := strings.TrimSpace(p); name != "" {
+			items = append(items, entity.DescriptionInvoice{
+				Description: name,
+				Quantity:    1,
+				Unit:        "preference",
+				Price:       currency.ZeroMoney(currencyCode),
+				Total:       currency.ZeroMoney(currencyCode),
+			})
+		}
+	}
+	return items
+}
+
+func (bu *BookingUsecase) queueEmailNotificationHotelAmend(ctx context.Context, bd entity.BookingDetail, previous entity.BookingDetailRevision) error {
+	emailTemplate, err := bu.emailRepo.GetEmailTemplateByName(ctx, constant.EmailHotelBookingAmend, bd.RoomPrice.RoomType.Hotel.Language)
+	if err != nil || emailTemplate == nil {
+		logger.Error(ctx, "Failed to get email template:", err)
+		return nil
+	}
+
+	// Room rate and additional services in IDR (always use IDR for hotel emails)
+	rateIDR, additionalServices := bu.hotelEmailRate(ctx, bd)
+
+	data := HotelEmailDataAmend{
+		GuestName:          bd.Guest,
+		BookingCode:        bd.Booking.BookingCode,
+		SubBookingID:       bd.SubBookingID,
+		Period:             fmt.Sprintf("%s to %s", bd.CheckInDate.Format("02-01-2006"), bd.CheckOutDate.Format("02-01-2006")),
+		RoomType:           bd.DetailRooms.RoomTypeName,
+		BedTypes:           strings.Join(bd.RoomPrice.RoomType.BedTypeNames, ", "),
+		Quantity:           bd.Quantity,
+		Rate:               fmt.Sprintf("%.2f", rateIDR.Float64()),
+		AdditionalServices: additionalServices,
+		PreviousPeriod:     fmt.Sprintf("%s to %s", previous.CheckInDate.Format("02-01-2006"), previous.CheckOutDate.Format("02-01-2006")),
+		PreviousRoomType:   previous.DetailRooms.RoomTypeName,
+		PreviousQuantity:   previous.Quantity,
+		Reason:             previous.Reason,
+	}
+	if bd.BedType != "" {
+		data.BedTypes = bd.BedType
+	}
+
+	var attachments []entity.EmailAttachment
+	data.SystemSignature, attachments = bu.signatureEmail(ctx, emailTemplate)
+
+	subjectParsed, err := utils.ParseTemplate(emailTemplate.Subject, data)
+	bodyHTML, err := utils.ParseTemplate(emailTemplate.Body, data)
+	if err != nil {
+		logger.Error(ctx, "Failed to parse hotel email template:", err)
+		return nil
+	}
+
+	emailTo := bd.RoomPrice.RoomType.Hotel.Email
+
+	emailLog := entity.EmailLog{
+		To:              emailTo,
+		Subject:         subjectParsed,
+		Body:            bodyHTML,
+		EmailTemplateID: uint(emailTemplate.ID),
+		TemplateName:    emailTemplate.Name,
+	}
+	metadataLog := entity.MetadataEmailLog{
+		HotelName:   bd.RoomPrice.RoomType.Hotel.Name,
+		BookingCode: bd.Booking.BookingCode,
+	}
+	emailLog.Meta = &metadataLog
+	emailLog.Attachments = attachments
+
+	return bu.queueEmail(ctx, constant.ScopeHotel, &emailLog)
+}
+
+type HotelEmailDataAmend struct {
+	GuestName          string
+	BookingCode        string
+	SubBookingID       string
+	Period             string
+	RoomType           string
+	BedTypes           string
+	Quantity           int
+	Rate               string
+	AdditionalServices []AdditionalServiceEmailInfo
+	SystemSignature    string // bisa berupa teks atau <img src="...">
+
+	// Reservation before the amendment
+	PreviousPeriod   string
+	PreviousRoomType string
+	PreviousQuantity int
+	Reason           string
+}
@@ -8,7 +8,9 @@ import (
 	"time"
 	"wtm-backend/config"
 	"wtm-backend/internal/domain"
+	"wtm-backend/internal/domain/entity"
 	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
 )
 
 type BookingUsecase struct {
@@ -23,9 +25,13 @@ type BookingUsecase struct {
 	emailSender domain.EmailSender
 	userRepo    domain.UserRepository
 	notifRepo   domain.NotificationRepository
+
+	currencyRepo     domain.CurrencyRepository
+	documentRenderer domain.DocumentRenderer
+	notifier         domain.Notifier
 }
 
-func NewBookingUsecase(bookingRepo domain.BookingRepository, hotelRepo domain.HotelRepository, promoRepo domain.PromoRepository, middleware domain.Middleware, dbTrx domain.DatabaseTransaction, fileStorage domain.StorageClient, config *config.Config, emailRepo domain.EmailRepository, emailSender domain.EmailSender, userRepo domain.UserRepository, notifRepo domain.NotificationRepository) *BookingUsecase {
+func NewBookingUsecase(bookingRepo domain.BookingRepository, hotelRepo domain.HotelRepository, promoRepo domain.PromoRepository, middleware domain.Middleware, dbTrx domain.DatabaseTransaction, fileStorage domain.StorageClient, config *config.Config, emailRepo domain.EmailRepository, emailSender domain.EmailSender, userRepo domain.UserRepository, notifRepo domain.NotificationRepository, currencyRepo domain.CurrencyRepository, documentRenderer domain.DocumentRenderer, notifier domain.Notifier) *BookingUsecase {
 	return &BookingUsecase{
 		bookingRepo: bookingRepo,
 		hotelRepo:   hotelRepo,
@@ -38,6 +44,10 @@ func NewBookingUsecase(bookingRepo domain.BookingRepository, hotelRepo domain.Ho
 		emailSender: emailSender,
 		userRepo:    userRepo,
 		notifRepo:   notifRepo,
+
+		currencyRepo:     currencyRepo,
+		documentRenderer: documentRenderer,
+		notifier:         notifier,
 	}
 }
 
@@ -61,6 +71,17 @@ func (bu *BookingUsecase) uploadFile(ctx context.Context, file *multipart.FileHe
 	return bu.fileStorage.UploadFile(ctx, f, file, bucketName, filename)
 }
 
+// queueEmail logs an email as pending and writes it to the outbox, in the transaction of ctx when there is one.
+// The outbox workers send it and update the status of the log.
+func (bu *BookingUsecase) queueEmail(ctx context.Context, scope constant.Scope, emailLog *entity.EmailLog) error {
+	emailLog.Scope = string(scope)
+	if err := bu.emailRepo.QueueEmail(ctx, emailLog, time.Time{}); err != nil {
+		logger.Error(ctx, "Failed to queue email:", err.Error())
+		return err
+	}
+	return nil
+}
+
 func (bu *BookingUsecase) summaryStatus(statuses []string, types string) string {
 	if len(statuses) == 0 {
 		return "No Status"
@@ -78,6 +99,7 @@ func (bu *BookingUsecase) summaryStatus(statuses []string, types string) string
 	case constant.ConstPayment:
 		priority = []string{
 			constant.StatusPaymentUnpaid,
+			constant.StatusPaymentPartiallyPaid,
 			constant.StatusPaymentPaid,
 		}
 	}
@@ -0,0 +1,43 @@
+package booking_usecase
+
+import (
+	"context"
+	"fmt"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+// documentBookingDetail returns the booking detail a document is rendered for, nil when it is not found. Agents only get
+// their own bookings.
+func (bu *BookingUsecase) documentBookingDetail(ctx context.Context, subBookingID string) (*entity.BookingDetail, error) {
+	userCtx, err := bu.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to get user from context", err.Error())
+		return nil, fmt.Errorf("failed to get user from context: %s", err.Error())
+	}
+
+	if userCtx == nil {
+		logger.Error(ctx, "user context is nil")
+		return nil, fmt.Errorf("user not found in context")
+	}
+
+	detailID, err := bu.bookingRepo.GetIDBySubBookingID(ctx, subBookingID)
+	if err != nil {
+		logger.Error(ctx, "failed to get ID by sub booking ID", err.Error())
+		return nil, err
+	}
+
+	details, err := bu.bookingRepo.GetBookingDetailsByIDs(ctx, []uint{detailID})
+	if err != nil {
+		logger.Error(ctx, "failed to get booking detail", err.Error())
+		return nil, err
+	}
+
+	if len(details) == 0 || (userCtx.RoleID == constant.RoleAgentID && details[0].Booking.AgentID != userCtx.ID) {
+		logger.Warn(ctx, "booking detail not found for document", subBookingID)
+		return nil, nil
+	}
+
+	return &details[0], nil
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/bookingdto"
 	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/currency"
 	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/pricing"
 	"wtm-backend/pkg/utils"
 )
 
@@ -25,60 +28,138 @@ func (bu *BookingUsecase) CancelBooking(ctx context.Context, req *bookingdto.Can
 	}
 
 	agentID := userCtx.ID
+	var bookingCode string
 
-	bookingDetail, err := bu.bookingRepo.CancelBooking(ctx, agentID, req.SubBookingID)
+	err = bu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		detailID, err := bu.bookingRepo.GetIDBySubBookingID(txCtx, req.SubBookingID)
+		if err != nil {
+			logger.Error(ctx, "failed to get ID by sub booking ID", err.Error())
+			return err
+		}
+
+		// Snapshot sebelum dibatalkan, untuk mengembalikan inventory kamar
+		details, err := bu.bookingRepo.GetBookingDetailsByIDs(txCtx, []uint{detailID})
+		if err != nil {
+			logger.Error(ctx, "failed to get booking detail", err.Error())
+			return err
+		}
+
+		if len(details) == 0 {
+			return fmt.Errorf("booking detail not found")
+		}
+		bookingCode = details[0].Booking.BookingCode
+		penalty, penaltyPercent, policy, err := bu.cancellationPenalty(txCtx, details[0])
+		if err != nil {
+			return err
+		}
+
+		bookingDetail, err := bu.bookingRepo.CancelBooking(txCtx, agentID, req.SubBookingID)
+		if err != nil {
+			logger.Error(ctx, "failed to cancel booking", err.Error())
+			return err
+		}
+
+		if err := bu.bookingRepo.UpdateBookingDetailCancellation(txCtx, detailID, penalty, penaltyPercent, policy.Name); err != nil {
+			logger.Error(ctx, "failed to record cancellation penalty", err.Error())
+			return err
+		}
+		bookingDetail.Currency = penalty.Currency()
+		bookingDetail.CancellationPenalty = penalty
+		bookingDetail.CancellationPenaltyPercent = penaltyPercent
+		bookingDetail.CancellationPolicyName = policy.Name
+
+		if err := bu.releaseRoomInventory(txCtx, details); err != nil {
+			return err
+		}
+
+		if err := bu.queueEmailNotificationHotelCancel(txCtx, bookingDetail); err != nil {
+			return err
+		}
+
+		err = bu.notifier.NotifyUser(txCtx, entity.Notification{
+			UserID:      agentID,
+			Title:       "Booking Status Cancelled",
+			Message:     fmt.Sprintf("Your Sub-booking ID: %s has been cancelled, please check Booking ID: %s", req.SubBookingID, bookingCode),
+			RedirectURL: fmt.Sprintf("%s/history-booking?search_by=booking_id&search=%s", bu.config.URLFEAgent, bookingCode),
+			Type:        constant.ConstBookingCancelled,
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		return bu.notifier.NotifyPermission(txCtx, constant.PermissionBookingEdit, entity.Notification{
+			Title:       "Booking Cancelled",
+			Message:     fmt.Sprintf("%s cancelled Sub-booking ID: %s of Booking ID: %s", userCtx.FullName, req.SubBookingID, bookingCode),
+			RedirectURL: fmt.Sprintf("%s/booking?search=%s", bu.config.URLFEAdmin, bookingCode),
+			Type:        constant.ConstCancel,
+		})
+	})
 	if err != nil {
-		logger.Error(ctx, "failed to cancel booking", err.Error())
 		return err
 	}
 
-	go func() {
-		newCtx, cancel := context.WithTimeout(context.Background(), bu.config.DurationCtxTOSlow)
-		defer cancel()
-		bu.sendEmailNotificationHotelCancel(newCtx, bookingDetail)
-	}()
-
 	return nil
 }
 
-func (bu *BookingUsecase) sendEmailNotificationHotelCancel(ctx context.Context, bd *entity.BookingDetail) {
+// cancellationPenalty computes the penalty of cancelling the booking detail now, with the policy snapshot taken
+// at checkout or the current policy for bookings checked out before policies existed.
+func (bu *BookingUsecase) cancellationPenalty(ctx context.Context, detail entity.BookingDetail) (currency.Money, float64, entity.CancellationPolicy, error) {
+	var policy entity.CancellationPolicy
+	if detail.DetailRooms.CancellationPolicy != nil {
+		policy = *detail.DetailRooms.CancellationPolicy
+	} else {
+		var err error
+		if policy, err = bu.cancellationPolicy(ctx, detail); err != nil {
+			return currency.Money{}, 0, policy, err
+		}
+	}
+
+	if detail.Currency == "" {
+		detail.Currency = "IDR" // Default fallback
+	}
+	total, err := bookingDetailTotal(detail)
+	if err != nil {
+		return currency.Money{}, 0, policy, err
+	}
+	penalty, percent := pricing.CancellationPenalty(policy, total, detail.CheckInDate, time.Now())
+	return penalty, percent, policy, nil
+}
+
+func (bu *BookingUsecase) queueEmailNotificationHotelCancel(ctx context.Context, bd *entity.BookingDetail) error {
 
 	if bd == nil {
 		logger.Error(ctx, "Booking Detail Data is Empty")
-		return
+		return nil
 	}
 
-	emailTemplate, err := bu.emailRepo.GetEmailTemplateByName(ctx, constant.EmailHotelBookingCancel)
+	emailTemplate, err := bu.emailRepo.GetEmailTemplateByName(ctx, constant.EmailHotelBookingCancel, bd.RoomPrice.RoomType.Hotel.Language)
 	if err != nil || emailTemplate == nil {
 		logger.Error(ctx, "Failed to get email template:", err)
-		return
+		return nil
 	}
 
 	data := HotelEmailDataCancel{
 		GuestName:   bd.Guest,
 		Period:      fmt.Sprintf("%s to %s", bd.CheckInDate.Format("02-01-2006"), bd.CheckOutDate.Format("02-01-2006")),
 		RoomType:    bd.RoomPrice.RoomType.Name,
-		Rate:        fmt.Sprintf("%.2f", bd.Price),
+		Rate:        bd.Price.String(),
 		BookingCode: bd.Booking.BookingCode,
 		Additional:  strings.Join(bd.BookingDetailAdditionalName, ", "),
-	}
 
-	if emailTemplate.IsSignatureImage && emailTemplate.Signature != "" {
-		data.SystemSignature = bu.assignSignatureEmail(emailTemplate.Signature)
+		CancellationPolicy:  bd.CancellationPolicyName,
+		CancellationPenalty: fmt.Sprintf("%s %s (%g%%)", bd.Currency, bd.CancellationPenalty, bd.CancellationPenaltyPercent),
 	}
 
-	if data.SystemSignature == "" && emailTemplate.Signature != "" {
-		data.SystemSignature = emailTemplate.Signature
-	}
+	var attachments []entity.EmailAttachment
+	data.SystemSignature, attachments = bu.signatureEmail(ctx, emailTemplate)
 
 	subjectParsed, err := utils.ParseTemplate(emailTemplate.Subject, data)
 	bodyHTML, err := utils.ParseTemplate(emailTemplate.Body, data)
 	if err != nil {
 		logger.Error(ctx, "Failed to parse hotel email template:", err)
-		return
+		return nil
 	}
 
-	var dataEmail bool
 	emailTo := bd.RoomPrice.RoomType.Hotel.Email
 
 	emailLog := entity.EmailLog{
@@ -86,36 +167,16 @@ func (bu *BookingUsecase) sendEmailNotificationHotelCancel(ctx context.Context,
 		Subject:         subjectParsed,
 		Body:            bodyHTML,
 		EmailTemplateID: uint(emailTemplate.ID),
+		TemplateName:    emailTemplate.Name,
 	}
 	metadataLog := entity.MetadataEmailLog{
 		HotelName:   bd.RoomPrice.RoomType.Hotel.Name,
 		BookingCode: bd.Booking.BookingCode,
 	}
 	emailLog.Meta = &metadataLog
+	emailLog.Attachments = attachments
 
-	statusEmailID := constant.StatusEmailSuccessID
-	if err = bu.emailRepo.CreateEmailLog(ctx, &emailLog); err != nil {
-		logger.Error(ctx, "Failed to create email log:", err)
-		dataEmail = false
-	} else {
-		dataEmail = true
-	}
-
-	err = bu.emailSender.Send(ctx, constant.ScopeHotel, emailTo, subjectParsed, bodyHTML, "Please view this email in HTML format.")
-	if err != nil {
-		logger.Error(ctx, "Failed to sending email:", err.Error())
-		statusEmailID = constant.StatusEmailFailedID
-		metadataLog.Notes = fmt.Sprintf("Failed to send email: %s", err.Error())
-		emailLog.Meta = &metadataLog
-	}
-
-	if dataEmail {
-		emailLog.StatusID = uint(statusEmailID)
-		if err := bu.emailRepo.UpdateStatusEmailLog(ctx, &emailLog); err != nil {
-			logger.Error(ctx, "Failed to update email log:", err.Error())
-		}
-	}
-
+	return bu.queueEmail(ctx, constant.ScopeHotel, &emailLog)
 }
 
 type HotelEmailDataCancel struct {
@@ -127,4 +188,7 @@ type HotelEmailDataCancel struct {
 	Remark          string
 	Additional      string
 	SystemSignature string // bisa berupa teks atau <img src="...">
+
+	CancellationPolicy  string // Name of the policy that applied
+	CancellationPenalty string // Penalty with currency and percentage, e.g. "IDR 1250000 (50%)"
 }
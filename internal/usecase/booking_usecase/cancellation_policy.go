@@ -0,0 +1,64 @@
+package booking_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/currency"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/pricing"
+)
+
+// cancellationPolicy returns the policy of a booking detail: the policy of the rate plan priced on the arrival night,
+// then the hotel policy, then the hotel CancellationPeriod. RoomPrice.RoomType.Hotel must be preloaded.
+func (bu *BookingUsecase) cancellationPolicy(ctx context.Context, detail entity.BookingDetail) (entity.CancellationPolicy, error) {
+	hotel := detail.RoomPrice.RoomType.Hotel
+
+	ratePlans, err := bu.hotelRepo.GetRoomRatePlans(ctx, []uint{detail.RoomPriceID}, detail.CheckInDate, detail.CheckInDate.AddDate(0, 0, 1))
+	if err != nil {
+		logger.Error(ctx, "failed to get room rate plans", err.Error())
+		return entity.CancellationPolicy{}, fmt.Errorf("failed to get cancellation policy: %s", err.Error())
+	}
+
+	policyID := hotel.CancellationPolicyID
+	if plan := pricing.RatePlanFor(ratePlans, detail.RoomPriceID, detail.CheckInDate); plan != nil && plan.CancellationPolicyID != nil {
+		policyID = plan.CancellationPolicyID
+	}
+	if policyID == nil {
+		return pricing.LegacyCancellationPolicy(hotel.CancellationPeriod), nil
+	}
+
+	policy, err := bu.hotelRepo.GetCancellationPolicyByID(ctx, *policyID)
+	if err != nil {
+		logger.Error(ctx, "failed to get cancellation policy", err.Error())
+		return entity.CancellationPolicy{}, fmt.Errorf("failed to get cancellation policy: %s", err.Error())
+	}
+	return *policy, nil
+}
+
+// freeCancellationDate formats the last date of free cancellation, empty when the policy is non-refundable.
+func freeCancellationDate(policy entity.CancellationPolicy, checkIn time.Time) string {
+	deadline, ok := pricing.FreeCancellationUntil(policy, checkIn)
+	if !ok {
+		return ""
+	}
+	return deadline.Format(time.DateOnly)
+}
+
+// bookingDetailTotal is the amount a cancellation penalty is taken from: the room price and the price-based additionals
+// for every room of the booking detail.
+func bookingDetailTotal(detail entity.BookingDetail) (currency.Money, error) {
+	total := detail.Price.WithCurrency(detail.Currency)
+	for _, additional := range detail.BookingDetailsAdditional {
+		if additional.Category == constant.AdditionalServiceCategoryPrice && additional.Price != nil {
+			price, err := currency.NewMoney(*additional.Price, detail.Currency)
+			if err != nil {
+				return currency.Money{}, err
+			}
+			total = total.Add(price)
+		}
+	}
+	return total.Mul(max(detail.Quantity, 1)), nil
+}
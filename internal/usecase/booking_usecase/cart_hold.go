@@ -0,0 +1,65 @@
+package booking_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/logger"
+)
+
+// validateCartHolds re-checks availability for cart details whose hold has expired,
+// since other agents may have taken the units in the meantime.
+func (bu *BookingUsecase) validateCartHolds(ctx context.Context, details []entity.BookingDetail) error {
+	cartDetailIDs := make([]uint, 0, len(details))
+	for _, detail := range details {
+		cartDetailIDs = append(cartDetailIDs, detail.ID)
+	}
+
+	// Units already claimed by expired details of this cart, per room type night
+	claimed := make(map[string]int)
+	for _, detail := range details {
+		expiresAt, err := bu.bookingRepo.GetCartHoldExpiry(ctx, detail.RoomPrice.RoomTypeID, detail.CheckInDate, detail.ID)
+		if err != nil {
+			logger.Error(ctx, "failed to get cart hold expiry", err.Error())
+			return fmt.Errorf("failed to check cart hold: %s", err.Error())
+		}
+		if !expiresAt.IsZero() {
+			continue
+		}
+
+		inventories, err := bu.hotelRepo.GetRoomInventories(ctx, []uint{detail.RoomPrice.RoomTypeID}, detail.CheckInDate, detail.CheckOutDate)
+		if err != nil {
+			logger.Error(ctx, "failed to get room inventory", err.Error())
+			return fmt.Errorf("failed to check room availability: %s", err.Error())
+		}
+
+		holds, err := bu.bookingRepo.CountCartHolds(ctx, detail.RoomPrice.RoomTypeID, detail.CheckInDate, detail.CheckOutDate, cartDetailIDs)
+		if err != nil {
+			logger.Error(ctx, "failed to count cart holds", err.Error())
+			return fmt.Errorf("failed to check room availability: %s", err.Error())
+		}
+
+		for _, inv := range inventories {
+			date := inv.Date.Format(time.DateOnly)
+			key := fmt.Sprintf("%d:%s", detail.RoomPrice.RoomTypeID, date)
+			remaining := inv.TotalUnit - inv.BookedUnit - holds[date] - claimed[key]
+			if remaining < detail.Quantity {
+				logger.Warn(ctx, "Expired cart hold no longer available", "bookingDetailID", detail.ID, "date", date)
+				return fmt.Errorf("the hold for %s has expired and it is no longer available on %s", detail.RoomPrice.RoomType.Name, date)
+			}
+			claimed[key] += detail.Quantity
+		}
+	}
+
+	return nil
+}
+
+// releaseCartHolds drops the holds of the given cart details, failures only leave the hold to expire.
+func (bu *BookingUsecase) releaseCartHolds(ctx context.Context, details []entity.BookingDetail) {
+	for _, detail := range details {
+		if err := bu.bookingRepo.ReleaseCartHold(ctx, detail.RoomPrice.RoomTypeID, detail.CheckInDate, detail.CheckOutDate, detail.ID); err != nil {
+			logger.Error(ctx, "failed to release cart hold", "bookingDetailID", detail.ID, err.Error())
+		}
+	}
+}
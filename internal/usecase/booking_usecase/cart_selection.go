@@ -0,0 +1,68 @@
+package booking_usecase
+
+import (
+	"errors"
+	"slices"
+	"time"
+	"wtm-backend/internal/domain/entity"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// validateCartSelection checks the promo and additionals an agent picked for a room price, the same way for add to
+// cart and quote. promo is the promo of promoID, nil when it does not exist.
+func validateCartSelection(agent *entity.User, roomPrice *entity.RoomPrice, checkInDate time.Time, nights int, promoID uint, promo *entity.Promo, additionalIDs []uint, additionals []entity.RoomTypeAdditional) error {
+	errs := validation.Errors{}
+	if promoID > 0 {
+		errs["promo_id"] = validatePromo(agent, roomPrice.RoomTypeID, checkInDate, nights, promo)
+	}
+	errs["room_type_additional_ids"] = validateAdditionals(roomPrice.RoomTypeID, additionalIDs, additionals)
+	return errs.Filter()
+}
+
+// validatePromo allows a promo that is active on the check-in date, offered to the agent's promo group and to the
+// room type, and not longer than the stay
+func validatePromo(agent *entity.User, roomTypeID uint, checkInDate time.Time, nights int, promo *entity.Promo) error {
+	if promo == nil {
+		return errors.New("Promo not found")
+	}
+	checkIn := checkInDate.Format(time.DateOnly)
+	if !promo.IsActive ||
+		(promo.StartDate != nil && checkIn < promo.StartDate.Format(time.DateOnly)) ||
+		(promo.EndDate != nil && checkIn > promo.EndDate.Format(time.DateOnly)) {
+		return errors.New("Promo is not active for the selected dates")
+	}
+
+	inGroup := agent != nil && agent.PromoGroupID != nil && slices.ContainsFunc(promo.PromoGroups, func(group entity.PromoGroup) bool {
+		return group.ID == *agent.PromoGroupID
+	})
+	if !inGroup {
+		return errors.New("Promo is not available for your account")
+	}
+
+	forRoomType := slices.ContainsFunc(promo.PromoRoomTypes, func(prt entity.PromoRoomType) bool {
+		return prt.RoomTypeID == roomTypeID
+	})
+	if !forRoomType {
+		return errors.New("Promo is not available for this room")
+	}
+
+	if promo.Duration > nights {
+		return errors.New("Promo is not valid for the selected stay duration")
+	}
+	return nil
+}
+
+// validateAdditionals allows only additionals of the room type, every requested one must exist
+func validateAdditionals(roomTypeID uint, additionalIDs []uint, additionals []entity.RoomTypeAdditional) error {
+	uniqueIDs := slices.Compact(slices.Sorted(slices.Values(additionalIDs)))
+	if len(additionals) != len(uniqueIDs) {
+		return errors.New("Additional services not found")
+	}
+	for _, add := range additionals {
+		if add.RoomTypeID != roomTypeID {
+			return errors.New("Additional services must belong to the selected room")
+		}
+	}
+	return nil
+}
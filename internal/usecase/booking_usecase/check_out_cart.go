@@ -10,12 +10,16 @@ import (
 	"wtm-backend/pkg/constant"
 	"wtm-backend/pkg/currency"
 	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/pricing"
 	"wtm-backend/pkg/utils"
 )
 
 func (bu *BookingUsecase) CheckOutCart(ctx context.Context) (*bookingdto.CheckOutCartResponse, error) {
 	var invoices []entity.Invoice
 	var bookingID uint
+	var cartDetails []entity.BookingDetail
+	var allGuests []GuestEmailInfo
+	var bookingCode, agentName string
 
 	err := bu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
 		// Get agent Id from context
@@ -49,6 +53,17 @@ func (bu *BookingUsecase) CheckOutCart(ctx context.Context) (*bookingdto.CheckOu
 		}
 
 		bookingID = booking.ID // Capture booking ID for email function
+		bookingCode = booking.BookingCode
+		agentName = user.FullName
+
+		// 2. Re-validate expired cart holds, then reserve room inventory, fails when a night is sold out
+		if err := bu.validateCartHolds(txCtx, booking.BookingDetails); err != nil {
+			return err
+		}
+		if err := bu.reserveRoomInventory(txCtx, booking.BookingDetails); err != nil {
+			return err
+		}
+		cartDetails = booking.BookingDetails
 
 		// 3. Update status to "in review"
 		if err := bu.bookingRepo.UpdateBookingStatus(txCtx, booking.ID, constant.StatusBookingWaitingApprovalID); err != nil {
@@ -59,13 +74,18 @@ func (bu *BookingUsecase) CheckOutCart(ctx context.Context) (*bookingdto.CheckOu
 		var countExpired int
 		// 4. Create Invoice Data
 		for _, detail := range booking.BookingDetails {
-			cancellationDate := detail.CheckInDate.AddDate(0, 0, detail.RoomPrice.RoomType.Hotel.CancellationPeriod)
+			// Snapshot the cancellation policy, the penalty on cancel follows the terms agreed at checkout
+			cancellationPolicy, err := bu.cancellationPolicy(txCtx, detail)
+			if err != nil {
+				return err
+			}
 			detailRoom := entity.DetailRoom{
-				HotelName:     detail.RoomPrice.RoomType.Hotel.Name,
-				RoomTypeName:  detail.RoomPrice.RoomType.Name,
-				Capacity:      detail.RoomPrice.RoomType.MaxOccupancy,
-				IsAPI:         detail.RoomPrice.RoomType.Hotel.IsAPI,
-				CancelledDate: cancellationDate.Format(time.DateOnly),
+				HotelName:          detail.RoomPrice.RoomType.Hotel.Name,
+				RoomTypeName:       detail.RoomPrice.RoomType.Name,
+				Capacity:           detail.RoomPrice.RoomType.MaxOccupancy,
+				IsAPI:              detail.RoomPrice.RoomType.Hotel.IsAPI,
+				CancelledDate:      freeCancellationDate(cancellationPolicy, detail.CheckInDate),
+				CancellationPolicy: &cancellationPolicy,
 			}
 			detail.DetailRooms = detailRoom
 
@@ -100,10 +120,7 @@ func (bu *BookingUsecase) CheckOutCart(ctx context.Context) (*bookingdto.CheckOu
 				countExpired++
 			}
 
-			var totalPrice float64
-			var descriptionItems []entity.DescriptionInvoice
 			var detailPromo entity.DetailPromo
-			nights := int(detail.CheckOutDate.Sub(detail.CheckInDate).Hours() / 24)
 
 			// Get currency from booking detail (snapshot at booking time)
 			bookingCurrency := detail.Currency
@@ -114,107 +131,37 @@ func (bu *BookingUsecase) CheckOutCart(ctx context.Context) (*bookingdto.CheckOu
 				bookingCurrency = "IDR" // Default fallback
 			}
 
-			// Get base price from multi-currency Prices map, fallback to deprecated Price field
-			var oriPrice float64
-			if len(detail.RoomPrice.Prices) > 0 {
-				// Use Prices map for multi-currency support
-				if price, _, err := currency.GetPriceForCurrency(detail.RoomPrice.Prices, bookingCurrency); err == nil {
-					oriPrice = price
-				} else {
-					// Fallback to Price field if currency not found in Prices map
-					oriPrice = detail.RoomPrice.Price
-				}
-			} else {
-				// Fallback to deprecated Price field if Prices map is empty
-				oriPrice = detail.RoomPrice.Price
+			// Exchange rate in effect at checkout, derives prices not entered in the booking currency
+			exchangeRate, err := bu.exchangeRate(txCtx, bookingCurrency)
+			if err != nil {
+				return err
 			}
 
-			priceRoom := float64(nights) * oriPrice
-			roomPrice := oriPrice
+			// Price the stay: nightly rates with seasonal rate plans, promo and additionals
+			quote, err := bu.quoteBookingDetail(txCtx, detail, bookingCurrency, exchangeRate, quoteAdditionals(detail))
+			if err != nil {
+				return err
+			}
+			// The rate is only snapshot when a price was converted with it
+			exchangeRate = quote.ExchangeRate
 
 			if detail.Promo != nil {
-				promo := detail.Promo
-				detailPromo, err = bu.generateDetailPromo(promo)
+				detailPromo, err = bu.generateDetailPromo(detail.Promo)
 				if err != nil {
 					logger.Error(ctx, "failed to generate detail promo", err.Error())
 				}
 				// snapshot promo both on booking detail and on invoice detail
 				detail.DetailPromos = detailPromo
 				invoiceData.DetailInvoice.Promo = detailPromo
-				switch detail.Promo.PromoTypeID {
-				case constant.PromoTypeFixedPriceID:
-					// Use Prices map for multi-currency support
-					if len(promo.Detail.Prices) > 0 {
-						// Get price for the booking currency
-						if price, _, err := currency.GetPriceForCurrency(promo.Detail.Prices, bookingCurrency); err == nil {
-							roomPrice = price
-						} else {
-							// Fallback to FixedPrice if Prices not available (backward compatibility)
-							if promo.Detail.FixedPrice > 0 {
-								roomPrice = promo.Detail.FixedPrice
-							}
-						}
-					} else if promo.Detail.FixedPrice > 0 {
-						// Backward compatibility: use FixedPrice if Prices not set
-						roomPrice = promo.Detail.FixedPrice
-					}
-					if promo.Duration > nights {
-						roomPrice += float64(nights-promo.Duration) * oriPrice
-					}
-				case constant.PromoTypeDiscountID:
-					roomPrice = (100 - promo.Detail.DiscountPercentage) / 100 * oriPrice * float64(nights)
-					if promo.Duration > nights {
-						roomPrice += float64(nights-promo.Duration) * oriPrice
-					}
-				default:
-					roomPrice = roomPrice * float64(nights)
-				}
-			} else {
-				// No promo: multiply base price by number of nights
-				roomPrice = oriPrice * float64(nights)
-			}
-			detail.Price = roomPrice
-			itemRoom := entity.DescriptionInvoice{
-				Description:      detail.DetailRooms.RoomTypeName,
-				Quantity:         nights,
-				Unit:             constant.UnitNight,
-				Price:            oriPrice,
-				TotalBeforePromo: priceRoom,
-				Total:            detail.Price,
 			}
-			totalPrice += itemRoom.Total
-			descriptionItems = append(descriptionItems, itemRoom)
+			detail.Price = quote.RoomTotal
+			descriptionItems := quote.Items
+			totalPrice := quote.Total
+
 			var bookingDetailAdditionalName []string
 			var otherPreferences []string
 			for _, additional := range detail.BookingDetailsAdditional {
 				bookingDetailAdditionalName = append(bookingDetailAdditionalName, additional.NameAdditional)
-
-				itemAdditional := entity.DescriptionInvoice{
-					Description: additional.NameAdditional,
-					Category:    additional.Category,
-					IsRequired:  additional.IsRequired,
-				}
-
-				// Handle price-based additionals
-				if additional.Category == constant.AdditionalServiceCategoryPrice && additional.Price != nil {
-					quantity := 1
-					price := *additional.Price
-					priceAdditional := float64(quantity) * price
-					itemAdditional.Quantity = quantity
-					itemAdditional.Unit = constant.UnitPax
-					itemAdditional.Price = price
-					itemAdditional.Total = priceAdditional
-					totalPrice += priceAdditional
-				} else if additional.Category == constant.AdditionalServiceCategoryPax && additional.Pax != nil {
-					// Handle pax-based additionals (informational only, no charge)
-					itemAdditional.Quantity = *additional.Pax
-					itemAdditional.Unit = constant.UnitPax
-					itemAdditional.Price = 0
-					itemAdditional.Total = 0
-					itemAdditional.Pax = additional.Pax
-				}
-
-				descriptionItems = append(descriptionItems, itemAdditional)
 			}
 
 			// Add "Other Preferences" as informational invoice lines (no charge)
@@ -226,8 +173,8 @@ func (bu *BookingUsecase) CheckOutCart(ctx context.Context) (*bookingdto.CheckOu
 							Description: name,
 							Quantity:    1,
 							Unit:        "preference",
-							Price:       0,
-							Total:       0,
+							Price:       currency.ZeroMoney(bookingCurrency),
+							Total:       currency.ZeroMoney(bookingCurrency),
 						}
 						descriptionItems = append(descriptionItems, itemPref)
 					}
@@ -237,6 +184,13 @@ func (bu *BookingUsecase) CheckOutCart(ctx context.Context) (*bookingdto.CheckOu
 			invoiceData.DetailInvoice.DescriptionInvoice = descriptionItems
 			invoiceData.DetailInvoice.TotalPrice = totalPrice
 			invoiceData.DetailInvoice.Currency = bookingCurrency // Set currency for invoice
+			if exchangeRate != nil {
+				detail.ExchangeRate = exchangeRate.Rate
+				detail.ExchangeRateMarkup = exchangeRate.MarkupPercent
+				invoiceData.DetailInvoice.ExchangeRate = exchangeRate.Rate
+				invoiceData.DetailInvoice.ExchangeRateMarkup = exchangeRate.MarkupPercent
+				invoiceData.DetailInvoice.ExchangeRateDate = exchangeRate.EffectiveDate.Format(time.DateOnly)
+			}
 			invoiceData.BookingDetail = detail
 			invoiceData.BookingDetail.Price = detail.Price
 			invoiceData.BookingDetail.Booking.BookingCode = booking.BookingCode
@@ -248,6 +202,12 @@ func (bu *BookingUsecase) CheckOutCart(ctx context.Context) (*bookingdto.CheckOu
 				logger.Error(ctx, "failed to update booking", err.Error())
 				return fmt.Errorf("failed to update booking: %s", err.Error())
 			}
+			if exchangeRate != nil {
+				if err = bu.bookingRepo.UpdateBookingDetailExchangeRate(txCtx, detail.ID, exchangeRate.Rate, exchangeRate.MarkupPercent); err != nil {
+					logger.Error(ctx, "failed to snapshot exchange rate", err.Error())
+					return fmt.Errorf("failed to update booking: %s", err.Error())
+				}
+			}
 		}
 
 		if countExpired > 0 {
@@ -261,7 +221,28 @@ func (bu *BookingUsecase) CheckOutCart(ctx context.Context) (*bookingdto.CheckOu
 			return fmt.Errorf("failed to create invoice: %s", err.Error())
 		}
 
-		return nil
+		// Get all guests for the booking BEFORE they are deleted
+		// This must be done before deleting guests, as they are needed for emails
+		allGuests = bu.getGuestsForEmail(txCtx, bookingID)
+
+		// Group bookings by hotel email, one consolidated email per hotel is queued with the booking
+		hotelEmailMap := make(map[string][]entity.BookingDetail)
+		for _, invoice := range invoices {
+			hotelEmail := invoice.BookingDetail.RoomPrice.RoomType.Hotel.Email
+			hotelEmailMap[hotelEmail] = append(hotelEmailMap[hotelEmail], invoice.BookingDetail)
+		}
+		for _, bookingDetails := range hotelEmailMap {
+			if err = bu.queueConsolidatedEmailNotificationHotelConfirm(txCtx, bookingDetails, allGuests); err != nil {
+				return fmt.Errorf("failed to queue hotel email: %s", err.Error())
+			}
+		}
+
+		return bu.notifier.NotifyPermission(txCtx, constant.PermissionBookingEdit, entity.Notification{
+			Title:       "New Booking",
+			Message:     fmt.Sprintf("%s checked out Booking ID: %s with %d sub-booking(s), waiting approval", agentName, bookingCode, len(cartDetails)),
+			RedirectURL: fmt.Sprintf("%s/booking?search=%s", bu.config.URLFEAdmin, bookingCode),
+			Type:        constant.ConstNewBooking,
+		})
 	})
 
 	if err != nil {
@@ -269,16 +250,16 @@ func (bu *BookingUsecase) CheckOutCart(ctx context.Context) (*bookingdto.CheckOu
 		return nil, err
 	}
 
+	// Units are now booked in the inventory ledger, the cart holds are no longer needed
+	bu.releaseCartHolds(ctx, cartDetails)
+
 	// Consolidate invoices into one invoice with all booking details
 	// Group all items by sub-booking ID for clear separation
 	var consolidatedItems []entity.DescriptionInvoice
-	var consolidatedTotalPrice float64
+	var consolidatedTotalPrice currency.Money
 	var consolidatedCurrency string
 	var consolidatedSubBookingIDs []string
 
-	// Get all guests for the booking BEFORE they are deleted
-	// This must be done before deleting guests, as they are needed for emails
-	allGuests := bu.getGuestsForEmail(ctx, bookingID)
 	var guestNamesList []string
 	for _, guest := range allGuests {
 		guestStr := fmt.Sprintf("%s %s (%s", guest.Honorific, guest.Name, guest.Category)
@@ -314,7 +295,7 @@ func (bu *BookingUsecase) CheckOutCart(ctx context.Context) (*bookingdto.CheckOu
 	for _, invoice := range invoices {
 		subBookingID := invoice.DetailInvoice.SubBookingID
 		consolidatedSubBookingIDs = append(consolidatedSubBookingIDs, subBookingID)
-		consolidatedTotalPrice += invoice.DetailInvoice.TotalPrice
+		consolidatedTotalPrice = consolidatedTotalPrice.Add(invoice.DetailInvoice.TotalPrice)
 
 		// Collect hotel names
 		hotelNamesMap[invoice.DetailInvoice.Hotel] = true
@@ -335,8 +316,8 @@ func (bu *BookingUsecase) CheckOutCart(ctx context.Context) (*bookingdto.CheckOu
 				Description: separatorDescription,
 				Quantity:    0,
 				Unit:        "separator",
-				Price:       0,
-				Total:       0,
+				Price:       currency.ZeroMoney(consolidatedCurrency),
+				Total:       currency.ZeroMoney(consolidatedCurrency),
 			}
 			consolidatedItems = append(consolidatedItems, separatorItem)
 		} else {
@@ -345,8 +326,8 @@ func (bu *BookingUsecase) CheckOutCart(ctx context.Context) (*bookingdto.CheckOu
 				Description: separatorDescription,
 				Quantity:    0,
 				Unit:        "separator",
-				Price:       0,
-				Total:       0,
+				Price:       currency.ZeroMoney(consolidatedCurrency),
+				Total:       currency.ZeroMoney(consolidatedCurrency),
 			}
 			consolidatedItems = append(consolidatedItems, headerItem)
 		}
@@ -393,6 +374,9 @@ func (bu *BookingUsecase) CheckOutCart(ctx context.Context) (*bookingdto.CheckOu
 		Promo:              entity.DetailPromo{}, // Promos are per sub-booking
 		TotalPrice:         consolidatedTotalPrice,
 		Currency:           consolidatedCurrency,
+		ExchangeRate:       baseInvoice.DetailInvoice.ExchangeRate,
+		ExchangeRateMarkup: baseInvoice.DetailInvoice.ExchangeRateMarkup,
+		ExchangeRateDate:   baseInvoice.DetailInvoice.ExchangeRateDate,
 	}
 
 	// Create consolidated invoice response
@@ -406,96 +390,29 @@ func (bu *BookingUsecase) CheckOutCart(ctx context.Context) (*bookingdto.CheckOu
 		},
 	}
 
-	// Group bookings by hotel email for consolidated email sending
-	hotelEmailMap := make(map[string][]entity.BookingDetail)
-	for _, invoice := range invoices {
-		hotelEmail := invoice.BookingDetail.RoomPrice.RoomType.Hotel.Email
-		hotelEmailMap[hotelEmail] = append(hotelEmailMap[hotelEmail], invoice.BookingDetail)
-	}
-
-	// Send one consolidated email per hotel
-	// Pass guests as parameter since they're already retrieved and will be deleted
-	for hotelEmail, bookingDetails := range hotelEmailMap {
-		go func(email string, details []entity.BookingDetail, guests []GuestEmailInfo) {
-			newCtx, cancel := context.WithTimeout(context.Background(), bu.config.DurationCtxTOSlow)
-			defer cancel()
-			bu.sendConsolidatedEmailNotificationHotelConfirm(newCtx, details, bookingID, guests)
-		}(hotelEmail, bookingDetails, allGuests)
-	}
-
 	return resp, nil
 }
 
-func (bu *BookingUsecase) sendEmailNotificationHotelConfirm(ctx context.Context, bd entity.BookingDetail, bookingID uint) {
-
-	logger.Info(ctx, "Data details:", bd)
-
-	emailTemplate, err := bu.emailRepo.GetEmailTemplateByName(ctx, constant.EmailHotelBookingRequest)
-	if err != nil || emailTemplate == nil {
-		logger.Error(ctx, "Failed to get email template:", err)
-		return
-	}
-
-	// Get full guest information from database
-	guests := bu.getGuestsForEmail(ctx, bookingID)
-
-	// Calculate room rate in IDR (always use IDR for hotel emails)
-	// Note: bd.RoomPrice.Prices contains all currencies for the selected room price option.
-	// For example, if agent selected room price ID 3 with {"IDR": 250000, "KRW": 10000},
-	// and agent checked out using KRW, we extract IDR 250000 from the same Prices map.
-	nights := int(bd.CheckOutDate.Sub(bd.CheckInDate).Hours() / 24)
-	var rateIDR float64
-
-	// Get base price in IDR from RoomPrice.Prices map
-	// This gets the IDR price from the SAME room price option that the agent selected
-	var basePriceIDR float64
-	if len(bd.RoomPrice.Prices) > 0 {
-		if price, _, err := currency.GetPriceForCurrency(bd.RoomPrice.Prices, "IDR"); err == nil {
-			basePriceIDR = price
-		} else {
-			// Fallback to Price field if IDR not found in Prices map
-			basePriceIDR = bd.RoomPrice.Price
-		}
-	} else {
-		// Fallback to deprecated Price field if Prices map is empty
-		basePriceIDR = bd.RoomPrice.Price
-	}
+// GuestEmailInfo represents guest information for email template
+type GuestEmailInfo struct {
+	Name      string
+	Honorific string
+	Category  string
+	Age       string // formatted as string, empty if nil
+}
 
-	// Recalculate rate in IDR with promo if applicable
-	if bd.Promo != nil {
-		promo := bd.Promo
-		switch promo.PromoTypeID {
-		case constant.PromoTypeFixedPriceID:
-			// Use Prices map for multi-currency support, get IDR price
-			if len(promo.Detail.Prices) > 0 {
-				if price, _, err := currency.GetPriceForCurrency(promo.Detail.Prices, "IDR"); err == nil {
-					rateIDR = price
-				} else {
-					// Fallback to FixedPrice if IDR not available (backward compatibility)
-					if promo.Detail.FixedPrice > 0 {
-						rateIDR = promo.Detail.FixedPrice
-					}
-				}
-			} else if promo.Detail.FixedPrice > 0 {
-				// Backward compatibility: use FixedPrice if Prices not set
-				rateIDR = promo.Detail.FixedPrice
-			}
-			if promo.Duration > nights {
-				rateIDR += float64(nights-promo.Duration) * basePriceIDR
-			}
-		case constant.PromoTypeDiscountID:
-			rateIDR = (100 - promo.Detail.DiscountPercentage) / 100 * basePriceIDR * float64(nights)
-			if promo.Duration > nights {
-				rateIDR += float64(nights-promo.Duration) * basePriceIDR
-			}
-		default:
-			rateIDR = basePriceIDR * float64(nights)
-		}
-	} else {
-		// No promo: multiply base price by number of nights
-		rateIDR = basePriceIDR * float64(nights)
-	}
+// AdditionalServiceEmailInfo represents additional service information for email template
+type AdditionalServiceEmailInfo struct {
+	Name       string
+	Category   string
+	Price      string // formatted as string, empty if not price-based
+	Pax        string // formatted as string, empty if not pax-based
+	IsRequired bool
+}
 
+// hotelEmailRate prices a booking detail in IDR for the hotel, with the same engine as cart and checkout.
+// Additional services take the IDR price of the original RoomTypeAdditional, the snapshot is in the agent's currency.
+func (bu *BookingUsecase) hotelEmailRate(ctx context.Context, bd entity.BookingDetail) (currency.Money, []AdditionalServiceEmailInfo) {
 	// Fetch original RoomTypeAdditional to get IDR prices for additional services
 	var roomTypeAdditionalIDs []uint
 	for _, additional := range bd.BookingDetailsAdditional {
@@ -515,32 +432,44 @@ func (bu *BookingUsecase) sendEmailNotificationHotelConfirm(ctx context.Context,
 		}
 	}
 
-	// Format additional services with IDR prices
+	additionals := quoteAdditionals(bd)
+	for i, additional := range bd.BookingDetailsAdditional {
+		if additional.Category != constant.AdditionalServiceCategoryPrice {
+			continue
+		}
+		if rta, exists := roomTypeAdditionalsMap[additional.RoomTypeAdditionalID]; exists {
+			additionals[i].Price = pricing.AdditionalPrice(rta, "IDR", nil)
+		} else if additional.Price != nil {
+			// Last resort: use the stored price (may not be IDR, but better than nothing)
+			logger.Warn(ctx, fmt.Sprintf("RoomTypeAdditional not found for ID %d, using stored price", additional.RoomTypeAdditionalID))
+		}
+	}
+
+	quote, err := bu.quoteBookingDetail(ctx, bd, "IDR", nil, additionals)
+	if err != nil {
+		logger.Error(ctx, "Failed to price booking detail for hotel email", err.Error())
+		return currency.ZeroMoney("IDR"), nil
+	}
+
+	// Price lines of the quote are matched to the additionals by name, same names are taken in turn
+	quotedPrices := make(map[string][]currency.Money)
+	for _, item := range quote.Items {
+		if item.Category == constant.AdditionalServiceCategoryPrice {
+			quotedPrices[item.Description] = append(quotedPrices[item.Description], item.Price)
+		}
+	}
+
 	var additionalServices []AdditionalServiceEmailInfo
-	for _, additional := range bd.BookingDetailsAdditional {
+	for i, additional := range bd.BookingDetailsAdditional {
 		serviceInfo := AdditionalServiceEmailInfo{
 			Name:       additional.NameAdditional,
 			Category:   additional.Category,
 			IsRequired: additional.IsRequired,
 		}
-		if additional.Category == constant.AdditionalServiceCategoryPrice {
-			// Get IDR price from original RoomTypeAdditional
-			if rta, exists := roomTypeAdditionalsMap[additional.RoomTypeAdditionalID]; exists {
-				if len(rta.Prices) > 0 {
-					if priceIDR, _, err := currency.GetPriceForCurrency(rta.Prices, "IDR"); err == nil {
-						serviceInfo.Price = fmt.Sprintf("%.2f", priceIDR)
-					} else if rta.Price != nil {
-						// Fallback to Price field if IDR not found
-						serviceInfo.Price = fmt.Sprintf("%.2f", *rta.Price)
-					}
-				} else if rta.Price != nil {
-					// Fallback to Price field if Prices map is empty
-					serviceInfo.Price = fmt.Sprintf("%.2f", *rta.Price)
-				}
-			} else if additional.Price != nil {
-				// Last resort: use the stored price (may not be IDR, but better than nothing)
-				logger.Warn(ctx, fmt.Sprintf("RoomTypeAdditional not found for ID %d, using stored price", additional.RoomTypeAdditionalID))
-				serviceInfo.Price = fmt.Sprintf("%.2f", *additional.Price)
+		if additional.Category == constant.AdditionalServiceCategoryPrice && additionals[i].Price != nil {
+			if prices := quotedPrices[additional.NameAdditional]; len(prices) > 0 {
+				serviceInfo.Price = fmt.Sprintf("%.2f", prices[0].Float64())
+				quotedPrices[additional.NameAdditional] = prices[1:]
 			}
 		} else if additional.Category == constant.AdditionalServiceCategoryPax && additional.Pax != nil {
 			serviceInfo.Pax = fmt.Sprintf("%d", *additional.Pax)
@@ -548,91 +477,7 @@ func (bu *BookingUsecase) sendEmailNotificationHotelConfirm(ctx context.Context,
 		additionalServices = append(additionalServices, serviceInfo)
 	}
 
-	// Format bed types
-	bedTypesStr := strings.Join(bd.BedTypeNames, ", ")
-
-	data := HotelEmailData{
-		Guests:             guests,
-		GuestName:          bd.Guest, // Keep for backward compatibility
-		Period:             fmt.Sprintf("%s to %s", bd.CheckInDate.Format("02-01-2006"), bd.CheckOutDate.Format("02-01-2006")),
-		RoomType:           bd.DetailRooms.RoomTypeName,
-		BedTypes:           bedTypesStr,
-		Rate:               fmt.Sprintf("%.2f", rateIDR), // Use IDR price
-		BookingCode:        bd.Booking.BookingCode,
-		Additional:         strings.Join(bd.BookingDetailAdditionalName, ", "), // Keep for backward compatibility
-		AdditionalServices: additionalServices,
-	}
-
-	if emailTemplate.IsSignatureImage && emailTemplate.Signature != "" {
-		data.SystemSignature = bu.assignSignatureEmail(emailTemplate.Signature)
-	}
-
-	if data.SystemSignature == "" && emailTemplate.Signature != "" {
-		data.SystemSignature = emailTemplate.Signature
-	}
-
-	subjectParsed, err := utils.ParseTemplate(emailTemplate.Subject, data)
-	bodyHTML, err := utils.ParseTemplate(emailTemplate.Body, data)
-	if err != nil {
-		logger.Error(ctx, "Failed to parse hotel email template:", err)
-		return
-	}
-
-	emailTo := bd.RoomPrice.RoomType.Hotel.Email
-
-	emailLog := entity.EmailLog{
-		To:              emailTo,
-		Subject:         subjectParsed,
-		Body:            bodyHTML,
-		EmailTemplateID: uint(emailTemplate.ID),
-	}
-	metadataLog := entity.MetadataEmailLog{
-		HotelName:   bd.RoomPrice.RoomType.Hotel.Name,
-		BookingCode: bd.Booking.BookingCode,
-	}
-	emailLog.Meta = &metadataLog
-
-	var dataEmail bool
-	statusEmailID := constant.StatusEmailSuccessID
-	if err = bu.emailRepo.CreateEmailLog(ctx, &emailLog); err != nil {
-		logger.Error(ctx, "Failed to create email log:", err)
-		dataEmail = false
-	} else {
-		dataEmail = true
-	}
-
-	err = bu.emailSender.Send(ctx, constant.ScopeHotel, emailTo, subjectParsed, bodyHTML, "Please view this email in HTML format.")
-	if err != nil {
-		logger.Error(ctx, "Failed to sending email:", err.Error())
-		statusEmailID = constant.StatusEmailFailedID
-		metadataLog.Notes = fmt.Sprintf("Failed to send email: %s", err.Error())
-		emailLog.Meta = &metadataLog
-	}
-
-	if dataEmail {
-		emailLog.StatusID = uint(statusEmailID)
-		if err := bu.emailRepo.UpdateStatusEmailLog(ctx, &emailLog); err != nil {
-			logger.Error(ctx, "Failed to update email log:", err.Error())
-		}
-	}
-
-}
-
-// GuestEmailInfo represents guest information for email template
-type GuestEmailInfo struct {
-	Name      string
-	Honorific string
-	Category  string
-	Age       string // formatted as string, empty if nil
-}
-
-// AdditionalServiceEmailInfo represents additional service information for email template
-type AdditionalServiceEmailInfo struct {
-	Name       string
-	Category   string
-	Price      string // formatted as string, empty if not price-based
-	Pax        string // formatted as string, empty if not pax-based
-	IsRequired bool
+	return quote.RoomTotal, additionalServices
 }
 
 // getGuestsForEmail retrieves guest information from database for email template
@@ -663,26 +508,26 @@ func (bu *BookingUsecase) getGuestsForEmail(ctx context.Context, bookingID uint)
 	return guests
 }
 
-// sendConsolidatedEmailNotificationHotelConfirm sends one email per hotel with all booking details
-func (bu *BookingUsecase) sendConsolidatedEmailNotificationHotelConfirm(ctx context.Context, bookingDetails []entity.BookingDetail, bookingID uint, guests []GuestEmailInfo) {
+// queueConsolidatedEmailNotificationHotelConfirm queues one email per hotel with all booking details
+func (bu *BookingUsecase) queueConsolidatedEmailNotificationHotelConfirm(ctx context.Context, bookingDetails []entity.BookingDetail, guests []GuestEmailInfo) error {
 	if len(bookingDetails) == 0 {
 		logger.Warn(ctx, "No booking details provided for consolidated email")
-		return
+		return nil
 	}
 
-	logger.Info(ctx, fmt.Sprintf("Sending consolidated email for %d booking details", len(bookingDetails)))
+	logger.Info(ctx, fmt.Sprintf("Queueing consolidated email for %d booking details", len(bookingDetails)))
+
+	// Get hotel info from first booking detail (all should be same hotel)
+	firstBD := bookingDetails[0]
 
-	emailTemplate, err := bu.emailRepo.GetEmailTemplateByName(ctx, constant.EmailHotelBookingRequest)
+	emailTemplate, err := bu.emailRepo.GetEmailTemplateByName(ctx, constant.EmailHotelBookingRequest, firstBD.RoomPrice.RoomType.Hotel.Language)
 	if err != nil || emailTemplate == nil {
 		logger.Error(ctx, "Failed to get email template:", err)
-		return
+		return nil
 	}
 
 	// Use guests passed as parameter (retrieved before deletion)
-	// This ensures all guests are included even after they're deleted from the database
 
-	// Get hotel info from first booking detail (all should be same hotel)
-	firstBD := bookingDetails[0]
 	hotelEmail := firstBD.RoomPrice.RoomType.Hotel.Email
 	hotelName := firstBD.RoomPrice.RoomType.Hotel.Name
 	bookingCode := firstBD.Booking.BookingCode
@@ -690,97 +535,8 @@ func (bu *BookingUsecase) sendConsolidatedEmailNotificationHotelConfirm(ctx cont
 	// Build consolidated booking details
 	var consolidatedBookings []ConsolidatedBookingDetail
 	for index, bd := range bookingDetails {
-		// Calculate room rate in IDR (always use IDR for hotel emails)
-		nights := int(bd.CheckOutDate.Sub(bd.CheckInDate).Hours() / 24)
-		var rateIDR float64
-
-		// Get base price in IDR from RoomPrice.Prices map
-		var basePriceIDR float64
-		if len(bd.RoomPrice.Prices) > 0 {
-			if price, _, err := currency.GetPriceForCurrency(bd.RoomPrice.Prices, "IDR"); err == nil {
-				basePriceIDR = price
-			} else {
-				basePriceIDR = bd.RoomPrice.Price
-			}
-		} else {
-			basePriceIDR = bd.RoomPrice.Price
-		}
-
-		// Recalculate rate in IDR with promo if applicable
-		if bd.Promo != nil {
-			promo := bd.Promo
-			switch promo.PromoTypeID {
-			case constant.PromoTypeFixedPriceID:
-				if len(promo.Detail.Prices) > 0 {
-					if price, _, err := currency.GetPriceForCurrency(promo.Detail.Prices, "IDR"); err == nil {
-						rateIDR = price
-					} else if promo.Detail.FixedPrice > 0 {
-						rateIDR = promo.Detail.FixedPrice
-					}
-				} else if promo.Detail.FixedPrice > 0 {
-					rateIDR = promo.Detail.FixedPrice
-				}
-				if promo.Duration > nights {
-					rateIDR += float64(nights-promo.Duration) * basePriceIDR
-				}
-			case constant.PromoTypeDiscountID:
-				rateIDR = (100 - promo.Detail.DiscountPercentage) / 100 * basePriceIDR * float64(nights)
-				if promo.Duration > nights {
-					rateIDR += float64(nights-promo.Duration) * basePriceIDR
-				}
-			default:
-				rateIDR = basePriceIDR * float64(nights)
-			}
-		} else {
-			rateIDR = basePriceIDR * float64(nights)
-		}
-
-		// Fetch original RoomTypeAdditional to get IDR prices for additional services
-		var roomTypeAdditionalIDs []uint
-		for _, additional := range bd.BookingDetailsAdditional {
-			roomTypeAdditionalIDs = append(roomTypeAdditionalIDs, additional.RoomTypeAdditionalID)
-		}
-
-		roomTypeAdditionalsMap := make(map[uint]entity.RoomTypeAdditional)
-		if len(roomTypeAdditionalIDs) > 0 {
-			roomTypeAdditionals, err := bu.hotelRepo.GetRoomTypeAdditionalsByIDs(ctx, roomTypeAdditionalIDs)
-			if err != nil {
-				logger.Error(ctx, "Failed to get room type additionals for IDR prices", err.Error())
-			} else {
-				for _, rta := range roomTypeAdditionals {
-					roomTypeAdditionalsMap[rta.ID] = rta
-				}
-			}
-		}
-
-		// Format additional services with IDR prices
-		var additionalServices []AdditionalServiceEmailInfo
-		for _, additional := range bd.BookingDetailsAdditional {
-			serviceInfo := AdditionalServiceEmailInfo{
-				Name:       additional.NameAdditional,
-				Category:   additional.Category,
-				IsRequired: additional.IsRequired,
-			}
-			if additional.Category == constant.AdditionalServiceCategoryPrice {
-				if rta, exists := roomTypeAdditionalsMap[additional.RoomTypeAdditionalID]; exists {
-					if len(rta.Prices) > 0 {
-						if priceIDR, _, err := currency.GetPriceForCurrency(rta.Prices, "IDR"); err == nil {
-							serviceInfo.Price = fmt.Sprintf("%.2f", priceIDR)
-						} else if rta.Price != nil {
-							serviceInfo.Price = fmt.Sprintf("%.2f", *rta.Price)
-						}
-					} else if rta.Price != nil {
-						serviceInfo.Price = fmt.Sprintf("%.2f", *rta.Price)
-					}
-				} else if additional.Price != nil {
-					logger.Warn(ctx, fmt.Sprintf("RoomTypeAdditional not found for ID %d, using stored price", additional.RoomTypeAdditionalID))
-					serviceInfo.Price = fmt.Sprintf("%.2f", *additional.Price)
-				}
-			} else if additional.Category == constant.AdditionalServiceCategoryPax && additional.Pax != nil {
-				serviceInfo.Pax = fmt.Sprintf("%d", *additional.Pax)
-			}
-			additionalServices = append(additionalServices, serviceInfo)
-		}
+		// Room rate and additional services in IDR (always use IDR for hotel emails)
+		rateIDR, additionalServices := bu.hotelEmailRate(ctx, bd)
 
 		// Format bed types
 		bedTypesStr := strings.Join(bd.BedTypeNames, ", ")
@@ -792,7 +548,7 @@ func (bu *BookingUsecase) sendConsolidatedEmailNotificationHotelConfirm(ctx cont
 			Period:             fmt.Sprintf("%s to %s", bd.CheckInDate.Format("02-01-2006"), bd.CheckOutDate.Format("02-01-2006")),
 			RoomType:           bd.DetailRooms.RoomTypeName,
 			BedTypes:           bedTypesStr,
-			Rate:               fmt.Sprintf("%.2f", rateIDR),
+			Rate:               fmt.Sprintf("%.2f", rateIDR.Float64()),
 			AdditionalServices: additionalServices,
 			Additional:         strings.Join(bd.BookingDetailAdditionalName, ", "),
 		}
@@ -813,19 +569,14 @@ func (bu *BookingUsecase) sendConsolidatedEmailNotificationHotelConfirm(ctx cont
 		BookingDetails:     consolidatedBookings,
 	}
 
-	if emailTemplate.IsSignatureImage && emailTemplate.Signature != "" {
-		data.SystemSignature = bu.assignSignatureEmail(emailTemplate.Signature)
-	}
-
-	if data.SystemSignature == "" && emailTemplate.Signature != "" {
-		data.SystemSignature = emailTemplate.Signature
-	}
+	var attachments []entity.EmailAttachment
+	data.SystemSignature, attachments = bu.signatureEmail(ctx, emailTemplate)
 
 	subjectParsed, err := utils.ParseTemplate(emailTemplate.Subject, data)
 	bodyHTML, err := utils.ParseTemplate(emailTemplate.Body, data)
 	if err != nil {
 		logger.Error(ctx, "Failed to parse hotel email template:", err)
-		return
+		return nil
 	}
 
 	emailLog := entity.EmailLog{
@@ -833,36 +584,16 @@ func (bu *BookingUsecase) sendConsolidatedEmailNotificationHotelConfirm(ctx cont
 		Subject:         subjectParsed,
 		Body:            bodyHTML,
 		EmailTemplateID: uint(emailTemplate.ID),
+		TemplateName:    emailTemplate.Name,
 	}
 	metadataLog := entity.MetadataEmailLog{
 		HotelName:   hotelName,
 		BookingCode: bookingCode,
 	}
 	emailLog.Meta = &metadataLog
+	emailLog.Attachments = attachments
 
-	var dataEmail bool
-	statusEmailID := constant.StatusEmailSuccessID
-	if err = bu.emailRepo.CreateEmailLog(ctx, &emailLog); err != nil {
-		logger.Error(ctx, "Failed to create email log:", err)
-		dataEmail = false
-	} else {
-		dataEmail = true
-	}
-
-	err = bu.emailSender.Send(ctx, constant.ScopeHotel, hotelEmail, subjectParsed, bodyHTML, "Please view this email in HTML format.")
-	if err != nil {
-		logger.Error(ctx, "Failed to sending email:", err.Error())
-		statusEmailID = constant.StatusEmailFailedID
-		metadataLog.Notes = fmt.Sprintf("Failed to send email: %s", err.Error())
-		emailLog.Meta = &metadataLog
-	}
-
-	if dataEmail {
-		emailLog.StatusID = uint(statusEmailID)
-		if err := bu.emailRepo.UpdateStatusEmailLog(ctx, &emailLog); err != nil {
-			logger.Error(ctx, "Failed to update email log:", err.Error())
-		}
-	}
+	return bu.queueEmail(ctx, constant.ScopeHotel, &emailLog)
 }
 
 type HotelEmailData struct {
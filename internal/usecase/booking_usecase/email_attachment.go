@@ -0,0 +1,114 @@
+package booking_usecase
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+const signatureContentID = "signature"
+
+// signatureEmail returns the signature of a template for the email body. A signature image is embedded as an inline
+// attachment since many corporate mail clients block remote images.
+func (bu *BookingUsecase) signatureEmail(ctx context.Context, emailTemplate *entity.EmailTemplate) (string, []entity.EmailAttachment) {
+	if emailTemplate.Signature == "" {
+		return "", nil
+	}
+	if !emailTemplate.IsSignatureImage {
+		return emailTemplate.Signature, nil
+	}
+
+	attachment, err := bu.signatureAttachment(ctx, emailTemplate.Signature)
+	if err != nil {
+		logger.Error(ctx, "Failed to embed signature image:", err.Error())
+		return bu.assignSignatureEmail(emailTemplate.Signature), nil
+	}
+
+	return fmt.Sprintf(`<img src="cid:%s" alt="Signature" style="width:150px;">`, attachment.ContentID), []entity.EmailAttachment{*attachment}
+}
+
+// signatureAttachment locates the signature image in storage, it is stored as an object of the public email bucket or as a link
+func (bu *BookingUsecase) signatureAttachment(ctx context.Context, signature string) (*entity.EmailAttachment, error) {
+	bucket := fmt.Sprintf("%s-%s", constant.ConstEmail, constant.ConstPublic)
+	object := signature
+	if strings.HasPrefix(signature, "http://") || strings.HasPrefix(signature, "https://") {
+		var err error
+		if bucket, object, err = bu.fileStorage.ExtractBucketAndObject(ctx, signature); err != nil {
+			return nil, err
+		}
+	}
+
+	file, err := bu.fileStorage.GetFileObject(ctx, bucket, object)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return &entity.EmailAttachment{
+		Filename:    path.Base(object),
+		ContentType: file.GetContentType(),
+		ContentID:   signatureContentID,
+		Bucket:      bucket,
+		Object:      object,
+		Size:        file.GetContentLength(),
+	}, nil
+}
+
+// bookingDocumentAttachments renders the invoice and voucher of confirmed booking details and stores them as email
+// attachments. A document that cannot be rendered is left out, the email is still sent.
+func (bu *BookingUsecase) bookingDocumentAttachments(ctx context.Context, detailIDs []uint) []entity.EmailAttachment {
+	details, err := bu.bookingRepo.GetBookingDetailsByIDs(ctx, detailIDs)
+	if err != nil {
+		logger.Error(ctx, "Failed to get booking details for email attachments:", err.Error())
+		return nil
+	}
+
+	var attachments []entity.EmailAttachment
+	for _, detail := range details {
+		if detail.StatusBookingID != constant.StatusBookingConfirmedID {
+			continue
+		}
+
+		if invoice, err := bu.bookingRepo.GetInvoiceByBookingDetailID(ctx, detail.ID); err != nil {
+			logger.Error(ctx, "Failed to get invoice for email attachment:", err.Error())
+		} else if invoice == nil {
+			logger.Warn(ctx, "No invoice for email attachment of", detail.SubBookingID)
+		} else if content, err := bu.documentRenderer.RenderInvoice(ctx, *invoice); err != nil {
+			logger.Error(ctx, "Failed to render invoice for email attachment:", err.Error())
+		} else if attachment, err := bu.uploadEmailAttachment(ctx, fmt.Sprintf("invoice_%s.pdf", invoice.InvoiceCode), content); err == nil {
+			attachments = append(attachments, *attachment)
+		}
+
+		if content, err := bu.documentRenderer.RenderVoucher(ctx, bu.voucher(ctx, detail)); err != nil {
+			logger.Error(ctx, "Failed to render voucher for email attachment:", err.Error())
+		} else if attachment, err := bu.uploadEmailAttachment(ctx, fmt.Sprintf("voucher_%s.pdf", detail.SubBookingID), content); err == nil {
+			attachments = append(attachments, *attachment)
+		}
+	}
+
+	return attachments
+}
+
+// uploadEmailAttachment keeps a generated PDF in the private email bucket, retries of the email send the same file
+func (bu *BookingUsecase) uploadEmailAttachment(ctx context.Context, filename string, content []byte) (*entity.EmailAttachment, error) {
+	bucket := fmt.Sprintf("%s-%s", constant.ConstEmail, constant.ConstPrivate)
+	object := fmt.Sprintf("attachments/%d_%s", time.Now().UnixNano(), filename)
+
+	if _, err := bu.fileStorage.UploadBytes(ctx, content, "application/pdf", bucket, object); err != nil {
+		logger.Error(ctx, "Failed to upload email attachment:", err.Error())
+		return nil, err
+	}
+
+	return &entity.EmailAttachment{
+		Filename:    filename,
+		ContentType: "application/pdf",
+		Bucket:      bucket,
+		Object:      object,
+		Size:        int64(len(content)),
+	}, nil
+}
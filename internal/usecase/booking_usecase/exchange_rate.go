@@ -0,0 +1,25 @@
+package booking_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/currency"
+	"wtm-backend/pkg/logger"
+)
+
+// exchangeRate returns the exchange rate in effect today for currencyCode, nil for IDR or when no rate is set.
+func (bu *BookingUsecase) exchangeRate(ctx context.Context, currencyCode string) (*entity.ExchangeRate, error) {
+	normalizedCurrency := currency.NormalizeCurrencyCode(currencyCode)
+	if normalizedCurrency == "" || normalizedCurrency == "IDR" {
+		return nil, nil
+	}
+
+	rate, err := bu.currencyRepo.GetEffectiveExchangeRate(ctx, normalizedCurrency, time.Now())
+	if err != nil {
+		logger.Error(ctx, "failed to get exchange rate", err.Error())
+		return nil, fmt.Errorf("failed to get exchange rate for %s: %s", normalizedCurrency, err.Error())
+	}
+	return rate, nil
+}
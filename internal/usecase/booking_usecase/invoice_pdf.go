@@ -0,0 +1,35 @@
+package booking_usecase
+
+import (
+	"context"
+	"fmt"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/pkg/logger"
+)
+
+func (bu *BookingUsecase) InvoicePDF(ctx context.Context, req *bookingdto.BookingDocumentRequest) (*bookingdto.BookingDocumentResponse, error) {
+	detail, err := bu.documentBookingDetail(ctx, req.SubBookingID)
+	if err != nil || detail == nil {
+		return nil, err
+	}
+
+	invoice, err := bu.bookingRepo.GetInvoiceByBookingDetailID(ctx, detail.ID)
+	if err != nil {
+		logger.Error(ctx, "failed to get invoice", err.Error())
+		return nil, err
+	}
+	if invoice == nil {
+		return nil, nil
+	}
+
+	content, err := bu.documentRenderer.RenderInvoice(ctx, *invoice)
+	if err != nil {
+		logger.Error(ctx, "failed to render invoice", err.Error())
+		return nil, fmt.Errorf("failed to render invoice: %s", err.Error())
+	}
+
+	return &bookingdto.BookingDocumentResponse{
+		Filename: fmt.Sprintf("invoice_%s.pdf", invoice.InvoiceCode),
+		Content:  content,
+	}, nil
+}
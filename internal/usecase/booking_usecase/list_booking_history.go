@@ -8,6 +8,7 @@ import (
 	"wtm-backend/internal/dto/bookingdto"
 	"wtm-backend/internal/repository/filter"
 	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/currency"
 	"wtm-backend/pkg/logger"
 )
 
@@ -73,7 +74,7 @@ func (bu *BookingUsecase) ListBookingHistory(ctx context.Context, req *bookingdt
 
 			// Map detailed additional services (with price, category, pax, etc.)
 			var additionalServices []bookingdto.BookingHistoryAdditional
-			var totalAdditionalPrice float64
+			var totalAdditionalPrice currency.Money
 			if len(detail.BookingDetailsAdditional) > 0 {
 				additionalServices = make([]bookingdto.BookingHistoryAdditional, 0, len(detail.BookingDetailsAdditional))
 				for _, add := range detail.BookingDetailsAdditional {
@@ -87,7 +88,12 @@ func (bu *BookingUsecase) ListBookingHistory(ctx context.Context, req *bookingdt
 					additionalServices = append(additionalServices, additionalService)
 					// Calculate total additional price
 					if add.Category == constant.AdditionalServiceCategoryPrice && add.Price != nil {
-						totalAdditionalPrice += *add.Price
+						price, err := currency.NewMoney(*add.Price, detail.Currency)
+						if err != nil {
+							logger.Error(ctx, "invalid additional service price", err.Error())
+							return nil, err
+						}
+						totalAdditionalPrice = totalAdditionalPrice.Add(price)
 					}
 				}
 			}
@@ -105,13 +111,11 @@ func (bu *BookingUsecase) ListBookingHistory(ctx context.Context, req *bookingdt
 			}
 
 			// Room price per night (already includes promo if applied)
-			roomPricePerNight := detail.Price / float64(nights)
-			if nights == 0 {
-				roomPricePerNight = detail.Price
-			}
+			roomPrice := detail.Price.WithCurrency(bookingCurrency)
+			roomPricePerNight := roomPrice.Div(nights)
 
 			// Total price = room price + additional services
-			totalPrice := detail.Price + totalAdditionalPrice
+			totalPrice := roomPrice.Add(totalAdditionalPrice.WithCurrency(bookingCurrency))
 
 			resp.Data[i].Detail[j] = bookingdto.DetailBookingHistory{
 				GuestName:          detail.Guest,
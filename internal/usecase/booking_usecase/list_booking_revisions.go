@@ -0,0 +1,70 @@
+package booking_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+func (bu *BookingUsecase) ListBookingRevisions(ctx context.Context, req *bookingdto.ListBookingRevisionsRequest) (*bookingdto.ListBookingRevisionsResponse, error) {
+	userCtx, err := bu.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to get user from context", err.Error())
+		return nil, fmt.Errorf("failed to get user from context: %s", err.Error())
+	}
+
+	if userCtx == nil {
+		logger.Error(ctx, "user context is nil")
+		return nil, fmt.Errorf("user not found in context")
+	}
+
+	detailID, err := bu.bookingRepo.GetIDBySubBookingID(ctx, req.SubBookingID)
+	if err != nil {
+		logger.Error(ctx, "failed to get ID by sub booking ID", err.Error())
+		return nil, err
+	}
+
+	details, err := bu.bookingRepo.GetBookingDetailsByIDs(ctx, []uint{detailID})
+	if err != nil {
+		logger.Error(ctx, "failed to get booking detail", err.Error())
+		return nil, err
+	}
+
+	// Agents only see the history of their own bookings
+	if len(details) == 0 || (userCtx.RoleID == constant.RoleAgentID && details[0].Booking.AgentID != userCtx.ID) {
+		return nil, fmt.Errorf("booking detail not found")
+	}
+
+	revisions, err := bu.bookingRepo.GetBookingDetailRevisions(ctx, detailID)
+	if err != nil {
+		logger.Error(ctx, "failed to get booking detail revisions", err.Error())
+		return nil, err
+	}
+
+	resp := &bookingdto.ListBookingRevisionsResponse{
+		Revisions: make([]bookingdto.BookingRevision, 0, len(revisions)),
+	}
+	for _, revision := range revisions {
+		resp.Revisions = append(resp.Revisions, bookingdto.BookingRevision{
+			Revision:      revision.Revision,
+			RoomPriceID:   revision.RoomPriceID,
+			HotelName:     revision.DetailRooms.HotelName,
+			RoomTypeName:  revision.DetailRooms.RoomTypeName,
+			CheckInDate:   revision.CheckInDate.Format(time.DateOnly),
+			CheckOutDate:  revision.CheckOutDate.Format(time.DateOnly),
+			Quantity:      revision.Quantity,
+			Promo:         revision.DetailPromos,
+			Price:         revision.Price,
+			Currency:      revision.Currency,
+			StatusBooking: constant.MapStatusBooking[int(revision.StatusBookingID)],
+			Reason:        revision.Reason,
+			AmendedBy:     revision.AmendedByName,
+			AmendedAt:     revision.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return resp, nil
+}
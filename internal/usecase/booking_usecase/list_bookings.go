@@ -8,6 +8,7 @@ import (
 	"wtm-backend/internal/dto/bookingdto"
 	"wtm-backend/internal/repository/filter"
 	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/currency"
 	"wtm-backend/pkg/logger"
 )
 
@@ -52,7 +53,7 @@ func (bu *BookingUsecase) ListBookings(ctx context.Context, req *bookingdto.List
 
 			// Map detailed additional services (with price, category, pax, etc.)
 			var additionalServices []bookingdto.BookingHistoryAdditional
-			var totalAdditionalPrice float64
+			var totalAdditionalPrice currency.Money
 			if len(detail.BookingDetailsAdditional) > 0 {
 				additionalServices = make([]bookingdto.BookingHistoryAdditional, 0, len(detail.BookingDetailsAdditional))
 				for _, add := range detail.BookingDetailsAdditional {
@@ -67,7 +68,12 @@ func (bu *BookingUsecase) ListBookings(ctx context.Context, req *bookingdto.List
 
 					// Calculate total additional price when category is price
 					if add.Category == constant.AdditionalServiceCategoryPrice && add.Price != nil {
-						totalAdditionalPrice += *add.Price
+						price, err := currency.NewMoney(*add.Price, detail.Currency)
+						if err != nil {
+							logger.Error(ctx, "invalid additional service price", err.Error())
+							return nil, err
+						}
+						totalAdditionalPrice = totalAdditionalPrice.Add(price)
 					}
 				}
 			}
@@ -85,13 +91,11 @@ func (bu *BookingUsecase) ListBookings(ctx context.Context, req *bookingdto.List
 			}
 
 			// Room price per night (already includes promo if applied)
-			roomPricePerNight := detail.Price / float64(nights)
-			if nights == 0 {
-				roomPricePerNight = detail.Price
-			}
+			roomPrice := detail.Price.WithCurrency(bookingCurrency)
+			roomPricePerNight := roomPrice.Div(nights)
 
 			// Total price = room price + additional services
-			totalPrice := detail.Price + totalAdditionalPrice
+			totalPrice := roomPrice.Add(totalAdditionalPrice.WithCurrency(bookingCurrency))
 
 			resp.Data[i].Detail[j] = bookingdto.DetailBooking{
 				GuestName:          detail.Guest,
@@ -99,8 +103,8 @@ func (bu *BookingUsecase) ListBookings(ctx context.Context, req *bookingdto.List
 				RoomTypeName:       detail.DetailRooms.RoomTypeName,
 				IsBreakfast:        detail.RoomPrice.IsBreakfast,
 				BedType:            detail.BedType,
-				RoomPrice:          roomPricePerNight,
-				TotalPrice:         totalPrice,
+				RoomPrice:          &roomPricePerNight,
+				TotalPrice:         &totalPrice,
 				Currency:           bookingCurrency,
 				CheckInDate:        detail.CheckInDate.Format(time.DateOnly),
 				CheckOutDate:       detail.CheckOutDate.Format(time.DateOnly),
@@ -114,6 +118,8 @@ func (bu *BookingUsecase) ListBookings(ctx context.Context, req *bookingdto.List
 				PromoCode:          detail.DetailPromos.PromoCode,
 				AdditionalNotes:    detail.AdditionalNotes,
 				AdminNotes:         detail.AdminNotes,
+
+				HotelConfirmationNumber: detail.HotelConfirmationNumber,
 			}
 			var receiptUrl string
 			if detail.ReceiptUrl != "" {
@@ -36,11 +36,10 @@ func (bu *BookingUsecase) ListCart(ctx context.Context) (*bookingdto.ListCartRes
 	if cart != nil {
 		result.ID = cart.ID
 		var details []bookingdto.CartDetail
-		var grandTotal float64
+		var grandTotal currency.Money
 
 		for _, detail := range cart.BookingDetails {
 			var additionals []bookingdto.CartDetailAdditional
-			var totalAdditional float64
 
 			// Map additional services with new structure (Category, Price/Pax, IsRequired)
 			// These fields are now stored directly in BookingDetailAdditional
@@ -54,16 +53,14 @@ func (bu *BookingUsecase) ListCart(ctx context.Context) (*bookingdto.ListCartRes
 				}
 
 				additionals = append(additionals, cartAdditional)
-
-				// Calculate total - only count price-based additionals
-				if cartAdditional.Category == constant.AdditionalServiceCategoryPrice && cartAdditional.Price != nil {
-					totalAdditional += *cartAdditional.Price
-				}
 			}
 
-			cancellationDate := detail.CheckInDate.AddDate(0, 0, detail.RoomPrice.RoomType.Hotel.CancellationPeriod).Format("2006-01-02")
+			cancellationPolicy, err := bu.cancellationPolicy(ctx, detail)
+			if err != nil {
+				return nil, err
+			}
+			cancellationDate := freeCancellationDate(cancellationPolicy, detail.CheckInDate)
 
-			nights := int(detail.CheckOutDate.Sub(detail.CheckInDate).Hours() / 24)
 			var checkInHourDur, checkOutHourDur time.Duration
 			checkInHour := detail.RoomPrice.RoomType.Hotel.CheckInHour
 			if checkInHour != nil {
@@ -97,19 +94,16 @@ func (bu *BookingUsecase) ListCart(ctx context.Context) (*bookingdto.ListCartRes
 				bookingCurrency = "IDR" // Default fallback
 			}
 
-			// Get base price from multi-currency Prices map, fallback to deprecated Price field
-			var basePrice float64
-			if len(detail.RoomPrice.Prices) > 0 {
-				// Use Prices map for multi-currency support
-				if price, _, err := currency.GetPriceForCurrency(detail.RoomPrice.Prices, bookingCurrency); err == nil {
-					basePrice = price
-				} else {
-					// Fallback to Price field if currency not found in Prices map
-					basePrice = detail.RoomPrice.Price
-				}
-			} else {
-				// Fallback to deprecated Price field if Prices map is empty
-				basePrice = detail.RoomPrice.Price
+			// Exchange rate in effect today, derives prices not entered in the booking currency
+			exchangeRate, err := bu.exchangeRate(ctx, bookingCurrency)
+			if err != nil {
+				return nil, err
+			}
+
+			// Price the stay the same way checkout does: nightly rates with seasonal rate plans, promo and additionals
+			quote, err := bu.quoteBookingDetail(ctx, detail, bookingCurrency, exchangeRate, quoteAdditionals(detail))
+			if err != nil {
+				return nil, err
 			}
 
 			cartDetail := bookingdto.CartDetail{
@@ -128,53 +122,31 @@ func (bu *BookingUsecase) ListCart(ctx context.Context) (*bookingdto.ListCartRes
 				AdditionalNotes:      detail.AdditionalNotes, // Notes from agent to admin
 				AdminNotes:           detail.AdminNotes,      // Notes from admin to agent
 				CancellationDate:     cancellationDate,
-				PriceBeforePromo:     basePrice * float64(nights),
-				TotalAdditionalPrice: totalAdditional,
+				CancellationPolicy:   &cancellationPolicy,
+				PriceBeforePromo:     quote.RoomTotalBeforePromo,
+				Price:                quote.RoomTotal,
+				TotalAdditionalPrice: quote.AdditionalTotal,
+				TotalPrice:           quote.Total,
+				Currency:             bookingCurrency,
 			}
-			roomPrice := basePrice
 
 			if detail.Promo != nil {
-				promo := detail.Promo
-				detailPromo, err := bu.generateDetailPromo(promo)
+				detailPromo, err := bu.generateDetailPromo(detail.Promo)
 				if err != nil {
 					logger.Error(ctx, "failed to generate detail promo", err.Error())
 				}
 				cartDetail.Promo = detailPromo
-				switch detail.Promo.PromoTypeID {
-				case constant.PromoTypeFixedPriceID:
-					// Use Prices map for multi-currency support
-					if len(promo.Detail.Prices) > 0 {
-						// Get price for the booking currency
-						if price, _, err := currency.GetPriceForCurrency(promo.Detail.Prices, bookingCurrency); err == nil {
-							roomPrice = price
-						} else {
-							// Fallback to FixedPrice if Prices not available (backward compatibility)
-							if promo.Detail.FixedPrice > 0 {
-								roomPrice = promo.Detail.FixedPrice
-							}
-						}
-					} else if promo.Detail.FixedPrice > 0 {
-						// Backward compatibility: use FixedPrice if Prices not set
-						roomPrice = promo.Detail.FixedPrice
-					}
-					if promo.Duration > nights {
-						roomPrice += float64(nights-promo.Duration) * basePrice
-					}
-				case constant.PromoTypeDiscountID:
-					roomPrice = (100 - promo.Detail.DiscountPercentage) / 100 * basePrice * float64(nights)
-					if promo.Duration > nights {
-						roomPrice += float64(nights-promo.Duration) * basePrice
-					}
-				default:
-					roomPrice = basePrice * float64(nights)
-				}
+			}
+
+			holdExpiresAt, err := bu.bookingRepo.GetCartHoldExpiry(ctx, detail.RoomPrice.RoomTypeID, detail.CheckInDate, detail.ID)
+			if err != nil {
+				logger.Error(ctx, "failed to get cart hold expiry", err.Error())
+			}
+			if holdExpiresAt.IsZero() {
+				cartDetail.IsHoldExpired = true
 			} else {
-				// No promo: multiply base price by number of nights
-				roomPrice = basePrice * float64(nights)
+				cartDetail.HoldExpiresAt = holdExpiresAt.In(constant.AsiaJakarta).Format(time.RFC3339)
 			}
-			cartDetail.Price = roomPrice
-			cartDetail.TotalPrice = cartDetail.Price + cartDetail.TotalAdditionalPrice
-			cartDetail.Currency = bookingCurrency
 			for _, photo := range detail.RoomPrice.RoomType.Photos {
 				if photo != "" {
 					bucketName := fmt.Sprintf("%s-%s", constant.ConstHotel, constant.ConstPublic)
@@ -188,7 +160,7 @@ func (bu *BookingUsecase) ListCart(ctx context.Context) (*bookingdto.ListCartRes
 				}
 			}
 
-			grandTotal += cartDetail.TotalPrice
+			grandTotal = grandTotal.Add(cartDetail.TotalPrice)
 			details = append(details, cartDetail)
 		}
 
@@ -0,0 +1,43 @@
+package booking_usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+func (bu *BookingUsecase) ListPayments(ctx context.Context, req *bookingdto.ListPaymentsRequest) (*bookingdto.ListPaymentsResponse, error) {
+	userCtx, err := bu.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to get user from context", err.Error())
+		return nil, fmt.Errorf("failed to get user from context: %s", err.Error())
+	}
+
+	if userCtx == nil {
+		logger.Error(ctx, "user context is nil")
+		return nil, fmt.Errorf("user not found in context")
+	}
+
+	ledger, err := bu.loadPaymentLedger(ctx, strings.TrimSpace(req.BookingID), strings.TrimSpace(req.SubBookingID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Agents only see the payments of their own bookings
+	if userCtx.RoleID == constant.RoleAgentID && ledger.booking.AgentID != userCtx.ID {
+		return nil, fmt.Errorf("booking not found")
+	}
+
+	resp := &bookingdto.ListPaymentsResponse{
+		Payments: make([]bookingdto.Payment, 0, len(ledger.payments)),
+		Balance:  ledger.toBookingBalance(),
+	}
+	for _, payment := range ledger.payments {
+		resp.Payments = append(resp.Payments, toPaymentDTO(payment))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,248 @@
+package booking_usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/currency"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/pricing"
+)
+
+// paymentLedger is a booking with its sub-bookings, payments and the balances computed from them.
+type paymentLedger struct {
+	booking  entity.Booking
+	details  []entity.BookingDetail // ordered by ID, the order booking payments are allocated in
+	payments []entity.Payment
+	currency string
+	balances []pricing.Balance // same order as details
+	credit   currency.Money
+	released []uint // sub-bookings whose manual payment status was handed back to the ledger
+}
+
+// loadPaymentLedger loads the ledger of the booking a booking code or sub booking code belongs to.
+func (bu *BookingUsecase) loadPaymentLedger(ctx context.Context, bookingCode, subBookingCode string) (*paymentLedger, error) {
+	if bookingCode == "" {
+		detailID, err := bu.bookingRepo.GetIDBySubBookingID(ctx, subBookingCode)
+		if err != nil {
+			logger.Error(ctx, "failed to get ID by sub booking ID", err.Error())
+			return nil, err
+		}
+		details, err := bu.bookingRepo.GetBookingDetailsByIDs(ctx, []uint{detailID})
+		if err != nil {
+			logger.Error(ctx, "failed to get booking detail", err.Error())
+			return nil, err
+		}
+		if len(details) == 0 {
+			return nil, fmt.Errorf("booking detail not found")
+		}
+		bookingCode = details[0].Booking.BookingCode
+	}
+
+	detailIDs, err := bu.bookingRepo.GetBookingDetailIDsByBookingCode(ctx, bookingCode)
+	if err != nil {
+		logger.Error(ctx, "failed to get booking detail IDs by booking code", err.Error())
+		return nil, err
+	}
+	details, err := bu.bookingRepo.GetBookingDetailsByIDs(ctx, detailIDs)
+	if err != nil {
+		logger.Error(ctx, "failed to get booking details", err.Error())
+		return nil, err
+	}
+	if len(details) == 0 {
+		return nil, fmt.Errorf("booking not found")
+	}
+	sort.Slice(details, func(i, j int) bool { return details[i].ID < details[j].ID })
+
+	payments, err := bu.bookingRepo.GetPaymentsByBookingID(ctx, details[0].BookingID)
+	if err != nil {
+		logger.Error(ctx, "failed to get payments", err.Error())
+		return nil, err
+	}
+
+	ledger := &paymentLedger{
+		booking:  details[0].Booking,
+		details:  details,
+		payments: payments,
+		currency: details[0].Currency,
+	}
+	if ledger.currency == "" {
+		ledger.currency = "IDR" // Default fallback
+	}
+	if err := ledger.allocate(); err != nil {
+		logger.Error(ctx, "failed to allocate payments", err.Error())
+		return nil, err
+	}
+
+	return ledger, nil
+}
+
+// allocate computes the balance of every sub-booking from the payments of the ledger.
+func (l *paymentLedger) allocate() error {
+	paid := make(map[uint]currency.Money, len(l.details))
+	bookingPaid := currency.ZeroMoney(l.currency)
+	for _, payment := range l.payments {
+		amount := payment.Amount.WithCurrency(l.currency)
+		if payment.BookingDetailID == nil {
+			bookingPaid = bookingPaid.Add(amount)
+			continue
+		}
+		if p, ok := paid[*payment.BookingDetailID]; ok {
+			paid[*payment.BookingDetailID] = p.Add(amount)
+		} else {
+			paid[*payment.BookingDetailID] = amount
+		}
+	}
+
+	inputs := make([]pricing.BalanceInput, 0, len(l.details))
+	for _, detail := range l.details {
+		due, err := amountDue(detail, l.currency)
+		if err != nil {
+			return err
+		}
+		hotel := detail.RoomPrice.RoomType.Hotel
+		input := pricing.BalanceInput{
+			ID:      detail.ID,
+			Due:     due,
+			Paid:    currency.ZeroMoney(l.currency),
+			CheckIn: detail.CheckInDate,
+			Deposit: pricing.DepositRule{Percent: hotel.DepositPercent, BalanceDueDays: hotel.BalanceDueDays},
+		}
+		if p, ok := paid[detail.ID]; ok {
+			input.Paid = p
+		}
+		inputs = append(inputs, input)
+	}
+
+	l.balances, l.credit = pricing.AllocatePayments(inputs, bookingPaid)
+	return nil
+}
+
+// amountDue is what a sub-booking owes: the booking total, the cancellation penalty once cancelled, nothing once rejected.
+func amountDue(detail entity.BookingDetail, currencyCode string) (currency.Money, error) {
+	switch detail.StatusBookingID {
+	case constant.StatusBookingRejectedID:
+		return currency.ZeroMoney(currencyCode), nil
+	case constant.StatusBookingCancelledID:
+		return detail.CancellationPenalty.WithCurrency(currencyCode), nil
+	default:
+		detail.Currency = currencyCode
+		return bookingDetailTotal(detail)
+	}
+}
+
+// agentReceipt is the receipt the agent uploaded for the sub-booking, for the whole booking when nil, empty when there
+// is none or the sub-bookings have different receipts.
+func (l *paymentLedger) agentReceipt(bookingDetailID *uint) string {
+	var receipt string
+	for _, detail := range l.details {
+		if bookingDetailID != nil && *bookingDetailID != detail.ID {
+			continue
+		}
+		if detail.ReceiptUrl == "" || (receipt != "" && receipt != detail.ReceiptUrl) {
+			return ""
+		}
+		receipt = detail.ReceiptUrl
+	}
+	return receipt
+}
+
+// releaseManualStatus hands the payment status of the sub-booking, of every sub-booking when nil, back to the ledger.
+func (l *paymentLedger) releaseManualStatus(bookingDetailID *uint) {
+	for i := range l.details {
+		if !l.details[i].StatusPaymentManual || (bookingDetailID != nil && *bookingDetailID != l.details[i].ID) {
+			continue
+		}
+		l.details[i].StatusPaymentManual = false
+		l.released = append(l.released, l.details[i].ID)
+	}
+}
+
+// syncStatusPayment updates the payment status of the sub-bookings whose balance changed status, a status an admin
+// set by hand is kept until releaseManualStatus.
+func (bu *BookingUsecase) syncStatusPayment(ctx context.Context, ledger *paymentLedger) error {
+	released := make(map[uint]bool, len(ledger.released))
+	for _, detailID := range ledger.released {
+		released[detailID] = true
+	}
+
+	changed := make(map[int][]uint)
+	for i, balance := range ledger.balances {
+		detail := ledger.details[i]
+		if detail.StatusPaymentManual {
+			continue
+		}
+		if uint(balance.StatusPaymentID) != detail.StatusPaymentID || released[detail.ID] {
+			changed[balance.StatusPaymentID] = append(changed[balance.StatusPaymentID], balance.ID)
+		}
+	}
+
+	for statusID, detailIDs := range changed {
+		if err := bu.bookingRepo.UpdateBookingDetailStatusPayment(ctx, detailIDs, uint(statusID), false); err != nil {
+			logger.Error(ctx, "failed to update status payment", err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
+// toBookingBalance maps the balances of a ledger to the response.
+func (l *paymentLedger) toBookingBalance() bookingdto.BookingBalance {
+	due := currency.ZeroMoney(l.currency)
+	outstanding := currency.ZeroMoney(l.currency)
+	resp := bookingdto.BookingBalance{
+		BookingID:          l.booking.BookingCode,
+		Currency:           l.currency,
+		Credit:             l.credit,
+		SubBookingBalances: make([]bookingdto.SubBookingBalance, 0, len(l.balances)),
+	}
+	for i, balance := range l.balances {
+		due = due.Add(balance.Due)
+		outstanding = outstanding.Add(balance.Outstanding)
+		resp.SubBookingBalances = append(resp.SubBookingBalances, bookingdto.SubBookingBalance{
+			SubBookingID:       l.details[i].SubBookingID,
+			Due:                balance.Due,
+			Paid:               balance.Paid,
+			Outstanding:        balance.Outstanding,
+			Deposit:            balance.Deposit,
+			DepositOutstanding: balance.DepositOutstanding,
+			BalanceDueDate:     balance.BalanceDueDate.Format(time.DateOnly),
+			StatusPayment:      constant.MapStatusPayment[balance.StatusPaymentID],
+		})
+		if l.details[i].StatusPaymentManual {
+			resp.SubBookingBalances[i].StatusPayment = constant.MapStatusPayment[int(l.details[i].StatusPaymentID)]
+			resp.SubBookingBalances[i].StatusPaymentManual = true
+		}
+	}
+
+	paid := currency.ZeroMoney(l.currency)
+	for _, payment := range l.payments {
+		paid = paid.Add(payment.Amount.WithCurrency(l.currency))
+	}
+
+	resp.Due = due
+	resp.Paid = paid
+	resp.Outstanding = outstanding
+	resp.StatusPayment = constant.MapStatusPayment[pricing.PaymentStatusID(due, paid)]
+	return resp
+}
+
+func toPaymentDTO(payment entity.Payment) bookingdto.Payment {
+	return bookingdto.Payment{
+		ID:           payment.ID,
+		SubBookingID: payment.SubBookingID,
+		Amount:       payment.Amount,
+		Currency:     payment.Currency,
+		Method:       payment.Method,
+		Reference:    payment.Reference,
+		ReceiptUrl:   payment.ReceiptUrl,
+		Notes:        payment.Notes,
+		PaidAt:       payment.PaidAt.Format(time.DateOnly),
+		RecordedBy:   payment.RecordedByName,
+		RecordedAt:   payment.CreatedAt.Format(time.RFC3339),
+	}
+}
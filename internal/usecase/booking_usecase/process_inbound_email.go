@@ -0,0 +1,269 @@
+package booking_usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/inboundmail"
+	"wtm-backend/pkg/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+func (bu *BookingUsecase) ProcessInboundEmail(ctx context.Context, raw []byte) (*bookingdto.ProcessInboundEmailResponse, error) {
+	msg, err := inboundmail.Parse(raw)
+	if err != nil {
+		logger.Warn(ctx, "failed to parse inbound email", err.Error())
+		return nil, validation.Errors{
+			"message": validation.NewInternalError(fmt.Errorf("invalid MIME message: %w", err)),
+		}
+	}
+
+	// Messages without a Message-ID are deduplicated on their content
+	messageID := msg.MessageID
+	if messageID == "" {
+		sum := sha256.Sum256(raw)
+		messageID = "sha256:" + hex.EncodeToString(sum[:])
+	}
+
+	existing, err := bu.emailRepo.GetInboundEmailByMessageID(ctx, messageID)
+	if err != nil {
+		logger.Error(ctx, "failed to get inbound email", err.Error())
+		return nil, err
+	}
+	if existing != nil {
+		logger.Info(ctx, "inbound email already received", messageID)
+		resp := toProcessInboundEmailResponse(existing)
+		resp.Duplicate = true
+		return resp, nil
+	}
+
+	details, wholeBooking, fromSubject, err := bu.matchInboundEmail(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	action, confirmationNumber := inboundmail.Classify(msg.Reply)
+	inbound := &entity.InboundEmail{
+		MessageID:          messageID,
+		From:               msg.From,
+		To:                 msg.To,
+		Subject:            msg.Subject,
+		Body:               msg.Reply,
+		Action:             action,
+		ConfirmationNumber: confirmationNumber,
+		Status:             constant.InboundEmailUnmatched,
+	}
+
+	var detailIDs []uint
+	if len(details) > 0 {
+		bookingID := details[0].BookingID
+		inbound.BookingID = &bookingID
+		inbound.BookingCode = details[0].Booking.BookingCode
+		for _, detail := range details {
+			detailIDs = append(detailIDs, detail.ID)
+			inbound.SubBookingIDs = append(inbound.SubBookingIDs, detail.SubBookingID)
+		}
+
+		// Hanya balasan dari email hotel dengan kode booking di subject yang boleh mengubah booking
+		inbound.Status = constant.InboundEmailReceived
+		switch {
+		case action == inboundmail.ActionNone:
+		case !fromSubject:
+			inbound.Note = "Booking code not in the subject, the booking is not changed"
+		case !isHotelSender(msg.From, details):
+			inbound.Note = "Sender is not the email of the hotel, the booking is not changed"
+		default:
+			inbound.Status = constant.InboundEmailAttached
+		}
+	}
+
+	err = bu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := bu.emailRepo.CreateInboundEmail(txCtx, inbound); err != nil {
+			return err
+		}
+		if inbound.Status == constant.InboundEmailAttached && action == inboundmail.ActionConfirm {
+			return bu.bookingRepo.UpdateHotelConfirmationNumber(txCtx, detailIDs, confirmationNumber)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to save inbound email", err.Error())
+		return nil, err
+	}
+
+	if inbound.Status == constant.InboundEmailAttached && bu.config.InboundEmailAutoStatus {
+		bu.applyInboundEmailStatus(ctx, inbound, details, wholeBooking)
+	}
+
+	if inbound.Status == constant.InboundEmailAttached || inbound.Status == constant.InboundEmailApplied {
+		// Balasan sudah tersimpan dan tampil di daftar inbound, kegagalan notifikasi tidak menggagalkan webhook
+		err := bu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+			return bu.notifyAdminsInboundEmail(txCtx, inbound)
+		})
+		if err != nil {
+			logger.Error(ctx, "failed to notify admins of inbound email", err.Error())
+		}
+	}
+
+	return toProcessInboundEmailResponse(inbound), nil
+}
+
+// matchInboundEmail returns the booking details a reply is about: the sub-bookings in the subject, else every sub-booking
+// of the booking code in the subject, then wholeBooking is true. A subject without any of our codes is matched on the quoted
+// original email, then fromSubject is false.
+func (bu *BookingUsecase) matchInboundEmail(ctx context.Context, msg *inboundmail.Message) (details []entity.BookingDetail, wholeBooking, fromSubject bool, err error) {
+	bookingCodes, subBookingIDs := inboundmail.BookingCodes(msg.Subject)
+	fromSubject = len(bookingCodes) > 0 || len(subBookingIDs) > 0
+	if !fromSubject {
+		bookingCodes, subBookingIDs = inboundmail.BookingCodes(msg.Text)
+	}
+
+	var ids []uint
+	for _, subBookingID := range subBookingIDs {
+		id, err := bu.bookingRepo.GetIDBySubBookingID(ctx, subBookingID)
+		if err != nil {
+			logger.Error(ctx, "failed to get ID by sub booking ID", err.Error())
+			return nil, false, false, err
+		}
+		if id != 0 {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		wholeBooking = true
+		for _, bookingCode := range bookingCodes {
+			detailIDs, err := bu.bookingRepo.GetBookingDetailIDsByBookingCode(ctx, bookingCode)
+			if err != nil {
+				logger.Error(ctx, "failed to get booking detail IDs by booking code", err.Error())
+				return nil, false, false, err
+			}
+			ids = append(ids, detailIDs...)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, false, false, nil
+	}
+
+	details, err = bu.bookingRepo.GetBookingDetailsByIDs(ctx, ids)
+	if err != nil {
+		logger.Error(ctx, "failed to get booking details", err.Error())
+		return nil, false, false, err
+	}
+
+	// A reply is about one booking, sub-bookings of other bookings in the same subject are left out
+	var matched []entity.BookingDetail
+	for _, detail := range details {
+		if detail.StatusBookingID == constant.StatusBookingInCartID {
+			continue
+		}
+		if len(matched) > 0 && detail.BookingID != matched[0].BookingID {
+			continue
+		}
+		matched = append(matched, detail)
+	}
+
+	return matched, wholeBooking, fromSubject, nil
+}
+
+// isHotelSender reports whether the reply comes from the email of the hotel of every matched sub-booking
+func isHotelSender(from string, details []entity.BookingDetail) bool {
+	from = strings.ToLower(strings.TrimSpace(from))
+	if from == "" {
+		return false
+	}
+	for _, detail := range details {
+		if strings.ToLower(strings.TrimSpace(detail.RoomPrice.RoomType.Hotel.Email)) != from {
+			return false
+		}
+	}
+	return true
+}
+
+// applyInboundEmailStatus confirms or rejects the matched sub-bookings still waiting approval, the same way an admin does.
+// A failure leaves the reply attached for the admins.
+func (bu *BookingUsecase) applyInboundEmailStatus(ctx context.Context, inbound *entity.InboundEmail, details []entity.BookingDetail, wholeBooking bool) {
+	statusID := uint(constant.StatusBookingConfirmedID)
+	reason := ""
+	if inbound.Action == inboundmail.ActionReject {
+		statusID = constant.StatusBookingRejectedID
+		reason = "Rejected by the hotel"
+	}
+
+	var pending []string
+	for _, detail := range details {
+		if detail.StatusBookingID == constant.StatusBookingWaitingApprovalID {
+			pending = append(pending, detail.SubBookingID)
+		}
+	}
+	if len(pending) == 0 {
+		inbound.Note = "No sub-booking waiting approval"
+		if err := bu.emailRepo.UpdateInboundEmailStatus(ctx, inbound.ID, inbound.Status, inbound.Note); err != nil {
+			logger.Error(ctx, "failed to update inbound email status", err.Error())
+		}
+		return
+	}
+
+	// A reply about the whole booking changes it in one request, so the agent gets a single email
+	var requests []bookingdto.UpdateStatusRequest
+	if wholeBooking {
+		requests = append(requests, bookingdto.UpdateStatusRequest{BookingID: inbound.BookingCode, StatusID: statusID, Reason: reason})
+	} else {
+		for _, subBookingID := range pending {
+			requests = append(requests, bookingdto.UpdateStatusRequest{SubBookingID: subBookingID, StatusID: statusID, Reason: reason})
+		}
+	}
+
+	for _, req := range requests {
+		if err := bu.UpdateStatusBooking(ctx, &req, constant.ConstBooking); err != nil {
+			logger.Error(ctx, "failed to update status booking from inbound email", err.Error())
+			inbound.Note = fmt.Sprintf("Failed to change the status automatically: %s", err.Error())
+			if err := bu.emailRepo.UpdateInboundEmailStatus(ctx, inbound.ID, inbound.Status, inbound.Note); err != nil {
+				logger.Error(ctx, "failed to update inbound email status", err.Error())
+			}
+			return
+		}
+	}
+
+	inbound.Status = constant.InboundEmailApplied
+	inbound.Note = fmt.Sprintf("%s %s", constant.MapStatusBooking[int(statusID)], strings.Join(pending, ", "))
+	if err := bu.emailRepo.UpdateInboundEmailStatus(ctx, inbound.ID, inbound.Status, inbound.Note); err != nil {
+		logger.Error(ctx, "failed to update inbound email status", err.Error())
+	}
+}
+
+// notifyAdminsInboundEmail notifies the users who can change the status of the booking
+func (bu *BookingUsecase) notifyAdminsInboundEmail(ctx context.Context, inbound *entity.InboundEmail) error {
+	title := "Hotel Confirmed Booking"
+	message := fmt.Sprintf("The hotel replied with confirmation number %s to Booking ID: %s", inbound.ConfirmationNumber, inbound.BookingCode)
+	if inbound.Action == inboundmail.ActionReject {
+		title = "Hotel Rejected Booking"
+		message = fmt.Sprintf("The hotel rejected Booking ID: %s", inbound.BookingCode)
+	}
+	if inbound.Status == constant.InboundEmailApplied {
+		message = fmt.Sprintf("%s, the status was changed automatically", message)
+	}
+
+	return bu.notifier.NotifyPermission(ctx, constant.PermissionBookingEdit, entity.Notification{
+		Title:       title,
+		Message:     message,
+		RedirectURL: fmt.Sprintf("%s/booking?search=%s", bu.config.URLFEAdmin, inbound.BookingCode),
+		Type:        constant.ConstHotelReply,
+	})
+}
+
+func toProcessInboundEmailResponse(inbound *entity.InboundEmail) *bookingdto.ProcessInboundEmailResponse {
+	return &bookingdto.ProcessInboundEmailResponse{
+		ID:                 inbound.ExternalID,
+		Status:             inbound.Status,
+		Action:             inbound.Action,
+		BookingCode:        inbound.BookingCode,
+		SubBookingIDs:      inbound.SubBookingIDs,
+		ConfirmationNumber: inbound.ConfirmationNumber,
+	}
+}
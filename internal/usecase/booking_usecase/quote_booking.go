@@ -0,0 +1,148 @@
+package booking_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/pricing"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// QuoteBooking prices a stay in the agent's currency without adding it to the cart.
+// It goes through the same pricing engine as the cart and checkout, so the numbers match.
+func (bu *BookingUsecase) QuoteBooking(ctx context.Context, req *bookingdto.QuoteBookingRequest) (*bookingdto.QuoteBookingResponse, error) {
+	userCtx, err := bu.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to get user from context", err.Error())
+		return nil, fmt.Errorf("failed to get user from context: %s", err.Error())
+	}
+
+	if userCtx == nil {
+		logger.Error(ctx, "user context is nil")
+		return nil, fmt.Errorf("user context is nil")
+	}
+
+	checkInDate, err := time.Parse(time.DateOnly, req.CheckInDate)
+	if err != nil {
+		logger.Error(ctx, "failed to parse check-in date", err.Error())
+		return nil, fmt.Errorf("invalid check-in date: %s", err.Error())
+	}
+
+	checkOutDate, err := time.Parse(time.DateOnly, req.CheckOutDate)
+	if err != nil {
+		logger.Error(ctx, "failed to parse check-out date", err.Error())
+		return nil, fmt.Errorf("invalid check-out date: %s", err.Error())
+	}
+
+	if !checkOutDate.After(checkInDate) {
+		return nil, validation.Errors{
+			"check_out_date": errors.New("Check Out Date must be after Check In Date"),
+		}
+	}
+	nights := int(checkOutDate.Sub(checkInDate).Hours() / 24)
+
+	roomPrice, err := bu.hotelRepo.GetRoomPriceByID(ctx, req.RoomPriceID)
+	if err != nil {
+		logger.Error(ctx, "failed to get room price by id", err.Error())
+		return nil, fmt.Errorf("failed to get room price: %s", err.Error())
+	}
+	if roomPrice == nil {
+		return nil, nil
+	}
+
+	var promo *entity.Promo
+	if req.PromoID > 0 {
+		promo, err = bu.promoRepo.GetPromoByID(ctx, req.PromoID, nil)
+		if err != nil {
+			logger.Error(ctx, "failed to get promo by id", err.Error())
+			return nil, fmt.Errorf("failed to get promo: %s", err.Error())
+		}
+	}
+
+	var roomTypeAdditionals []entity.RoomTypeAdditional
+	if len(req.RoomTypeAdditionalIDs) > 0 {
+		roomTypeAdditionals, err = bu.hotelRepo.GetRoomTypeAdditionalsByIDs(ctx, req.RoomTypeAdditionalIDs)
+		if err != nil {
+			logger.Error(ctx, "failed to get room type additional", err.Error())
+			return nil, fmt.Errorf("failed to get additionals: %s", err.Error())
+		}
+	}
+
+	// Get agent's currency preference
+	user, err := bu.userRepo.GetUserByID(ctx, userCtx.ID)
+	if err != nil {
+		logger.Error(ctx, "failed to get user for currency", err.Error())
+		return nil, fmt.Errorf("failed to get user: %s", err.Error())
+	}
+	if err := validateCartSelection(user, roomPrice, checkInDate, nights, req.PromoID, promo, req.RoomTypeAdditionalIDs, roomTypeAdditionals); err != nil {
+		logger.Error(ctx, "invalid quote selection", err.Error())
+		return nil, err
+	}
+
+	agentCurrency := "IDR" // Default fallback
+	if user != nil && user.Currency != "" {
+		agentCurrency = user.Currency
+	}
+
+	exchangeRate, err := bu.exchangeRate(ctx, agentCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	// Additionals are priced the same way add to cart snapshots them
+	var additionals []pricing.QuoteAdditional
+	for _, add := range roomTypeAdditionals {
+		additionals = append(additionals, pricing.QuoteAdditional{
+			Name:       add.RoomAdditional.Name,
+			Category:   add.Category,
+			Price:      pricing.AdditionalPrice(add, agentCurrency, exchangeRate),
+			Pax:        add.Pax,
+			IsRequired: add.IsRequired,
+			Derived:    add.Category == constant.AdditionalServiceCategoryPrice && pricing.IsDerived(add.Prices, agentCurrency, exchangeRate),
+		})
+	}
+
+	detail := entity.BookingDetail{
+		RoomPriceID:  roomPrice.ID,
+		RoomPrice:    *roomPrice,
+		CheckInDate:  checkInDate,
+		CheckOutDate: checkOutDate,
+		Quantity:     req.Quantity,
+		Promo:        promo,
+	}
+	quote, err := bu.quoteBookingDetail(ctx, detail, agentCurrency, exchangeRate, additionals)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &bookingdto.QuoteBookingResponse{
+		Currency:             quote.Currency,
+		Nights:               quote.Nights,
+		Quantity:             quote.Quantity,
+		Items:                quote.Items,
+		RoomTotalBeforePromo: quote.RoomTotalBeforePromo,
+		RoomTotal:            quote.RoomTotal,
+		AdditionalTotal:      quote.AdditionalTotal,
+		TotalPerRoom:         quote.Total,
+		GrandTotal:           quote.GrandTotal,
+	}
+	if promo != nil {
+		resp.Promo, err = bu.generateDetailPromo(promo)
+		if err != nil {
+			logger.Error(ctx, "failed to generate detail promo", err.Error())
+		}
+	}
+	if quote.ExchangeRate != nil {
+		resp.ExchangeRate = quote.ExchangeRate.Rate
+		resp.ExchangeRateMarkup = quote.ExchangeRate.MarkupPercent
+		resp.ExchangeRateDate = quote.ExchangeRate.EffectiveDate.Format(time.DateOnly)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,54 @@
+package booking_usecase
+
+import (
+	"context"
+	"fmt"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/pricing"
+)
+
+// quoteBookingDetail prices a booking detail with the seasonal rate plans of its room price, its promo and the given additionals.
+// Prices not entered in currencyCode are derived from IDR with the exchange rate, when given.
+func (bu *BookingUsecase) quoteBookingDetail(ctx context.Context, detail entity.BookingDetail, currencyCode string, exchangeRate *entity.ExchangeRate, additionals []pricing.QuoteAdditional) (*pricing.Quote, error) {
+	ratePlans, err := bu.hotelRepo.GetRoomRatePlans(ctx, []uint{detail.RoomPriceID}, detail.CheckInDate, detail.CheckOutDate)
+	if err != nil {
+		logger.Error(ctx, "failed to get room rate plans", err.Error())
+		return nil, fmt.Errorf("failed to get room rates: %s", err.Error())
+	}
+
+	roomPrice := detail.RoomPrice
+	roomPrice.ID = detail.RoomPriceID
+
+	quote, err := pricing.CalculateQuote(pricing.QuoteInput{
+		RoomPrice:    roomPrice,
+		RatePlans:    ratePlans,
+		CheckIn:      detail.CheckInDate,
+		CheckOut:     detail.CheckOutDate,
+		Quantity:     detail.Quantity,
+		Promo:        detail.Promo,
+		Additionals:  additionals,
+		Currency:     currencyCode,
+		ExchangeRate: exchangeRate,
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to calculate quote", err.Error())
+		return nil, fmt.Errorf("failed to calculate price: %s", err.Error())
+	}
+	return &quote, nil
+}
+
+// quoteAdditionals maps the additionals snapshot of a booking detail, priced in the booking currency at add to cart.
+func quoteAdditionals(detail entity.BookingDetail) []pricing.QuoteAdditional {
+	var additionals []pricing.QuoteAdditional
+	for _, additional := range detail.BookingDetailsAdditional {
+		additionals = append(additionals, pricing.QuoteAdditional{
+			Name:       additional.NameAdditional,
+			Category:   additional.Category,
+			Price:      additional.Price,
+			Pax:        additional.Pax,
+			IsRequired: additional.IsRequired,
+		})
+	}
+	return additionals
+}
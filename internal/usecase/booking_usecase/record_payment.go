@@ -0,0 +1,124 @@
+package booking_usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/currency"
+	"wtm-backend/pkg/logger"
+)
+
+func (bu *BookingUsecase) RecordPayment(ctx context.Context, req *bookingdto.RecordPaymentRequest) (*bookingdto.RecordPaymentResponse, error) {
+	userCtx, err := bu.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to get user from context", err.Error())
+		return nil, fmt.Errorf("failed to get user from context: %s", err.Error())
+	}
+
+	if userCtx == nil {
+		logger.Error(ctx, "user context is nil")
+		return nil, fmt.Errorf("user not found in context")
+	}
+
+	paidAt := time.Now()
+	if req.PaidAt != "" {
+		if paidAt, err = time.Parse(time.DateOnly, req.PaidAt); err != nil {
+			return nil, fmt.Errorf("invalid paid at date: %s", err.Error())
+		}
+	}
+
+	var resp *bookingdto.RecordPaymentResponse
+	err = bu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		ledger, err := bu.loadPaymentLedger(txCtx, strings.TrimSpace(req.BookingID), strings.TrimSpace(req.SubBookingID))
+		if err != nil {
+			return err
+		}
+
+		// Payments are kept in the booking currency so the balance never depends on an exchange rate
+		if req.Currency != "" && !strings.EqualFold(req.Currency, ledger.currency) {
+			return fmt.Errorf("payment currency %s does not match booking currency %s", strings.ToUpper(req.Currency), ledger.currency)
+		}
+
+		amount, err := currency.NewMoney(req.Amount, ledger.currency)
+		if err != nil {
+			return err
+		}
+
+		payment := entity.Payment{
+			BookingID:  ledger.booking.ID,
+			Amount:     amount,
+			Currency:   ledger.currency,
+			Method:     req.Method,
+			Reference:  req.Reference,
+			Notes:      req.Notes,
+			PaidAt:     paidAt,
+			RecordedBy: userCtx.ID,
+		}
+		if req.BookingID == "" {
+			for _, detail := range ledger.details {
+				if detail.SubBookingID == req.SubBookingID {
+					payment.BookingDetailID = &detail.ID
+					payment.SubBookingID = detail.SubBookingID
+					break
+				}
+			}
+			if payment.BookingDetailID == nil {
+				return fmt.Errorf("booking detail not found")
+			}
+		}
+
+		prefix := "booking/payments/booking"
+		if payment.BookingDetailID != nil {
+			prefix = "booking/payments/booking_detail"
+		}
+		if payment.ReceiptUrl, err = bu.uploadFile(txCtx, req.Receipt, prefix, ledger.booking.ID); err != nil {
+			logger.Error(ctx, "failed to upload payment receipt file", err.Error())
+			return err
+		}
+		if payment.ReceiptUrl == "" {
+			// Tanpa file, pembayaran memakai bukti transfer yang diunggah agent
+			payment.ReceiptUrl = ledger.agentReceipt(payment.BookingDetailID)
+		}
+
+		if err := bu.bookingRepo.CreatePayment(txCtx, &payment); err != nil {
+			logger.Error(ctx, "failed to create payment", err.Error())
+			return err
+		}
+		payment.RecordedByName = userCtx.FullName
+
+		ledger.payments = append(ledger.payments, payment)
+		ledger.releaseManualStatus(payment.BookingDetailID)
+		if err := ledger.allocate(); err != nil {
+			logger.Error(ctx, "failed to allocate payments", err.Error())
+			return err
+		}
+		if err := bu.syncStatusPayment(txCtx, ledger); err != nil {
+			return err
+		}
+
+		if err := bu.notifier.NotifyUser(txCtx, entity.Notification{
+			UserID:      ledger.booking.AgentID,
+			Title:       "Payment Received",
+			Message:     fmt.Sprintf("We received your payment of %s %s for Booking ID: %s", payment.Currency, payment.Amount, ledger.booking.BookingCode),
+			RedirectURL: fmt.Sprintf("%s/history-booking?search_by=booking_id&search=%s", bu.config.URLFEAgent, ledger.booking.BookingCode),
+			Type:        constant.ConstPaymentReceived,
+		}, nil); err != nil {
+			return err
+		}
+
+		resp = &bookingdto.RecordPaymentResponse{
+			Payment: toPaymentDTO(payment),
+			Balance: ledger.toBookingBalance(),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,59 @@
+package booking_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+func (bu *BookingUsecase) RemindCheckIns(ctx context.Context) (int, error) {
+	// Tanggal check in disimpan tanpa zona waktu, besok dihitung di zona waktu default
+	tomorrow := time.Now().In(constant.LoadTimezone(constant.DefaultTimezone)).AddDate(0, 0, 1).Format(time.DateOnly)
+
+	detailIDs, err := bu.bookingRepo.GetCheckInReminderDetailIDs(ctx, tomorrow)
+	if err != nil {
+		return 0, err
+	}
+
+	reminded := 0
+	for _, detailID := range detailIDs {
+		var claimed bool
+		err := bu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+			ok, err := bu.bookingRepo.ClaimCheckInReminder(txCtx, detailID)
+			if err != nil || !ok {
+				return err
+			}
+			claimed = true
+
+			details, err := bu.bookingRepo.GetBookingDetailsByIDs(txCtx, []uint{detailID})
+			if err != nil {
+				logger.Error(ctx, "failed to get booking detail", err.Error())
+				return err
+			}
+			if len(details) == 0 {
+				return nil
+			}
+			detail := details[0]
+
+			return bu.notifier.NotifyUser(txCtx, entity.Notification{
+				UserID:      detail.Booking.AgentID,
+				Title:       "Check-in Tomorrow",
+				Message:     fmt.Sprintf("%s checks in at %s tomorrow, please check Sub-booking ID: %s", detail.Guest, detail.DetailRooms.HotelName, detail.SubBookingID),
+				RedirectURL: fmt.Sprintf("%s/history-booking?search_by=booking_id&search=%s", bu.config.URLFEAgent, detail.Booking.BookingCode),
+				Type:        constant.ConstCheckInReminder,
+			}, nil)
+		})
+		if err != nil {
+			logger.Error(ctx, "failed to remind check in of booking detail", detailID, err.Error())
+			continue
+		}
+		if claimed {
+			reminded++
+		}
+	}
+
+	return reminded, nil
+}
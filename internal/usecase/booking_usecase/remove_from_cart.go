@@ -21,11 +21,20 @@ func (bu *BookingUsecase) RemoveFromCart(ctx context.Context, bookingDetailID ui
 
 	agentID := userCtx.ID
 
+	// Snapshot before delete, needed to release the cart hold
+	details, err := bu.bookingRepo.GetBookingDetailsByIDs(ctx, []uint{bookingDetailID})
+	if err != nil {
+		logger.Error(ctx, "failed to get booking detail", err.Error())
+		return fmt.Errorf("failed to get booking detail: %s", err.Error())
+	}
+
 	// Remove BookingDetail from cart
 	if err := bu.bookingRepo.DeleteCartBooking(ctx, agentID, bookingDetailID); err != nil {
 		logger.Error(ctx, "failed to remove booking detail from cart", err.Error())
 		return fmt.Errorf("failed to remove booking detail from cart: %s", err.Error())
 	}
 
+	bu.releaseCartHolds(ctx, details)
+
 	return nil
 }
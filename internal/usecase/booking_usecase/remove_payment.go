@@ -0,0 +1,34 @@
+package booking_usecase
+
+import (
+	"context"
+	"wtm-backend/pkg/logger"
+)
+
+func (bu *BookingUsecase) RemovePayment(ctx context.Context, paymentID uint) error {
+	return bu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		payment, err := bu.bookingRepo.GetPaymentByID(txCtx, paymentID)
+		if err != nil {
+			logger.Error(ctx, "failed to get payment", err.Error())
+			return err
+		}
+
+		booking, err := bu.bookingRepo.GetBookingByID(txCtx, payment.BookingID)
+		if err != nil {
+			logger.Error(ctx, "failed to get booking", err.Error())
+			return err
+		}
+
+		if err := bu.bookingRepo.DeletePayment(txCtx, paymentID); err != nil {
+			logger.Error(ctx, "failed to delete payment", err.Error())
+			return err
+		}
+
+		ledger, err := bu.loadPaymentLedger(txCtx, booking.BookingCode, "")
+		if err != nil {
+			return err
+		}
+		ledger.releaseManualStatus(payment.BookingDetailID)
+		return bu.syncStatusPayment(txCtx, ledger)
+	})
+}
@@ -0,0 +1,81 @@
+package booking_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+// checkRoomAvailability fails when any night of the stay has fewer than quantity units left,
+// counting the units held in carts as taken.
+func (bu *BookingUsecase) checkRoomAvailability(ctx context.Context, roomTypeID uint, roomTypeName string, checkInDate, checkOutDate time.Time, quantity int) error {
+	inventories, err := bu.hotelRepo.GetRoomInventories(ctx, []uint{roomTypeID}, checkInDate, checkOutDate)
+	if err != nil {
+		logger.Error(ctx, "failed to get room inventory", err.Error())
+		return fmt.Errorf("failed to check room availability: %s", err.Error())
+	}
+	holds, err := bu.bookingRepo.CountCartHolds(ctx, roomTypeID, checkInDate, checkOutDate, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to count cart holds", err.Error())
+		return fmt.Errorf("failed to check room availability: %s", err.Error())
+	}
+	for _, inv := range inventories {
+		if remaining := inv.TotalUnit - inv.BookedUnit - holds[inv.Date.Format(time.DateOnly)]; remaining < quantity {
+			logger.Error(ctx, fmt.Sprintf("Room type %s sold out on %s", roomTypeName, inv.Date.Format(time.DateOnly)))
+			return fmt.Errorf("not enough rooms available for %s on %s: %d requested, %d left", roomTypeName, inv.Date.Format(time.DateOnly), quantity, max(remaining, 0))
+		}
+	}
+	return nil
+}
+
+// holdCartUnits holds one unit per cart booking detail on every night of the stay. The hold checks the units left
+// after bookings against the other carts in the same atomic step, so two carts cannot take the last unit.
+func (bu *BookingUsecase) holdCartUnits(ctx context.Context, roomTypeID uint, roomTypeName string, checkInDate, checkOutDate time.Time, bookingDetailIDs []uint) error {
+	inventories, err := bu.hotelRepo.GetRoomInventories(ctx, []uint{roomTypeID}, checkInDate, checkOutDate)
+	if err != nil {
+		logger.Error(ctx, "failed to get room inventory", err.Error())
+		return fmt.Errorf("failed to check room availability: %s", err.Error())
+	}
+	available := make(map[string]int, len(inventories))
+	for _, inv := range inventories {
+		available[inv.Date.Format(time.DateOnly)] = inv.TotalUnit - inv.BookedUnit
+	}
+
+	if _, err := bu.bookingRepo.CreateCartHold(ctx, roomTypeID, checkInDate, checkOutDate, bookingDetailIDs, available, bu.config.DurationCartHold); err != nil {
+		logger.Error(ctx, "failed to create cart hold", err.Error())
+		return fmt.Errorf("failed to hold %s: %s", roomTypeName, err.Error())
+	}
+	return nil
+}
+
+// reserveRoomInventory takes the nights of every sub-booking out of the room inventory ledger.
+func (bu *BookingUsecase) reserveRoomInventory(ctx context.Context, details []entity.BookingDetail) error {
+	for _, detail := range details {
+		if err := bu.hotelRepo.ReserveRoomInventory(ctx, detail.RoomPrice.RoomTypeID, detail.CheckInDate, detail.CheckOutDate, detail.Quantity); err != nil {
+			logger.Error(ctx, "failed to reserve room inventory", "subBookingID", detail.SubBookingID, err.Error())
+			return fmt.Errorf("%s: %s", detail.RoomPrice.RoomType.Name, err.Error())
+		}
+	}
+	return nil
+}
+
+// releaseRoomInventory gives the nights back for sub-bookings that were holding them,
+// i.e. checked out and still waiting approval or confirmed.
+func (bu *BookingUsecase) releaseRoomInventory(ctx context.Context, details []entity.BookingDetail) error {
+	for _, detail := range details {
+		if detail.Booking.StatusBookingID == constant.StatusBookingInCartID {
+			continue
+		}
+		if detail.StatusBookingID != constant.StatusBookingWaitingApprovalID && detail.StatusBookingID != constant.StatusBookingConfirmedID {
+			continue
+		}
+		if err := bu.hotelRepo.ReleaseRoomInventory(ctx, detail.RoomPrice.RoomTypeID, detail.CheckInDate, detail.CheckOutDate, detail.Quantity); err != nil {
+			logger.Error(ctx, "failed to release room inventory", "subBookingID", detail.SubBookingID, err.Error())
+			return err
+		}
+	}
+	return nil
+}
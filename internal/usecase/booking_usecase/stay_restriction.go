@@ -0,0 +1,54 @@
+package booking_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/logger"
+)
+
+// validateStayRestrictions checks a stay against the yield rules of its room type.
+// Length of stay, closed to arrival and release days are read from the arrival date, closed to departure from the check-out date.
+func (bu *BookingUsecase) validateStayRestrictions(ctx context.Context, roomTypeID uint, roomTypeName string, checkInDate, checkOutDate time.Time) error {
+	restrictions, err := bu.hotelRepo.GetRoomRestrictions(ctx, []uint{roomTypeID}, checkInDate, checkOutDate.AddDate(0, 0, 1))
+	if err != nil {
+		logger.Error(ctx, "failed to get room restrictions", err.Error())
+		return fmt.Errorf("failed to check stay restrictions: %s", err.Error())
+	}
+
+	var arrival, departure entity.RoomRestriction
+	for _, r := range restrictions {
+		switch r.Date.Format(time.DateOnly) {
+		case checkInDate.Format(time.DateOnly):
+			arrival = r
+		case checkOutDate.Format(time.DateOnly):
+			departure = r
+		}
+	}
+
+	checkIn := checkInDate.Format(time.DateOnly)
+	nights := int(checkOutDate.Sub(checkInDate).Hours() / 24)
+
+	if arrival.ClosedToArrival {
+		return fmt.Errorf("%s is closed to arrival on %s", roomTypeName, checkIn)
+	}
+	if departure.ClosedToDeparture {
+		return fmt.Errorf("%s is closed to departure on %s", roomTypeName, checkOutDate.Format(time.DateOnly))
+	}
+	if arrival.MinStay > 0 && nights < arrival.MinStay {
+		return fmt.Errorf("%s requires a minimum stay of %d nights for arrival on %s, but %d nights requested", roomTypeName, arrival.MinStay, checkIn, nights)
+	}
+	if arrival.MaxStay > 0 && nights > arrival.MaxStay {
+		return fmt.Errorf("%s allows a maximum stay of %d nights for arrival on %s, but %d nights requested", roomTypeName, arrival.MaxStay, checkIn, nights)
+	}
+	if arrival.ReleaseDays > 0 {
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		if cutOff := checkInDate.AddDate(0, 0, -arrival.ReleaseDays); today.After(cutOff) {
+			return fmt.Errorf("%s must be booked at least %d days before arrival on %s (booking closed on %s)", roomTypeName, arrival.ReleaseDays, checkIn, cutOff.Format(time.DateOnly))
+		}
+	}
+
+	return nil
+}
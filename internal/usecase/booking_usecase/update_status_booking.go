@@ -46,172 +46,213 @@ func (bu *BookingUsecase) UpdateStatusBooking(ctx context.Context, req *bookingd
 			logger.Warn(ctx, "Booking status cannot be changed to waiting approval")
 			return errors.New("booking status cannot be changed to waiting approval")
 		}
-		bookingDetails, guests, err := bu.bookingRepo.UpdateBookingDetailStatusBooking(ctx, bookingDetailIDs, req.StatusID)
+		err = bu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+			// Snapshot sebelum update, hanya yang masih waiting approval yang berubah
+			previousDetails, err := bu.bookingRepo.GetBookingDetailsByIDs(txCtx, bookingDetailIDs)
+			if err != nil {
+				logger.Error(ctx, "failed to get booking details", err.Error())
+				return err
+			}
+
+			bookingDetails, guests, err := bu.bookingRepo.UpdateBookingDetailStatusBooking(txCtx, bookingDetailIDs, req.StatusID)
+			if err != nil {
+				logger.Error(ctx, "failed to update status booking", err.Error())
+				return err
+			}
+
+			// Booking yang ditolak mengembalikan malam yang sudah dipegang ke inventory
+			if req.StatusID == constant.StatusBookingRejectedID {
+				var rejected []entity.BookingDetail
+				for _, detail := range previousDetails {
+					if detail.StatusBookingID == constant.StatusBookingWaitingApprovalID {
+						rejected = append(rejected, detail)
+					}
+				}
+				if err = bu.releaseRoomInventory(txCtx, rejected); err != nil {
+					return err
+				}
+			}
+
+			// Email ke agent masuk outbox di transaksi yang sama dengan perubahan status
+			return bu.notifyAgentBookingStatus(txCtx, bookingDetails, req.StatusID, req.Reason, types, guests)
+		})
 		if err != nil {
-			logger.Error(ctx, "failed to update status booking", err.Error())
 			return err
 		}
 
-		go func() {
-			newCtx, cancel := context.WithTimeout(context.Background(), bu.config.DurationCtxTOSlow)
-			defer cancel()
-			bu.sendEmailNotificationAgent(newCtx, bookingDetails, req.StatusID, req.Reason, types, guests)
-		}()
-
 	case constant.ConstPayment:
-		if err = bu.bookingRepo.UpdateBookingDetailStatusPayment(ctx, bookingDetailIDs, req.StatusID); err != nil {
+		// Status dari admin ditandai manual supaya tidak ditimpa saat ledger pembayaran disinkronkan
+		if err = bu.bookingRepo.UpdateBookingDetailStatusPayment(ctx, bookingDetailIDs, req.StatusID, true); err != nil {
 			logger.Error(ctx, "failed to update status payment", err.Error())
 			return err
 		}
+		if req.StatusID == constant.StatusPaymentPaidID {
+			bu.notifyAgentPaymentReceived(ctx, bookingDetailIDs, types)
+		}
 	}
 
 	return nil
 }
 
-func (bu *BookingUsecase) sendEmailNotificationAgent(ctx context.Context, details []entity.BookingDetail, statusID uint, rejectionReason, types string, guests []string) {
+// notifyAgentBookingStatus notifies the agent of the new status of its booking in the transaction of ctx, a confirmation
+// with booking documents is sent once the transaction commits.
+func (bu *BookingUsecase) notifyAgentBookingStatus(ctx context.Context, details []entity.BookingDetail, statusID uint, rejectionReason, types string, guests []string) error {
 	if len(details) == 0 {
 		logger.Warn(ctx,
 			"No booking details provided for email notification")
-		return
+		return nil
 	}
 
 	booking := details[0].Booking
 
-	notifSettings, err := bu.userRepo.GetNotificationSettings(ctx, booking.AgentID)
-	if err != nil {
-		logger.Error(ctx, "Failed to get notification settings:", err.Error())
-		return
-	}
-
 	var templateName, title, message, typeNotif string
-	var notifAllowed NotifAgentAllowed
 	switch statusID {
 	case constant.StatusBookingConfirmedID:
 		templateName = constant.EmailBookingConfirmed
-		typeNotif = constant.ConstBooking
+		typeNotif = constant.ConstBookingConfirmed
 		message = fmt.Sprintf("Your booking has been confirmed, please check Booking ID: %s", booking.BookingCode)
 		title = "Booking Status Confirmed"
 
 	case constant.StatusBookingRejectedID:
 		templateName = constant.EmailBookingRejected
-		typeNotif = constant.ConstReject
+		typeNotif = constant.ConstBookingRejected
 		message = fmt.Sprintf("Your booking has been rejected, please check Booking ID: %s", booking.BookingCode)
 		title = "Booking Status Rejected"
 
+	case constant.StatusBookingCancelledID:
+		typeNotif = constant.ConstBookingCancelled
+		message = fmt.Sprintf("Your booking has been cancelled, please check Booking ID: %s", booking.BookingCode)
+		title = "Booking Status Cancelled"
+
 	default:
-		logger.Warn(ctx, "No email template for status:", statusID)
-		return
+		logger.Warn(ctx, "No notification for status:", statusID)
+		return nil
 	}
 
-	notifAllowed = getNotifAgentAllowed(notifSettings, typeNotif)
 	redirectURL := fmt.Sprintf("%s/history-booking?search_by=booking_id&search=%s", bu.config.URLFEAgent, booking.BookingCode)
+	notification := entity.Notification{
+		UserID:      booking.AgentID,
+		Title:       title,
+		Message:     message,
+		RedirectURL: redirectURL,
+		Type:        typeNotif,
+	}
 
-	if notifAllowed.WebNotif {
-		go func() {
-			newCtx, cancel := context.WithTimeout(context.Background(), bu.config.DurationCtxTOSlow)
-			defer cancel()
-			notification := entity.Notification{
-				UserID:      booking.AgentID,
-				Title:       title,
-				Message:     message,
-				RedirectURL: redirectURL,
-				Type:        typeNotif,
+	// Invoice and voucher PDFs go with the confirmation when enabled. Rendering and uploading them waits for the
+	// commit, so the transaction is not held open by it and a rollback leaves no files behind.
+	if templateName != "" && statusID == constant.StatusBookingConfirmedID && bu.config.EmailAttachBookingDocuments {
+		bu.dbTrx.AfterCommit(ctx, func(ctx context.Context) {
+			emailLog := bu.bookingStatusEmail(ctx, details, statusID, templateName, redirectURL, rejectionReason, types, guests)
+			if emailLog != nil {
+				var detailIDs []uint
+				for _, bd := range details {
+					detailIDs = append(detailIDs, bd.ID)
+				}
+				emailLog.Attachments = bu.bookingDocumentAttachments(ctx, detailIDs)
 			}
-
-			if err = bu.notifRepo.CreateNotification(newCtx, &notification); err != nil {
-				logger.Error(ctx, "Failed to create notification:", err.Error())
-				return
+			if err := bu.notifier.NotifyUser(ctx, notification, emailLog); err != nil {
+				logger.Error(ctx, "Failed to notify agent of confirmed booking", booking.BookingCode, err.Error())
 			}
-			logger.Info(ctx, "Web notification created for agent:", booking.AgentID)
-		}()
+		})
+		return nil
+	}
 
+	// Tanpa template khusus, agent menerima email notifikasi umum
+	var emailLog *entity.EmailLog
+	if templateName != "" {
+		emailLog = bu.bookingStatusEmail(ctx, details, statusID, templateName, redirectURL, rejectionReason, types, guests)
 	}
 
-	if notifAllowed.EmailNotif {
-		go func() {
-			newCtx, cancel := context.WithTimeout(context.Background(), bu.config.DurationCtxTOSlow)
-			defer cancel()
-			emailTemplate, err := bu.emailRepo.GetEmailTemplateByName(newCtx, templateName)
-			if err != nil || emailTemplate == nil {
-				logger.Error(newCtx, "Failed to get email template:", err)
-				return
-			}
+	return bu.notifier.NotifyUser(ctx, notification, emailLog)
+}
 
-			var subBookings []SubBookingData
-			for _, bd := range details {
-				subBookings = append(subBookings, SubBookingData{
-					SubBookingID: bd.SubBookingID,
-					Guest:        bd.Guest,
-					HotelName:    bd.DetailRooms.HotelName,
-					CheckIn:      bd.CheckInDate.Format("02-01-2006"),
-					CheckOut:     bd.CheckOutDate.Format("02-01-2006"),
-				})
-			}
+// notifyAgentPaymentReceived notifies the agent that the booking details were marked as paid
+func (bu *BookingUsecase) notifyAgentPaymentReceived(ctx context.Context, bookingDetailIDs []uint, types string) {
+	details, err := bu.bookingRepo.GetBookingDetailsByIDs(ctx, bookingDetailIDs)
+	if err != nil || len(details) == 0 {
+		logger.Error(ctx, "failed to get booking details for payment notification", err)
+		return
+	}
 
-			data := BookingEmailData{
-				AgentName:       booking.AgentName,
-				BookingID:       booking.BookingCode,
-				GuestName:       strings.Join(guests, ", "),
-				BookingLink:     redirectURL,
-				RejectionReason: rejectionReason,
-				HomePageLink:    bu.config.URLFEAgent,
-				SubBookings:     subBookings,
-			}
+	booking := details[0].Booking
+	message := fmt.Sprintf("Your payment has been received, please check Booking ID: %s", booking.BookingCode)
+	if types == constant.ConstSubBooking {
+		message = fmt.Sprintf("Your payment has been received, please check Sub-booking ID: %s", details[0].SubBookingID)
+	}
 
-			switch types {
-			case constant.ConstBooking:
-				data.ID = booking.BookingCode
-			case constant.ConstSubBooking:
-				data.ID = details[0].SubBookingID
-			}
+	_ = bu.notifier.NotifyUser(ctx, entity.Notification{
+		UserID:      booking.AgentID,
+		Title:       "Payment Received",
+		Message:     message,
+		RedirectURL: fmt.Sprintf("%s/history-booking?search_by=booking_id&search=%s", bu.config.URLFEAgent, booking.BookingCode),
+		Type:        constant.ConstPaymentReceived,
+	}, nil)
+}
 
-			subjectParsed, err := utils.ParseTemplate(emailTemplate.Subject, data)
-			bodyHTML, err := utils.ParseTemplate(emailTemplate.Body, data)
-			if err != nil {
-				logger.Error(ctx, "Failed to parse email template:", err)
-				return
-			}
+// bookingStatusEmail renders the status email of the booking for its agent, nil when the template is missing
+func (bu *BookingUsecase) bookingStatusEmail(ctx context.Context, details []entity.BookingDetail, statusID uint, templateName, redirectURL, rejectionReason, types string, guests []string) *entity.EmailLog {
+	booking := details[0].Booking
 
-			emailTo := booking.AgentEmail
+	emailTemplate, err := bu.emailRepo.GetEmailTemplateByName(ctx, templateName, booking.AgentLanguage)
+	if err != nil || emailTemplate == nil {
+		logger.Error(ctx, "Failed to get email template:", err)
+		return nil
+	}
 
-			emailLog := entity.EmailLog{
-				To:              emailTo,
-				Subject:         subjectParsed,
-				Body:            bodyHTML,
-				EmailTemplateID: uint(emailTemplate.ID),
-			}
-			metadataLog := entity.MetadataEmailLog{
-				AgentName:   booking.AgentName,
-				BookingCode: booking.BookingCode,
-			}
-			emailLog.Meta = &metadataLog
-
-			var dataEmail bool
-			statusEmailID := constant.StatusEmailSuccessID
-			if err = bu.emailRepo.CreateEmailLog(newCtx, &emailLog); err != nil {
-				logger.Error(newCtx, "Failed to create email log:", err)
-				dataEmail = false
-			} else {
-				dataEmail = true
-			}
+	var subBookings []SubBookingData
+	for _, bd := range details {
+		subBookings = append(subBookings, SubBookingData{
+			SubBookingID: bd.SubBookingID,
+			Guest:        bd.Guest,
+			HotelName:    bd.DetailRooms.HotelName,
+			CheckIn:      bd.CheckInDate.Format("02-01-2006"),
+			CheckOut:     bd.CheckOutDate.Format("02-01-2006"),
+		})
+	}
 
-			err = bu.emailSender.Send(newCtx, constant.ScopeAgent, emailTo, subjectParsed, bodyHTML, "Please view this email in HTML format.")
-			if err != nil {
-				logger.Error(newCtx, "Failed to sending email:", err.Error())
-				statusEmailID = constant.StatusEmailFailedID
-				metadataLog.Notes = fmt.Sprintf("Failed to send email: %s", err.Error())
-				emailLog.Meta = &metadataLog
-			}
+	data := BookingEmailData{
+		AgentName:       booking.AgentName,
+		BookingID:       booking.BookingCode,
+		GuestName:       strings.Join(guests, ", "),
+		BookingLink:     redirectURL,
+		RejectionReason: rejectionReason,
+		HomePageLink:    bu.config.URLFEAgent,
+		SubBookings:     subBookings,
+	}
 
-			if dataEmail {
-				emailLog.StatusID = uint(statusEmailID)
-				if err := bu.emailRepo.UpdateStatusEmailLog(newCtx, &emailLog); err != nil {
-					logger.Error(newCtx, "Failed to update email log:", err.Error())
-				}
-			}
-		}()
+	switch types {
+	case constant.ConstBooking:
+		data.ID = booking.BookingCode
+	case constant.ConstSubBooking:
+		data.ID = details[0].SubBookingID
+	}
+
+	subjectParsed, err := utils.ParseTemplate(emailTemplate.Subject, data)
+	if err != nil {
+		logger.Error(ctx, "Failed to parse email subject:", err)
+		return nil
+	}
+	bodyHTML, err := utils.ParseTemplate(emailTemplate.Body, data)
+	if err != nil {
+		logger.Error(ctx, "Failed to parse email template:", err)
+		return nil
+	}
 
+	emailLog := entity.EmailLog{
+		To:              booking.AgentEmail,
+		Subject:         subjectParsed,
+		Body:            bodyHTML,
+		EmailTemplateID: uint(emailTemplate.ID),
+		TemplateName:    emailTemplate.Name,
+		Scope:           string(constant.ScopeAgent),
 	}
+	metadataLog := entity.MetadataEmailLog{
+		AgentName:   booking.AgentName,
+		BookingCode: booking.BookingCode,
+	}
+	emailLog.Meta = &metadataLog
+
+	return &emailLog
 }
 
 func (bu *BookingUsecase) assignSignatureEmail(emailSignature string) string {
@@ -224,26 +265,6 @@ func (bu *BookingUsecase) assignSignatureEmail(emailSignature string) string {
 	return emailSignature
 }
 
-func getNotifAgentAllowed(notifSettings []entity.UserNotificationSetting, typeNotif string) NotifAgentAllowed {
-	result := NotifAgentAllowed{}
-	for _, setting := range notifSettings {
-		if setting.IsEnabled && setting.Type == typeNotif {
-			switch setting.Channel {
-			case constant.ConstEmail:
-				result.EmailNotif = true
-			case constant.ConstWeb:
-				result.WebNotif = true
-			}
-		}
-	}
-	return result
-}
-
-type NotifAgentAllowed struct {
-	EmailNotif bool
-	WebNotif   bool
-}
-
 type SubBookingData struct {
 	SubBookingID string
 	Guest        string
@@ -2,8 +2,11 @@ package booking_usecase
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/pkg/constant"
 	"wtm-backend/pkg/logger"
 )
 
@@ -11,6 +14,7 @@ func (bu *BookingUsecase) UploadReceipt(ctx context.Context, req *bookingdto.Upl
 	var bookindDetailIDs []uint
 	var prefix string
 	var id uint
+	var bookingCode string
 
 	if strings.TrimSpace(req.BookingID) != "" {
 		prefix = "booking/receipts/booking"
@@ -21,6 +25,7 @@ func (bu *BookingUsecase) UploadReceipt(ctx context.Context, req *bookingdto.Upl
 			return err
 		}
 		id = booking.ID
+		bookingCode = booking.BookingCode
 		bookindDetailIDs = make([]uint, 0, len(booking.BookingDetails))
 		for _, detail := range booking.BookingDetails {
 			bookindDetailIDs = append(bookindDetailIDs, detail.ID)
@@ -34,6 +39,7 @@ func (bu *BookingUsecase) UploadReceipt(ctx context.Context, req *bookingdto.Upl
 		bookindDetailIDs = append(bookindDetailIDs, detail.ID)
 		prefix = "booking/receipts/booking_detail"
 		id = detail.BookingID
+		bookingCode = detail.Booking.BookingCode
 	}
 
 	fileReceiptPath, err := bu.uploadFile(ctx, req.Receipt, prefix, id)
@@ -42,10 +48,26 @@ func (bu *BookingUsecase) UploadReceipt(ctx context.Context, req *bookingdto.Upl
 		return err
 	}
 
-	if err := bu.bookingRepo.UpdateBookingReceipt(ctx, bookindDetailIDs, fileReceiptPath); err != nil {
-		logger.Error(ctx, "failed to update booking receipt", err.Error())
-		return err
+	uploader := "An agent"
+	if userCtx, err := bu.middleware.GenerateUserFromContext(ctx); err == nil && userCtx != nil {
+		uploader = userCtx.FullName
+	}
+	message := fmt.Sprintf("%s uploaded a payment receipt for Booking ID: %s", uploader, bookingCode)
+	if strings.TrimSpace(req.BookingID) == "" {
+		message = fmt.Sprintf("%s uploaded a payment receipt for Sub-booking ID: %s of Booking ID: %s", uploader, req.BookingDetailID, bookingCode)
 	}
 
-	return nil
+	return bu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := bu.bookingRepo.UpdateBookingReceipt(txCtx, bookindDetailIDs, fileReceiptPath); err != nil {
+			logger.Error(ctx, "failed to update booking receipt", err.Error())
+			return err
+		}
+
+		return bu.notifier.NotifyPermission(txCtx, constant.PermissionBookingEdit, entity.Notification{
+			Title:       "Payment Receipt Uploaded",
+			Message:     message,
+			RedirectURL: fmt.Sprintf("%s/booking?search=%s", bu.config.URLFEAdmin, bookingCode),
+			Type:        constant.ConstReceipt,
+		})
+	})
 }
@@ -0,0 +1,92 @@
+package booking_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/dto/bookingdto"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+func (bu *BookingUsecase) VoucherPDF(ctx context.Context, req *bookingdto.BookingDocumentRequest) (*bookingdto.BookingDocumentResponse, error) {
+	detail, err := bu.documentBookingDetail(ctx, req.SubBookingID)
+	if err != nil || detail == nil {
+		return nil, err
+	}
+
+	if detail.StatusBookingID != constant.StatusBookingConfirmedID {
+		return nil, validation.Errors{
+			"sub_booking_id": validation.NewInternalError(errors.New("voucher is only available for confirmed bookings")),
+		}
+	}
+
+	content, err := bu.documentRenderer.RenderVoucher(ctx, bu.voucher(ctx, *detail))
+	if err != nil {
+		logger.Error(ctx, "failed to render voucher", err.Error())
+		return nil, fmt.Errorf("failed to render voucher: %s", err.Error())
+	}
+
+	return &bookingdto.BookingDocumentResponse{
+		Filename: fmt.Sprintf("voucher_%s.pdf", detail.SubBookingID),
+		Content:  content,
+	}, nil
+}
+
+// voucher maps a confirmed booking detail to its voucher. RoomPrice.RoomType.Hotel and Booking must be preloaded.
+func (bu *BookingUsecase) voucher(ctx context.Context, detail entity.BookingDetail) entity.Voucher {
+	hotel := detail.RoomPrice.RoomType.Hotel
+
+	voucher := entity.Voucher{
+		SubBookingID:     detail.SubBookingID,
+		BookingCode:      detail.Booking.BookingCode,
+		HotelName:        detail.DetailRooms.HotelName,
+		HotelAddress:     joinNonEmpty(", ", hotel.AddrSubDistrict, hotel.AddrCity, hotel.AddrProvince),
+		HotelEmail:       hotel.Email,
+		RoomTypeName:     detail.DetailRooms.RoomTypeName,
+		BedType:          detail.BedType,
+		Quantity:         detail.Quantity,
+		Guest:            detail.Guest,
+		CheckIn:          detail.CheckInDate,
+		CheckOut:         detail.CheckOutDate,
+		CheckInHour:      bu.config.DefaultCheckInHour,
+		CheckOutHour:     bu.config.DefaultCheckOutHour,
+		OtherPreferences: detail.OtherPreferences,
+		ConfirmedAt:      detail.ApprovedAt,
+	}
+	if voucher.HotelName == "" {
+		voucher.HotelName = hotel.Name
+	}
+	if hotel.CheckInHour != nil {
+		voucher.CheckInHour = hotel.CheckInHour.In(constant.AsiaJakarta).Format("15:04")
+	}
+	if hotel.CheckOutHour != nil {
+		voucher.CheckOutHour = hotel.CheckOutHour.In(constant.AsiaJakarta).Format("15:04")
+	}
+	for _, additional := range detail.BookingDetailsAdditional {
+		voucher.Additionals = append(voucher.Additionals, additional.NameAdditional)
+	}
+
+	if agent, err := bu.userRepo.GetUserByID(ctx, detail.Booking.AgentID); err != nil {
+		logger.Error(ctx, "failed to get agent for voucher", err.Error())
+	} else if agent != nil {
+		voucher.Agent = agent.FullName
+		voucher.AgentCompany = agent.AgentCompanyName
+	}
+
+	return voucher
+}
+
+func joinNonEmpty(sep string, values ...string) string {
+	var parts []string
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, sep)
+}
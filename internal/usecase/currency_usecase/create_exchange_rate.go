@@ -0,0 +1,39 @@
+package currency_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	currencypkg "wtm-backend/pkg/currency"
+	"wtm-backend/pkg/logger"
+)
+
+func (cu *CurrencyUsecase) CreateExchangeRate(ctx context.Context, exchangeRate *entity.ExchangeRate) (*entity.ExchangeRate, error) {
+	// Normalize currency code
+	exchangeRate.CurrencyCode = currencypkg.NormalizeCurrencyCode(exchangeRate.CurrencyCode)
+
+	// Rates are IDR based, IDR itself never needs one
+	if exchangeRate.CurrencyCode == "IDR" {
+		return nil, fmt.Errorf("exchange rate for IDR is not needed, rates are IDR based")
+	}
+
+	// Currency must be registered
+	existing, err := cu.currencyRepo.GetCurrencyByCode(ctx, exchangeRate.CurrencyCode)
+	if err != nil {
+		logger.Error(ctx, "Error checking existing currency", err.Error())
+		return nil, err
+	}
+	if existing == nil {
+		logger.Warn(ctx, "Currency not found", exchangeRate.CurrencyCode)
+		return nil, fmt.Errorf("currency with code %s not found", exchangeRate.CurrencyCode)
+	}
+
+	created, err := cu.currencyRepo.CreateExchangeRate(ctx, exchangeRate)
+	if err != nil {
+		logger.Error(ctx, "Error creating exchange rate", err.Error())
+		return nil, fmt.Errorf("failed to create exchange rate, a rate for %s on %s may already exist", exchangeRate.CurrencyCode, exchangeRate.EffectiveDate.Format(time.DateOnly))
+	}
+
+	return created, nil
+}
@@ -0,0 +1,17 @@
+package currency_usecase
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	currencypkg "wtm-backend/pkg/currency"
+	"wtm-backend/pkg/logger"
+)
+
+func (cu *CurrencyUsecase) ListExchangeRates(ctx context.Context, currencyCode string) ([]entity.ExchangeRate, error) {
+	exchangeRates, err := cu.currencyRepo.GetExchangeRates(ctx, currencypkg.NormalizeCurrencyCode(currencyCode))
+	if err != nil {
+		logger.Error(ctx, "Error getting exchange rates", err.Error())
+		return nil, err
+	}
+	return exchangeRates, nil
+}
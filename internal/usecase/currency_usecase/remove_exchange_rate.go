@@ -0,0 +1,26 @@
+package currency_usecase
+
+import (
+	"context"
+	"fmt"
+	"wtm-backend/pkg/logger"
+)
+
+func (cu *CurrencyUsecase) RemoveExchangeRate(ctx context.Context, id uint) error {
+	existing, err := cu.currencyRepo.GetExchangeRateByID(ctx, id)
+	if err != nil {
+		logger.Error(ctx, "Error checking existing exchange rate", err.Error())
+		return err
+	}
+	if existing == nil {
+		logger.Warn(ctx, "Exchange rate not found", id)
+		return fmt.Errorf("exchange rate with id %d not found", id)
+	}
+
+	if err := cu.currencyRepo.DeleteExchangeRate(ctx, id); err != nil {
+		logger.Error(ctx, "Error deleting exchange rate", err.Error())
+		return err
+	}
+
+	return nil
+}
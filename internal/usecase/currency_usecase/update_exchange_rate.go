@@ -0,0 +1,32 @@
+package currency_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/logger"
+)
+
+func (cu *CurrencyUsecase) UpdateExchangeRate(ctx context.Context, exchangeRate *entity.ExchangeRate) (*entity.ExchangeRate, error) {
+	// Check if exchange rate exists
+	existing, err := cu.currencyRepo.GetExchangeRateByID(ctx, exchangeRate.ID)
+	if err != nil {
+		logger.Error(ctx, "Error checking existing exchange rate", err.Error())
+		return nil, err
+	}
+	if existing == nil {
+		logger.Warn(ctx, "Exchange rate not found", exchangeRate.ID)
+		return nil, fmt.Errorf("exchange rate with id %d not found", exchangeRate.ID)
+	}
+
+	// Update exchange rate (currency cannot be changed)
+	exchangeRate.CurrencyCode = existing.CurrencyCode
+	updated, err := cu.currencyRepo.UpdateExchangeRate(ctx, exchangeRate)
+	if err != nil {
+		logger.Error(ctx, "Error updating exchange rate", err.Error())
+		return nil, fmt.Errorf("failed to update exchange rate, a rate for %s on %s may already exist", exchangeRate.CurrencyCode, exchangeRate.EffectiveDate.Format(time.DateOnly))
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,18 @@
+package email_usecase
+
+import (
+	"context"
+	"wtm-backend/internal/repository/filter"
+	"wtm-backend/pkg/constant"
+)
+
+// AutoRetryFailedEmails queues failed emails again, at most the retry budget per run.
+// Emails retried EmailAutoRetryMaxRetries times are left for a manual retry.
+func (eu *EmailUsecase) AutoRetryFailedEmails(ctx context.Context) (int, error) {
+	filterReq := filter.EmailLogFilter{
+		Status: []string{constant.StatusEmailFailed},
+	}
+	filterReq.Limit = eu.config.EmailAutoRetryBudget
+
+	return eu.requeueEmails(ctx, filterReq, eu.config.EmailAutoRetryMaxRetries)
+}
@@ -6,6 +6,7 @@ import (
 	"mime/multipart"
 	"path/filepath"
 	"time"
+	"wtm-backend/config"
 	"wtm-backend/internal/domain"
 	"wtm-backend/pkg/constant"
 	"wtm-backend/pkg/logger"
@@ -16,14 +17,22 @@ type EmailUsecase struct {
 	emailSender domain.EmailSender
 	bookingRepo domain.BookingRepository
 	fileStorage domain.StorageClient
+	dbTrx       domain.DatabaseTransaction
+	config      *config.Config
+	userRepo    domain.UserRepository
+	middleware  domain.Middleware
 }
 
-func NewEmailUsecase(emailRepo domain.EmailRepository, emailSender domain.EmailSender, bookingRepo domain.BookingRepository, fileStorage domain.StorageClient) *EmailUsecase {
+func NewEmailUsecase(emailRepo domain.EmailRepository, emailSender domain.EmailSender, bookingRepo domain.BookingRepository, fileStorage domain.StorageClient, dbTrx domain.DatabaseTransaction, config *config.Config, userRepo domain.UserRepository, middleware domain.Middleware) *EmailUsecase {
 	return &EmailUsecase{
 		emailRepo:   emailRepo,
 		emailSender: emailSender,
 		bookingRepo: bookingRepo,
 		fileStorage: fileStorage,
+		dbTrx:       dbTrx,
+		config:      config,
+		userRepo:    userRepo,
+		middleware:  middleware,
 	}
 }
 
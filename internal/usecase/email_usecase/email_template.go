@@ -10,17 +10,9 @@ import (
 
 func (eu *EmailUsecase) EmailTemplate(ctx context.Context, req *emaildto.EmailTemplateRequest) (*emaildto.EmailTemplateResponse, error) {
 
-	var nameTemplate string
-	switch req.Type {
-	case "confirm":
-		nameTemplate = constant.EmailHotelBookingRequest
-	case "cancel":
-		nameTemplate = constant.EmailHotelBookingCancel
-	default:
-		nameTemplate = constant.EmailHotelBookingRequest
-	}
+	nameTemplate := emailTemplateName(req.Type)
 
-	emailTemplate, err := eu.emailRepo.GetEmailTemplateByName(ctx, nameTemplate)
+	emailTemplate, err := eu.emailRepo.GetEmailTemplateByName(ctx, nameTemplate, req.Locale)
 	if err != nil {
 		logger.Error(ctx, "Error getting email template by name:", err.Error())
 		return nil, err
@@ -31,7 +23,15 @@ func (eu *EmailUsecase) EmailTemplate(ctx context.Context, req *emaildto.EmailTe
 		return nil, nil
 	}
 
+	locales, err := eu.emailRepo.GetEmailTemplateLocales(ctx, nameTemplate)
+	if err != nil {
+		logger.Error(ctx, "Error getting email template locales:", err.Error())
+		return nil, err
+	}
+
 	resp := &emaildto.EmailTemplateResponse{
+		Locale:    emailTemplate.Locale,
+		Locales:   locales,
 		Body:      emailTemplate.Body,
 		Subject:   emailTemplate.Subject,
 		Signature: emailTemplate.Signature,
@@ -48,3 +48,11 @@ func (eu *EmailUsecase) EmailTemplate(ctx context.Context, req *emaildto.EmailTe
 
 	return resp, nil
 }
+
+// emailTemplateName maps a template type of constant.MapEmailTemplateType to its template name, hotel booking request by default
+func emailTemplateName(templateType string) string {
+	if name, ok := constant.MapEmailTemplateType[templateType]; ok {
+		return name
+	}
+	return constant.EmailHotelBookingRequest
+}
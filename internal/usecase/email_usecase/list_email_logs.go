@@ -12,7 +12,7 @@ import (
 func (eu *EmailUsecase) ListEmailLogs(ctx context.Context, req *emaildto.ListEmailLogsRequest) (*emaildto.ListEmailLogsResponse, error) {
 	filterReq := filter.EmailLogFilter{}
 	filterReq.PaginationRequest = req.PaginationRequest
-	filterReq.EmailType = []string{constant.EmailHotelBookingRequest, constant.EmailHotelBookingCancel}
+	filterReq.EmailType = []string{constant.EmailHotelBookingRequest, constant.EmailHotelBookingCancel, constant.EmailHotelBookingAmend}
 
 	// Apply filters from request
 	if len(req.Status) > 0 {
@@ -25,23 +25,7 @@ func (eu *EmailUsecase) ListEmailLogs(ctx context.Context, req *emaildto.ListEma
 		filterReq.BookingCode = req.BookingCode
 	}
 
-	// Parse date strings
-	if req.DateFrom != "" {
-		if dateFrom, err := time.Parse("2006-01-02", req.DateFrom); err == nil {
-			filterReq.DateFrom = &dateFrom
-		} else if dateFrom, err := time.Parse(time.RFC3339, req.DateFrom); err == nil {
-			filterReq.DateFrom = &dateFrom
-		}
-	}
-	if req.DateTo != "" {
-		if dateTo, err := time.Parse("2006-01-02", req.DateTo); err == nil {
-			// Set to end of day
-			dateTo = dateTo.Add(23*time.Hour + 59*time.Minute + 59*time.Second)
-			filterReq.DateTo = &dateTo
-		} else if dateTo, err := time.Parse(time.RFC3339, req.DateTo); err == nil {
-			filterReq.DateTo = &dateTo
-		}
-	}
+	filterReq.DateFrom, filterReq.DateTo = emailLogDateRange(req.DateFrom, req.DateTo)
 
 	emailLogs, total, err := eu.emailRepo.GetEmailLogs(ctx, filterReq)
 	if err != nil {
@@ -71,3 +55,25 @@ func (eu *EmailUsecase) ListEmailLogs(ctx context.Context, req *emaildto.ListEma
 
 	return response, nil
 }
+
+// emailLogDateRange parses the date range of an email log filter, a date without time includes the whole day
+func emailLogDateRange(from, to string) (*time.Time, *time.Time) {
+	var dateFrom, dateTo *time.Time
+	if from != "" {
+		if t, err := time.Parse("2006-01-02", from); err == nil {
+			dateFrom = &t
+		} else if t, err := time.Parse(time.RFC3339, from); err == nil {
+			dateFrom = &t
+		}
+	}
+	if to != "" {
+		if t, err := time.Parse("2006-01-02", to); err == nil {
+			// Set to end of day
+			t = t.Add(23*time.Hour + 59*time.Minute + 59*time.Second)
+			dateTo = &t
+		} else if t, err := time.Parse(time.RFC3339, to); err == nil {
+			dateTo = &t
+		}
+	}
+	return dateFrom, dateTo
+}
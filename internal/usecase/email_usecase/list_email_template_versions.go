@@ -0,0 +1,45 @@
+package email_usecase
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/dto/emaildto"
+	"wtm-backend/pkg/logger"
+)
+
+func (eu *EmailUsecase) ListEmailTemplateVersions(ctx context.Context, req *emaildto.EmailTemplateRequest) ([]emaildto.EmailTemplateVersionResponse, error) {
+	nameTemplate := emailTemplateName(req.Type)
+
+	emailTemplate, err := eu.emailRepo.GetEmailTemplateByName(ctx, nameTemplate, req.Locale)
+	if err != nil {
+		logger.Error(ctx, "Error getting email template by name:", err.Error())
+		return nil, err
+	}
+
+	versions, err := eu.emailRepo.GetEmailTemplateVersions(ctx, uint(emailTemplate.ID))
+	if err != nil {
+		logger.Error(ctx, "Error getting email template versions:", err.Error())
+		return nil, err
+	}
+
+	resp := make([]emaildto.EmailTemplateVersionResponse, 0, len(versions))
+	for _, version := range versions {
+		resp = append(resp, toEmailTemplateVersionResponse(version))
+	}
+
+	return resp, nil
+}
+
+func toEmailTemplateVersionResponse(version entity.EmailTemplateVersion) emaildto.EmailTemplateVersionResponse {
+	return emaildto.EmailTemplateVersionResponse{
+		Version:          version.Version,
+		Subject:          version.Subject,
+		Body:             version.Body,
+		IsSignatureImage: version.IsSignatureImage,
+		Signature:        version.Signature,
+		AuthorName:       version.AuthorName,
+		Note:             version.Note,
+		CreatedAt:        version.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,45 @@
+package email_usecase
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/dto/emaildto"
+	"wtm-backend/internal/repository/filter"
+	"wtm-backend/pkg/logger"
+)
+
+func (eu *EmailUsecase) ListInboundEmails(ctx context.Context, req *emaildto.ListInboundEmailsRequest) (*emaildto.ListInboundEmailsResponse, error) {
+	filterReq := filter.InboundEmailFilter{
+		PaginationRequest: req.PaginationRequest,
+		BookingCode:       req.BookingCode,
+		Status:            req.Status,
+	}
+
+	inbounds, total, err := eu.emailRepo.GetInboundEmails(ctx, filterReq)
+	if err != nil {
+		logger.Error(ctx, "failed to list inbound emails", err.Error())
+		return nil, err
+	}
+
+	response := &emaildto.ListInboundEmailsResponse{
+		InboundEmails: make([]emaildto.InboundEmailResponse, 0, len(inbounds)),
+		Total:         total,
+	}
+	for _, inbound := range inbounds {
+		response.InboundEmails = append(response.InboundEmails, emaildto.InboundEmailResponse{
+			ID:                 inbound.ExternalID,
+			DateTime:           inbound.CreatedAt.Format(time.RFC3339),
+			From:               inbound.From,
+			Subject:            inbound.Subject,
+			Body:               inbound.Body,
+			BookingCode:        inbound.BookingCode,
+			SubBookingIDs:      inbound.SubBookingIDs,
+			Action:             inbound.Action,
+			ConfirmationNumber: inbound.ConfirmationNumber,
+			Status:             inbound.Status,
+			Note:               inbound.Note,
+		})
+	}
+
+	return response, nil
+}
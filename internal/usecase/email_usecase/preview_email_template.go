@@ -0,0 +1,73 @@
+package email_usecase
+
+import (
+	"context"
+	"fmt"
+	"wtm-backend/internal/dto/emaildto"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// PreviewEmailTemplate renders the current template, or one of its versions, against the sample data of its type
+func (eu *EmailUsecase) PreviewEmailTemplate(ctx context.Context, req *emaildto.PreviewEmailTemplateRequest) (*emaildto.PreviewEmailTemplateResponse, error) {
+	nameTemplate := emailTemplateName(req.Type)
+
+	emailTemplate, err := eu.emailRepo.GetEmailTemplateByName(ctx, nameTemplate, req.Locale)
+	if err != nil {
+		logger.Error(ctx, "Error getting email template by name:", err.Error())
+		return nil, err
+	}
+
+	resp := &emaildto.PreviewEmailTemplateResponse{Name: nameTemplate, Locale: emailTemplate.Locale}
+	subject, body := emailTemplate.Subject, emailTemplate.Body
+	isSignatureImage, signature := emailTemplate.IsSignatureImage, emailTemplate.Signature
+
+	if req.Version > 0 {
+		version, err := eu.emailRepo.GetEmailTemplateVersion(ctx, uint(emailTemplate.ID), req.Version)
+		if err != nil {
+			logger.Error(ctx, "Error getting email template version:", err.Error())
+			return nil, err
+		}
+		if version == nil {
+			return nil, validation.Errors{
+				"version": validation.NewInternalError(fmt.Errorf("version %d of %s not found", req.Version, nameTemplate)),
+			}
+		}
+		resp.Version = version.Version
+		subject, body = version.Subject, version.Body
+		isSignatureImage, signature = version.IsSignatureImage, version.Signature
+	} else {
+		versions, err := eu.emailRepo.GetEmailTemplateVersions(ctx, uint(emailTemplate.ID))
+		if err != nil {
+			logger.Error(ctx, "Error getting email template versions:", err.Error())
+			return nil, err
+		}
+		if len(versions) > 0 {
+			resp.Version = versions[0].Version
+		}
+	}
+
+	resp.Subject, resp.Body, err = renderTemplateSample(nameTemplate, subject, body, eu.previewSignature(ctx, isSignatureImage, signature))
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// previewSignature returns the signature as the hotel emails show it, image signatures linked from storage instead of embedded
+func (eu *EmailUsecase) previewSignature(ctx context.Context, isSignatureImage bool, signature string) string {
+	if !isSignatureImage || signature == "" {
+		return signature
+	}
+
+	bucketName := fmt.Sprintf("%s-%s", constant.ConstEmail, constant.ConstPublic)
+	signatureImg, err := eu.fileStorage.GetFile(ctx, bucketName, signature)
+	if err != nil {
+		logger.Error(ctx, "Error getting signature image:", err.Error())
+		return ""
+	}
+	return fmt.Sprintf(`<img src="%s" alt="Signature" style="width:150px;">`, signatureImg)
+}
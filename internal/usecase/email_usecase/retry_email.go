@@ -2,13 +2,20 @@ package email_usecase
 
 import (
 	"context"
+	"fmt"
+	"time"
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/emaildto"
+	"wtm-backend/internal/repository/filter"
 	"wtm-backend/pkg/constant"
 	"wtm-backend/pkg/logger"
 )
 
 func (eu *EmailUsecase) RetryEmail(ctx context.Context, req *emaildto.RetryEmailRequest) (*emaildto.RetryEmailResponse, error) {
+	if req.ID == 0 {
+		return eu.retryEmails(ctx, req.Filter)
+	}
+
 	// Get email log
 	emailLog, err := eu.emailRepo.GetEmailLogByID(ctx, req.ID)
 	if err != nil {
@@ -27,61 +34,119 @@ func (eu *EmailUsecase) RetryEmail(ctx context.Context, req *emaildto.RetryEmail
 		}, nil
 	}
 
-	// Determine scope based on email template name
-	// For now, we'll use ScopeAgent as default since most emails are agent-related
-	// This can be enhanced to determine scope from email template metadata
-	scope := constant.ScopeAgent
-
-	// Update status to pending
-	emailLog.StatusID = constant.StatusEmailPendingID
-	if err := eu.emailRepo.UpdateStatusEmailLog(ctx, emailLog); err != nil {
-		logger.Error(ctx, "failed to update email log status to pending", err.Error())
+	queued, err := eu.requeueEmail(ctx, *emailLog)
+	if err != nil {
+		logger.Error(ctx, "failed to queue email for retry", err.Error())
 		return &emaildto.RetryEmailResponse{
 			Success: false,
-			Message: "Failed to update email log status",
+			Message: "Failed to queue email for retry",
 		}, err
 	}
+	if !queued {
+		return &emaildto.RetryEmailResponse{
+			Success: false,
+			Message: "Email is already being retried",
+		}, nil
+	}
 
-	// Send email
-	bodyText := "Please view this email in HTML format."
-	err = eu.emailSender.Send(ctx, scope, emailLog.To, emailLog.Subject, emailLog.Body, bodyText)
-	
-	statusEmailID := constant.StatusEmailSuccessID
-	if err != nil {
-		logger.Error(ctx, "failed to retry email", err.Error())
-		statusEmailID = constant.StatusEmailFailedID
-		
-		// Update metadata with error
-		if emailLog.Meta == nil {
-			emailLog.Meta = &entity.MetadataEmailLog{}
-		}
-		if emailLog.Meta.Notes != "" {
-			emailLog.Meta.Notes += "; Retry failed: " + err.Error()
-		} else {
-			emailLog.Meta.Notes = "Retry failed: " + err.Error()
-		}
+	return &emaildto.RetryEmailResponse{
+		Success: true,
+		Message: "Email queued for retry",
+		Queued:  1,
+	}, nil
+}
+
+// retryEmails queues every failed (or stuck pending) email of the filter, up to the bulk limit
+func (eu *EmailUsecase) retryEmails(ctx context.Context, req *emaildto.RetryEmailFilter) (*emaildto.RetryEmailResponse, error) {
+	filterReq := filter.EmailLogFilter{
+		Status:      req.Status,
+		EmailType:   req.EmailType,
+		HotelName:   req.HotelName,
+		BookingCode: req.BookingCode,
+	}
+	if len(filterReq.Status) == 0 {
+		filterReq.Status = []string{constant.StatusEmailFailed}
 	}
+	filterReq.DateFrom, filterReq.DateTo = emailLogDateRange(req.DateFrom, req.DateTo)
+	filterReq.Limit = eu.config.EmailRetryBulkLimit
 
-	// Update status
-	emailLog.StatusID = uint(statusEmailID)
-	if err := eu.emailRepo.UpdateStatusEmailLog(ctx, emailLog); err != nil {
-		logger.Error(ctx, "failed to update email log status after retry", err.Error())
+	queued, err := eu.requeueEmails(ctx, filterReq, 0)
+	if err != nil {
 		return &emaildto.RetryEmailResponse{
 			Success: false,
-			Message: "Failed to update email log status",
+			Message: fmt.Sprintf("Failed to queue emails for retry after %d emails", queued),
+			Queued:  queued,
 		}, err
 	}
 
-	if err != nil {
+	if queued == 0 {
 		return &emaildto.RetryEmailResponse{
-			Success: false,
-			Message: "Failed to resend email: " + err.Error(),
+			Success: true,
+			Message: "No emails to retry",
 		}, nil
 	}
 
 	return &emaildto.RetryEmailResponse{
 		Success: true,
-		Message: "Email resent successfully",
+		Message: fmt.Sprintf("%d emails queued for retry", queued),
+		Queued:  queued,
 	}, nil
 }
 
+// requeueEmails queues the retryable email logs of the filter, it stops at the first email that cannot be queued
+func (eu *EmailUsecase) requeueEmails(ctx context.Context, filterReq filter.EmailLogFilter, maxRetries int) (int, error) {
+	emailLogs, err := eu.emailRepo.GetRetryableEmailLogs(ctx, filterReq, maxRetries)
+	if err != nil {
+		logger.Error(ctx, "failed to get retryable email logs", err.Error())
+		return 0, err
+	}
+
+	queued := 0
+	for _, emailLog := range emailLogs {
+		ok, err := eu.requeueEmail(ctx, emailLog)
+		if err != nil {
+			logger.Error(ctx, fmt.Sprintf("failed to queue email log %d for retry", emailLog.ID), err.Error())
+			return queued, err
+		}
+		if ok {
+			queued++
+		}
+	}
+
+	return queued, nil
+}
+
+// requeueEmail moves an email log back to pending and queues it in the outbox through the SMTP route of its scope.
+// It returns false without queueing when the log changed since it was read, so an email is queued once per retry
+func (eu *EmailUsecase) requeueEmail(ctx context.Context, emailLog entity.EmailLog) (bool, error) {
+	queued := false
+	err := eu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		marked, err := eu.emailRepo.MarkEmailLogRetrying(txCtx, emailLog.ID, emailLog.StatusID, emailLog.RetryCount)
+		if err != nil || !marked {
+			return err
+		}
+		queued = true
+
+		emailLog.Scope = string(emailScope(emailLog))
+		return eu.emailRepo.EnqueueEmail(txCtx, emailLog, time.Time{})
+	})
+	return queued && err == nil, err
+}
+
+// emailScope returns the scope an email was sent with, logs written before the scope was stored are routed by template
+func emailScope(emailLog entity.EmailLog) constant.Scope {
+	if emailLog.Scope != "" {
+		return constant.Scope(emailLog.Scope)
+	}
+	return templateScope(emailLog.TemplateName)
+}
+
+// templateScope returns the SMTP route of a template, hotel for the hotel booking templates and agent for the rest
+func templateScope(name string) constant.Scope {
+	switch name {
+	case constant.EmailHotelBookingRequest, constant.EmailHotelBookingCancel, constant.EmailHotelBookingAmend:
+		return constant.ScopeHotel
+	default:
+		return constant.ScopeAgent
+	}
+}
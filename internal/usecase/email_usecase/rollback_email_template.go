@@ -0,0 +1,67 @@
+package email_usecase
+
+import (
+	"context"
+	"fmt"
+	"wtm-backend/internal/dto/emaildto"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// RollbackEmailTemplate restores a version of the template. The restored content is saved as a new version so the rollback itself can be undone.
+func (eu *EmailUsecase) RollbackEmailTemplate(ctx context.Context, req *emaildto.RollbackEmailTemplateRequest) (*emaildto.EmailTemplateVersionResponse, error) {
+	nameTemplate := emailTemplateName(req.Type)
+
+	locale := req.Locale
+	if locale == "" {
+		locale = constant.DefaultLocale
+	}
+
+	emailTemplate, err := eu.emailRepo.GetEmailTemplateByName(ctx, nameTemplate, locale)
+	if err != nil {
+		logger.Error(ctx, "Error getting email template by name:", err.Error())
+		return nil, err
+	}
+	if emailTemplate.Locale != locale {
+		return nil, validation.Errors{
+			"locale": validation.NewInternalError(fmt.Errorf("%s has no %s variant", nameTemplate, locale)),
+		}
+	}
+
+	version, err := eu.emailRepo.GetEmailTemplateVersion(ctx, uint(emailTemplate.ID), req.Version)
+	if err != nil {
+		logger.Error(ctx, "Error getting email template version:", err.Error())
+		return nil, err
+	}
+	if version == nil {
+		return nil, validation.Errors{
+			"version": validation.NewInternalError(fmt.Errorf("version %d of %s not found", req.Version, nameTemplate)),
+		}
+	}
+
+	emailTemplate.Subject = version.Subject
+	emailTemplate.Body = version.Body
+	emailTemplate.IsSignatureImage = version.IsSignatureImage
+	emailTemplate.Signature = version.Signature
+
+	var resp emaildto.EmailTemplateVersionResponse
+	err = eu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := eu.emailRepo.UpdateEmailTemplate(txCtx, emailTemplate); err != nil {
+			return err
+		}
+		restored, err := eu.createTemplateVersion(txCtx, emailTemplate, fmt.Sprintf("Rollback to version %d", version.Version))
+		if err != nil {
+			return err
+		}
+		resp = toEmailTemplateVersionResponse(*restored)
+		return nil
+	})
+	if err != nil {
+		logger.Error(ctx, "Error rolling back email template:", err.Error())
+		return nil, err
+	}
+
+	return &resp, nil
+}
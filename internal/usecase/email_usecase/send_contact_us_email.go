@@ -26,7 +26,7 @@ func (eu *EmailUsecase) SendContactUsEmail(ctx context.Context, req *emaildto.Se
 	}
 
 	// Ambil template
-	emailTemplate, err := eu.emailRepo.GetEmailTemplateByName(ctx, templateName)
+	emailTemplate, err := eu.emailRepo.GetEmailTemplateByName(ctx, templateName, constant.DefaultLocale)
 	if err != nil {
 		logger.Error(ctx, "get email template by name fail", err.Error())
 		return err
@@ -66,6 +66,8 @@ func (eu *EmailUsecase) SendContactUsEmail(ctx context.Context, req *emaildto.Se
 			Subject:         subjectParsed,
 			Body:            bodyHTML,
 			EmailTemplateID: uint(emailTemplate.ID),
+			TemplateName:    emailTemplate.Name,
+			Scope:           string(constant.ScopeAgent),
 		}
 
 		var dataEmail bool
@@ -183,6 +185,8 @@ func (eu *EmailUsecase) SendContactUsEmail(ctx context.Context, req *emaildto.Se
 			Subject:         subjectParsed,
 			Body:            bodyHTML,
 			EmailTemplateID: uint(emailTemplate.ID),
+			TemplateName:    emailTemplate.Name,
+			Scope:           string(constant.ScopeAgent),
 		}
 
 		var dataEmail bool
@@ -0,0 +1,183 @@
+package email_usecase
+
+import (
+	"fmt"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/utils"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// templateSampleData returns sample data for the template name, with the same fields as the data the template is sent with:
+// HotelEmailData, HotelEmailDataCancel, HotelEmailDataAmend and BookingEmailData in booking_usecase,
+// EmailData and AccountActivatedEmailData in user_usecase, EmailData in auth_usecase and the contact us data of this package.
+// Keep both in sync, a placeholder missing here is rejected when the template is saved.
+func templateSampleData(name, signature string) map[string]interface{} {
+	additionalServices := []map[string]interface{}{
+		{"Name": "Breakfast", "Category": constant.AdditionalServiceCategoryPrice, "Price": "IDR 150,000", "Pax": "", "IsRequired": true},
+		{"Name": "Extra Bed", "Category": constant.AdditionalServiceCategoryPax, "Price": "", "Pax": "1", "IsRequired": false},
+	}
+
+	switch name {
+	case constant.EmailHotelBookingRequest:
+		return map[string]interface{}{
+			"Guests": []map[string]interface{}{
+				{"Name": "John Doe", "Honorific": "Mr", "Category": constant.GuestCategoryAdult, "Age": ""},
+				{"Name": "Jane Doe", "Honorific": "Ms", "Category": constant.GuestCategoryChild, "Age": "8"},
+			},
+			"GuestName":          "John Doe, Jane Doe",
+			"Period":             "01-02-2025 - 03-02-2025",
+			"RoomType":           "Deluxe Room",
+			"BedTypes":           "King",
+			"Rate":               "IDR 2,500,000",
+			"BookingCode":        "BK-SAMPLE-001",
+			"Remark":             "Late check-in",
+			"Additional":         "Breakfast, Extra Bed",
+			"AdditionalServices": additionalServices,
+			"SystemSignature":    signature,
+			"BookingDetails": []map[string]interface{}{
+				{
+					"BookingNumber":      1,
+					"SubBookingID":       "SB-SAMPLE-001",
+					"GuestName":          "John Doe",
+					"Period":             "01-02-2025 - 03-02-2025",
+					"RoomType":           "Deluxe Room",
+					"BedTypes":           "King",
+					"Rate":               "IDR 2,500,000",
+					"AdditionalServices": additionalServices,
+					"Additional":         "Breakfast, Extra Bed",
+				},
+			},
+		}
+	case constant.EmailHotelBookingCancel:
+		return map[string]interface{}{
+			"GuestName":           "John Doe",
+			"Period":              "01-02-2025 - 03-02-2025",
+			"RoomType":            "Deluxe Room",
+			"Rate":                "IDR 2,500,000",
+			"BookingCode":         "SB-SAMPLE-001",
+			"Remark":              "Change of plans",
+			"Additional":          "Breakfast",
+			"SystemSignature":     signature,
+			"CancellationPolicy":  "Late cancellation",
+			"CancellationPenalty": "IDR 1,250,000 (50%)",
+		}
+	case constant.EmailHotelBookingAmend:
+		return map[string]interface{}{
+			"GuestName":          "John Doe",
+			"BookingCode":        "BK-SAMPLE-001",
+			"SubBookingID":       "SB-SAMPLE-001",
+			"Period":             "02-02-2025 - 04-02-2025",
+			"RoomType":           "Suite",
+			"BedTypes":           "King",
+			"Quantity":           1,
+			"Rate":               "IDR 4,000,000",
+			"AdditionalServices": additionalServices,
+			"SystemSignature":    signature,
+			"PreviousPeriod":     "01-02-2025 - 03-02-2025",
+			"PreviousRoomType":   "Deluxe Room",
+			"PreviousQuantity":   1,
+			"Reason":             "Guest requested a larger room",
+		}
+	case constant.EmailBookingConfirmed, constant.EmailBookingRejected:
+		return map[string]interface{}{
+			"ID":          "BK-SAMPLE-001",
+			"AgentName":   "Sample Agent",
+			"BookingID":   "BK-SAMPLE-001",
+			"GuestName":   "John Doe",
+			"BookingLink": "https://example.com/booking/BK-SAMPLE-001",
+			"SubBookings": []map[string]interface{}{
+				{"SubBookingID": "SB-SAMPLE-001", "Guest": "John Doe", "HotelName": "Sample Hotel", "CheckIn": "01-02-2025", "CheckOut": "03-02-2025"},
+			},
+			"RejectionReason": "Hotel is fully booked",
+			"HomePageLink":    "https://example.com",
+		}
+	case constant.EmailAgentApproved, constant.EmailAgentRejected:
+		return map[string]interface{}{
+			"AgentName":       "Sample Agent",
+			"LoginLink":       "https://example.com/login",
+			"ReRegisterLink":  "https://example.com/register",
+			"RejectionReason": "Incomplete documents",
+		}
+	case constant.EmailAccountActivated:
+		return map[string]interface{}{
+			"FullName":            "Sample Agent",
+			"TemporaryPassword":   "Temp-Pass-123",
+			"AccountSettingsLink": "https://example.com/settings",
+		}
+	case constant.EmailForgotPassword:
+		return map[string]interface{}{
+			"FullName":  "Sample Agent",
+			"ResetLink": "https://example.com/reset-password?token=sample",
+			"ExpiresIn": "30 minutes",
+		}
+	case constant.EmailContactUsGeneral:
+		return map[string]interface{}{
+			"UserName":    "John Doe",
+			"UserEmail":   "john.doe@example.com",
+			"Subject":     "Partnership inquiry",
+			"UserMessage": "I would like to know more about your services.",
+		}
+	case constant.EmailContactUsBooking:
+		return map[string]interface{}{
+			"BookingID":  "BK-SAMPLE-001",
+			"AgentName":  "Sample Agent",
+			"AgentEmail": "agent@example.com",
+			"AgencyName": "Sample Travel",
+			"AgentPhone": "+62 812 0000 0000",
+			"GuestName":  "John Doe",
+			"SubBookings": []map[string]interface{}{
+				{"SubBookingCode": "SB-SAMPLE-001", "GuestName": "John Doe", "Hotel": "Sample Hotel", "CheckIn": "01 Feb 2025", "CheckOut": "03 Feb 2025"},
+			},
+			"AgentMessage": "Please confirm the late check-in.",
+		}
+	case constant.EmailNotification:
+		return map[string]interface{}{
+			"FullName": "Sample User",
+			"Title":    "New Booking",
+			"Message":  "Sample Agent checked out Booking ID: BK-SAMPLE-001",
+			"Link":     "https://example.com/booking?search=BK-SAMPLE-001",
+		}
+	case constant.EmailNotificationDigest:
+		return map[string]interface{}{
+			"FullName": "Sample Agent",
+			"Date":     "01 Feb 2025",
+			"Count":    1,
+			"Items": []map[string]interface{}{
+				{"Title": "Booking Status Confirmed", "Message": "Your booking has been confirmed, please check Booking ID: BK-SAMPLE-001", "Link": "https://example.com/history-booking?search=BK-SAMPLE-001", "Time": "09:30"},
+			},
+		}
+	case constant.EmailAccountLocked:
+		return map[string]interface{}{
+			"FullName":           "Sample Agent",
+			"Attempts":           5,
+			"IPAddress":          "203.0.113.10",
+			"AttemptedAt":        "01 Feb 2025 09:30",
+			"LockedFor":          "15 minutes",
+			"ForgotPasswordLink": "https://example.com/forgot-password",
+		}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// renderTemplateSample renders subject and body against the sample data of the template name,
+// failing on unknown placeholders so a broken template is never saved
+func renderTemplateSample(name, subject, body, signature string) (string, string, error) {
+	data := templateSampleData(name, signature)
+
+	subjectParsed, err := utils.ParseTemplateStrict(subject, data)
+	if err != nil {
+		return "", "", validation.Errors{
+			"subject": validation.NewInternalError(fmt.Errorf("invalid template subject: %w", err)),
+		}
+	}
+	bodyHTML, err := utils.ParseTemplateStrict(body, data)
+	if err != nil {
+		return "", "", validation.Errors{
+			"body": validation.NewInternalError(fmt.Errorf("invalid template body: %w", err)),
+		}
+	}
+
+	return subjectParsed, bodyHTML, nil
+}
@@ -0,0 +1,42 @@
+package email_usecase
+
+import (
+	"context"
+	"fmt"
+	"wtm-backend/internal/dto/emaildto"
+	"wtm-backend/pkg/logger"
+)
+
+// TestSendEmailTemplate sends the preview of a template to the requesting admin, through the SMTP route of the template
+func (eu *EmailUsecase) TestSendEmailTemplate(ctx context.Context, req *emaildto.PreviewEmailTemplateRequest) (*emaildto.TestSendEmailTemplateResponse, error) {
+	userCtx, err := eu.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The access token does not carry the email address
+	user, err := eu.userRepo.GetUserByID(ctx, userCtx.ID)
+	if err != nil {
+		logger.Error(ctx, "Error getting user by ID:", err.Error())
+		return nil, err
+	}
+	if user == nil || user.Email == "" {
+		return nil, fmt.Errorf("no email address for user %d", userCtx.ID)
+	}
+
+	preview, err := eu.PreviewEmailTemplate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	subject := "[TEST] " + preview.Subject
+	if err := eu.emailSender.Send(ctx, templateScope(preview.Name), user.Email, subject, preview.Body, "Please view this email in HTML format."); err != nil {
+		logger.Error(ctx, "Error sending test email:", err.Error())
+		return nil, err
+	}
+
+	return &emaildto.TestSendEmailTemplateResponse{
+		To:      user.Email,
+		Subject: subject,
+	}, nil
+}
@@ -2,6 +2,8 @@ package email_usecase
 
 import (
 	"context"
+	"fmt"
+	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/emaildto"
 	"wtm-backend/pkg/constant"
 	"wtm-backend/pkg/logger"
@@ -9,33 +11,52 @@ import (
 
 func (eu *EmailUsecase) UpdateEmailTemplate(ctx context.Context, req *emaildto.UpdateEmailTemplateRequest) error {
 
-	var typeName string
-	switch req.Type {
-	case "confirm":
-		typeName = constant.EmailHotelBookingRequest
-	case "cancel":
-		typeName = constant.EmailHotelBookingCancel
-	default:
-		typeName = constant.EmailHotelBookingRequest
+	typeName := emailTemplateName(req.Type)
+	locale := req.Locale
+	if locale == "" {
+		locale = constant.DefaultLocale
 	}
 
-	emailTemplate, err := eu.emailRepo.GetEmailTemplateByName(ctx, typeName)
+	emailTemplate, err := eu.emailRepo.GetEmailTemplateByName(ctx, typeName, locale)
 	if err != nil {
 		logger.Error(ctx, "Error getting email template by name:", err.Error())
 		return err
 	}
 
 	if emailTemplate == nil {
-		logger.Error(ctx, "Email template not found for name:", constant.EmailHotelBookingRequest)
+		logger.Error(ctx, "Email template not found for name:", typeName)
 		return nil
 	}
 
+	// The version history starts with the template as it was before its first edit
+	baseline := *emailTemplate
+
+	// A missing language variant starts as a copy of the fallback, with its own version history
+	isNewVariant := emailTemplate.Locale != locale
+	if isNewVariant {
+		emailTemplate = &entity.EmailTemplate{
+			Name:             emailTemplate.Name,
+			Locale:           locale,
+			Subject:          emailTemplate.Subject,
+			Body:             emailTemplate.Body,
+			IsSignatureImage: emailTemplate.IsSignatureImage,
+			Signature:        emailTemplate.Signature,
+		}
+	}
+
 	if req.Subject != "" {
 		emailTemplate.Subject = req.Subject
 	}
 	if req.Body != "" {
 		emailTemplate.Body = req.Body
 	}
+
+	// Reject unknown placeholders before anything is uploaded or saved
+	if _, _, err := renderTemplateSample(emailTemplate.Name, emailTemplate.Subject, emailTemplate.Body, ""); err != nil {
+		logger.Warn(ctx, "Email template rejected:", err.Error())
+		return err
+	}
+
 	if req.SignatureImage != nil {
 		emailTemplate.IsSignatureImage = true
 		url, err := eu.uploadFile(ctx, req.SignatureImage)
@@ -45,8 +66,73 @@ func (eu *EmailUsecase) UpdateEmailTemplate(ctx context.Context, req *emaildto.U
 		}
 		emailTemplate.Signature = url
 	} else if req.SignatureText != "" {
+		emailTemplate.IsSignatureImage = false
 		emailTemplate.Signature = req.SignatureText
 	}
 
-	return eu.emailRepo.UpdateEmailTemplate(ctx, emailTemplate)
+	return eu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		if isNewVariant {
+			if err := eu.emailRepo.CreateEmailTemplate(txCtx, emailTemplate); err != nil {
+				return err
+			}
+		} else {
+			if err := eu.ensureTemplateBaseline(txCtx, &baseline); err != nil {
+				return err
+			}
+			if err := eu.emailRepo.UpdateEmailTemplate(txCtx, emailTemplate); err != nil {
+				return err
+			}
+		}
+		_, err := eu.createTemplateVersion(txCtx, emailTemplate, req.Note)
+		return err
+	})
+}
+
+// ensureTemplateBaseline stores the template as version 1 when it has no versions yet, so its original content can be restored
+func (eu *EmailUsecase) ensureTemplateBaseline(ctx context.Context, emailTemplate *entity.EmailTemplate) error {
+	versions, err := eu.emailRepo.GetEmailTemplateVersions(ctx, uint(emailTemplate.ID))
+	if err != nil {
+		logger.Error(ctx, "Error getting email template versions:", err.Error())
+		return err
+	}
+	if len(versions) > 0 {
+		return nil
+	}
+
+	baseline := templateVersion(emailTemplate)
+	baseline.Note = "Baseline before the first edit"
+	if err := eu.emailRepo.CreateEmailTemplateVersion(ctx, &baseline); err != nil {
+		logger.Error(ctx, "Error creating email template baseline version:", err.Error())
+		return err
+	}
+	return nil
+}
+
+// createTemplateVersion stores the current content of the template as a new version authored by the user of ctx
+func (eu *EmailUsecase) createTemplateVersion(ctx context.Context, emailTemplate *entity.EmailTemplate, note string) (*entity.EmailTemplateVersion, error) {
+	version := templateVersion(emailTemplate)
+	version.Note = note
+
+	user, err := eu.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get author of email template version: %w", err)
+	}
+	version.AuthorID = &user.ID
+	version.AuthorName = user.FullName
+
+	if err := eu.emailRepo.CreateEmailTemplateVersion(ctx, &version); err != nil {
+		logger.Error(ctx, "Error creating email template version:", err.Error())
+		return nil, err
+	}
+	return &version, nil
+}
+
+func templateVersion(emailTemplate *entity.EmailTemplate) entity.EmailTemplateVersion {
+	return entity.EmailTemplateVersion{
+		EmailTemplateID:  uint(emailTemplate.ID),
+		Subject:          emailTemplate.Subject,
+		Body:             emailTemplate.Body,
+		IsSignatureImage: emailTemplate.IsSignatureImage,
+		Signature:        emailTemplate.Signature,
+	}
 }
@@ -31,6 +31,11 @@ func (hu *HotelUsecase) AddRoomType(ctx context.Context, hotelID uint, req *hote
 			}
 		}
 
+		totalUnit := req.TotalUnit
+		if totalUnit == 0 {
+			totalUnit = 1
+		}
+
 		rt := &entity.RoomType{
 			HotelID:                hotelID,
 			Name:                   req.Name,
@@ -38,7 +43,7 @@ func (hu *HotelUsecase) AddRoomType(ctx context.Context, hotelID uint, req *hote
 			MaxOccupancy:           req.MaxOccupancy,
 			RoomSize:               req.RoomSize,
 			Description:            req.Description,
-			TotalUnit:              1,
+			TotalUnit:              totalUnit,
 			BookingLimitPerBooking: req.BookingLimitPerBooking,
 		}
 
@@ -78,6 +78,7 @@ func (hu *HotelUsecase) CreateHotel(ctx context.Context, req *hoteldto.CreateHot
 			CheckOutHour:       checkOutHour,
 			SocialMedia:        socialMediasMap,
 			Email:              req.Email,
+			Language:           req.Language,
 		}
 
 		// Create hotel
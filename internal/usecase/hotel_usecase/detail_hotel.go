@@ -31,9 +31,14 @@ func (hu *HotelUsecase) DetailHotel(ctx context.Context, hotelID uint) (*hoteldt
 		Description:        hotel.Description,
 		Rating:             hotel.Rating,
 		Email:              hotel.Email,
+		Language:           hotel.Language,
 		Facilities:         hotel.FacilityNames,
 		NearbyPlace:        hotel.NearbyPlaces,
 		CancellationPeriod: hotel.CancellationPeriod,
+
+		CancellationPolicyID: hotel.CancellationPolicyID,
+		DepositPercent:       hotel.DepositPercent,
+		BalanceDueDays:       hotel.BalanceDueDays,
 	}
 
 	for _, photo := range hotel.Photos {
@@ -63,6 +68,7 @@ func (hu *HotelUsecase) DetailHotel(ctx context.Context, hotelID uint) (*hoteldt
 			IsSmokingRoom:          rt.IsSmokingAllowed != nil && *rt.IsSmokingAllowed,
 			Description:            rt.Description,
 			BookingLimitPerBooking: rt.BookingLimitPerBooking,
+			TotalUnit:              rt.TotalUnit,
 		}
 
 		for i, photo := range rt.Photos {
@@ -3,14 +3,16 @@ package hotel_usecase
 import (
 	"context"
 	"fmt"
+	"time"
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/hoteldto"
 	"wtm-backend/pkg/constant"
 	"wtm-backend/pkg/currency"
 	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/pricing"
 )
 
-func (hu *HotelUsecase) DetailHotelForAgent(ctx context.Context, hotelID uint) (*hoteldto.DetailHotelForAgentResponse, error) {
+func (hu *HotelUsecase) DetailHotelForAgent(ctx context.Context, req *hoteldto.DetailHotelForAgentRequest) (*hoteldto.DetailHotelForAgentResponse, error) {
 	userCtx, err := hu.middleware.GenerateUserFromContext(ctx)
 	if err != nil {
 		logger.Error(ctx, "failed to get user from context", err.Error())
@@ -24,7 +26,7 @@ func (hu *HotelUsecase) DetailHotelForAgent(ctx context.Context, hotelID uint) (
 
 	agentID := userCtx.ID
 
-	hotel, err := hu.hotelRepo.GetHotelByID(ctx, hotelID, agentID)
+	hotel, err := hu.hotelRepo.GetHotelByID(ctx, req.HotelID, agentID)
 	if err != nil {
 		logger.Error(ctx, "Error getting hotel by Id", err.Error())
 		return nil, err
@@ -45,6 +47,15 @@ func (hu *HotelUsecase) DetailHotelForAgent(ctx context.Context, hotelID uint) (
 		Email:              hotel.Email,
 		Facilities:         hotel.FacilityNames,
 		CancellationPeriod: hotel.CancellationPeriod,
+		DepositPercent:     hotel.DepositPercent,
+		BalanceDueDays:     hotel.BalanceDueDays,
+	}
+
+	if hotel.CancellationPolicyID != nil {
+		respHotel.CancellationPolicy, err = hu.hotelRepo.GetCancellationPolicyByID(ctx, *hotel.CancellationPolicyID)
+		if err != nil {
+			logger.Error(ctx, "Error getting hotel cancellation policy", err.Error())
+		}
 	}
 
 	bucketName := fmt.Sprintf("%s-%s", constant.ConstHotel, constant.ConstPublic)
@@ -80,6 +91,35 @@ func (hu *HotelUsecase) DetailHotelForAgent(ctx context.Context, hotelID uint) (
 	}
 	normalizedCurrency := currency.NormalizeCurrencyCode(agentCurrency)
 
+	// Exchange rate in effect today, derives prices not entered in the agent's currency
+	var exchangeRate *entity.ExchangeRate
+	if normalizedCurrency != "IDR" {
+		exchangeRate, err = hu.currencyRepo.GetEffectiveExchangeRate(ctx, normalizedCurrency, time.Now())
+		if err != nil {
+			logger.Error(ctx, "Error getting exchange rate", err.Error())
+			return nil, err
+		}
+	}
+
+	// Effective rate for the requested stay, rate plans layered over the base prices
+	dateFrom, errFrom := time.Parse(time.DateOnly, req.DateFrom)
+	dateTo, errTo := time.Parse(time.DateOnly, req.DateTo)
+	withStay := errFrom == nil && errTo == nil && dateTo.After(dateFrom)
+
+	var ratePlans []entity.RoomRatePlan
+	if withStay {
+		var roomPriceIDs []uint
+		for _, rt := range hotel.RoomTypes {
+			roomPriceIDs = append(roomPriceIDs, rt.WithBreakfast.ID, rt.WithoutBreakfast.ID)
+		}
+		ratePlans, err = hu.hotelRepo.GetRoomRatePlans(ctx, roomPriceIDs, dateFrom, dateTo)
+		if err != nil {
+			logger.Error(ctx, "Error getting room rate plans", err.Error())
+			return nil, err
+		}
+		_, ratePlans = pricing.DeriveRoomPrices(entity.RoomPrice{}, ratePlans, normalizedCurrency, exchangeRate)
+	}
+
 	var roomTypeList []hoteldto.DetailRoomTypeForAgent
 	for _, rt := range hotel.RoomTypes {
 		roomType := hoteldto.DetailRoomTypeForAgent{
@@ -104,7 +144,7 @@ func (hu *HotelUsecase) DetailHotelForAgent(ctx context.Context, hotelID uint) (
 			ID:     rt.WithoutBreakfast.ID,
 			Pax:    rt.WithoutBreakfast.Pax,
 			Price:  rt.WithoutBreakfast.Price,
-			Prices: rt.WithoutBreakfast.Prices,
+			Prices: pricing.DerivePrices(rt.WithoutBreakfast.Prices, normalizedCurrency, exchangeRate),
 			IsShow: rt.WithoutBreakfast.IsShow,
 		}
 
@@ -112,10 +152,21 @@ func (hu *HotelUsecase) DetailHotelForAgent(ctx context.Context, hotelID uint) (
 			ID:     rt.WithBreakfast.ID,
 			Pax:    rt.WithBreakfast.Pax,
 			Price:  rt.WithBreakfast.Price,
-			Prices: rt.WithBreakfast.Prices,
+			Prices: pricing.DerivePrices(rt.WithBreakfast.Prices, normalizedCurrency, exchangeRate),
 			IsShow: rt.WithBreakfast.IsShow,
 		}
 
+		if withStay {
+			if roomType.WithoutBreakfast, err = effectiveBreakfastPrice(roomType.WithoutBreakfast, ratePlans, agentCurrency, dateFrom, dateTo); err != nil {
+				logger.Error(ctx, "Error pricing room without breakfast", err.Error())
+				return nil, err
+			}
+			if roomType.WithBreakfast, err = effectiveBreakfastPrice(roomType.WithBreakfast, ratePlans, agentCurrency, dateFrom, dateTo); err != nil {
+				logger.Error(ctx, "Error pricing room with breakfast", err.Error())
+				return nil, err
+			}
+		}
+
 		var promos []hoteldto.PromoDetailRoom
 		for _, prt := range rt.PromoRoomTypes {
 			if prt.Promo.IsActive {
@@ -141,8 +192,8 @@ func (hu *HotelUsecase) DetailHotelForAgent(ctx context.Context, hotelID uint) (
 					priceWithoutBreakfast = (100 - prt.Promo.Detail.DiscountPercentage) / 100 * basePriceWithoutBreakfast
 				} else if len(prt.Promo.Detail.Prices) > 0 {
 					// Use Prices map for multi-currency support
-					// GetPriceForCurrency will fallback to IDR if agent's currency not found
-					if price, _, _ := currency.GetPriceForCurrency(prt.Promo.Detail.Prices, agentCurrency); price > 0 {
+					// Missing agent currency is derived from the exchange rate, otherwise GetPriceForCurrency falls back to IDR
+					if price, _, _ := currency.GetPriceForCurrency(pricing.DerivePrices(prt.Promo.Detail.Prices, normalizedCurrency, exchangeRate), agentCurrency); price > 0 {
 						priceWithBreakfast = price
 						priceWithoutBreakfast = price
 					} else if prt.Promo.Detail.FixedPrice > 0 {
@@ -224,3 +275,26 @@ func (hu *HotelUsecase) DetailHotelForAgent(ctx context.Context, hotelID uint) (
 
 	return respHotel, nil
 }
+
+// effectiveBreakfastPrice replaces the base prices with the average nightly rate of the stay in every currency.
+func effectiveBreakfastPrice(b entity.CustomBreakfastWithID, ratePlans []entity.RoomRatePlan, agentCurrency string, dateFrom, dateTo time.Time) (entity.CustomBreakfastWithID, error) {
+	roomPrice := entity.RoomPrice{ID: b.ID, Price: b.Price, Prices: b.Prices}
+	nights := int(dateTo.Sub(dateFrom).Hours() / 24)
+
+	prices := make(map[string]float64, len(b.Prices))
+	for code := range b.Prices {
+		rates, err := pricing.NightlyRates(roomPrice, ratePlans, code, dateFrom, dateTo)
+		if err != nil {
+			return b, err
+		}
+		prices[code] = pricing.Total(rates).Div(nights).Float64()
+	}
+	b.Prices = prices
+
+	var err error
+	if b.NightlyRates, err = pricing.NightlyRates(roomPrice, ratePlans, agentCurrency, dateFrom, dateTo); err != nil {
+		return b, err
+	}
+
+	return b, nil
+}
@@ -23,9 +23,10 @@ type HotelUsecase struct {
 	dbTransaction domain.DatabaseTransaction
 	config        *config.Config
 	middleware    domain.Middleware
+	currencyRepo  domain.CurrencyRepository
 }
 
-func NewHotelUsecase(hotelRepo domain.HotelRepository, userRepo domain.UserRepository, fileStorage domain.StorageClient, dbTrx domain.DatabaseTransaction, config *config.Config, middleware domain.Middleware) *HotelUsecase {
+func NewHotelUsecase(hotelRepo domain.HotelRepository, userRepo domain.UserRepository, fileStorage domain.StorageClient, dbTrx domain.DatabaseTransaction, config *config.Config, middleware domain.Middleware, currencyRepo domain.CurrencyRepository) *HotelUsecase {
 	return &HotelUsecase{
 		hotelRepo:     hotelRepo,
 		userRepo:      userRepo,
@@ -33,6 +34,7 @@ func NewHotelUsecase(hotelRepo domain.HotelRepository, userRepo domain.UserRepos
 		dbTransaction: dbTrx,
 		config:        config,
 		middleware:    middleware,
+		currencyRepo:  currencyRepo,
 	}
 }
 
@@ -0,0 +1,19 @@
+package hotel_usecase
+
+import (
+	"context"
+	"wtm-backend/internal/dto/hoteldto"
+	"wtm-backend/pkg/logger"
+)
+
+func (hu *HotelUsecase) ListCancellationPolicies(ctx context.Context) (*hoteldto.ListCancellationPoliciesResponse, error) {
+	policies, err := hu.hotelRepo.GetCancellationPolicies(ctx)
+	if err != nil {
+		logger.Error(ctx, "Error getting cancellation policies", err.Error())
+		return nil, err
+	}
+
+	return &hoteldto.ListCancellationPoliciesResponse{
+		CancellationPolicies: policies,
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"wtm-backend/internal/dto/hoteldto"
 	"wtm-backend/internal/repository/filter"
 	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/currency"
 	"wtm-backend/pkg/logger"
 
 	"golang.org/x/sync/errgroup"
@@ -104,6 +105,19 @@ func (hu *HotelUsecase) ListHotelsForAgent(ctx context.Context, req *hoteldto.Li
 		filterHotel.MinGuest = req.TotalGuests / req.TotalRooms
 	}
 
+	// Prices are compared in the agent's currency, the ones not entered in it are derived with the exchange rate
+	if normalizedCurrency := currency.NormalizeCurrencyCode(agentCurrency); normalizedCurrency != "IDR" {
+		exchangeRate, err := hu.currencyRepo.GetEffectiveExchangeRate(ctx, normalizedCurrency, time.Now())
+		if err != nil {
+			logger.Error(ctx, "Error getting exchange rate", err.Error())
+			return nil, err
+		}
+		if exchangeRate != nil {
+			filterHotel.ExchangeRate = exchangeRate.Rate
+			filterHotel.ExchangeRateMarkup = exchangeRate.MarkupPercent
+		}
+	}
+
 	filter.Clean(&filterHotel)
 
 	var (
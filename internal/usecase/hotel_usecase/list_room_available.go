@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"time"
+	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/hoteldto"
 	"wtm-backend/pkg/logger"
 	"wtm-backend/pkg/utils"
@@ -36,7 +37,37 @@ func (hu *HotelUsecase) ListRoomAvailable(ctx context.Context, req *hoteldto.Lis
 		return nil, err
 	}
 
-	// 4. Init response
+	// 4. Ambil ledger inventory per malam untuk bulan tersebut
+	inventories, err := hu.hotelRepo.GetRoomInventories(ctx, roomTypeIDs, monthTime, monthTime.AddDate(0, 1, 0))
+	if err != nil {
+		logger.Error(ctx, "Error getting room inventory", "roomTypeIDs", roomTypeIDs, "month", monthTime, err.Error())
+		return nil, err
+	}
+
+	// 5. Ambil restriction (min/max stay, CTA/CTD, release days) untuk bulan tersebut
+	restrictions, err := hu.hotelRepo.GetRoomRestrictions(ctx, roomTypeIDs, monthTime, monthTime.AddDate(0, 1, 0))
+	if err != nil {
+		logger.Error(ctx, "Error getting room restrictions", "roomTypeIDs", roomTypeIDs, "month", monthTime, err.Error())
+		return nil, err
+	}
+
+	restrictionMap := make(map[uint]map[int]entity.RoomRestriction)
+	for _, r := range restrictions {
+		if restrictionMap[r.RoomTypeID] == nil {
+			restrictionMap[r.RoomTypeID] = make(map[int]entity.RoomRestriction)
+		}
+		restrictionMap[r.RoomTypeID][r.Date.Day()] = r
+	}
+
+	remainingMap := make(map[uint]map[int]int)
+	for _, inv := range inventories {
+		if remainingMap[inv.RoomTypeID] == nil {
+			remainingMap[inv.RoomTypeID] = make(map[int]int)
+		}
+		remainingMap[inv.RoomTypeID][inv.Date.Day()] = max(inv.TotalUnit-inv.BookedUnit, 0)
+	}
+
+	// 6. Init response
 	resp := &hoteldto.ListRoomAvailableResponse{}
 
 	days, err := utils.DaysInMonth(monthTime)
@@ -45,7 +76,7 @@ func (hu *HotelUsecase) ListRoomAvailable(ctx context.Context, req *hoteldto.Lis
 		return nil, err
 	}
 
-	// 5. Bangun map: roomTypeID → set of tanggal unavailable
+	// 7. Bangun map: roomTypeID → set of tanggal unavailable
 	unavailMap := make(map[uint]map[int]bool)
 	for _, ru := range roomUnavailable {
 		if ru.Date == nil {
@@ -58,20 +89,35 @@ func (hu *HotelUsecase) ListRoomAvailable(ctx context.Context, req *hoteldto.Lis
 		unavailMap[ru.RoomTypeID][day] = true
 	}
 
-	// 6. Construct DTO
+	// 8. Construct DTO
 	resp.RoomAvailable = make([]hoteldto.RoomAvailable, 0, len(rooms))
 	for _, room := range rooms {
 		roomAvailable := hoteldto.RoomAvailable{
 			RoomTypeID:   room.ID,
 			RoomTypeName: room.Name,
+			TotalUnit:    room.TotalUnit,
 			Data:         make([]hoteldto.DataAvailable, 0, days),
 		}
 
 		for day := 1; day <= days; day++ {
 			isUnavailable := unavailMap[room.ID][day]
+			remaining, ok := remainingMap[room.ID][day]
+			if !ok {
+				remaining = room.TotalUnit
+			}
+			if isUnavailable {
+				remaining = 0
+			}
+			restriction := restrictionMap[room.ID][day]
 			roomAvailable.Data = append(roomAvailable.Data, hoteldto.DataAvailable{
-				Day:       day,
-				Available: !isUnavailable,
+				Day:               day,
+				Available:         !isUnavailable,
+				RemainingUnit:     remaining,
+				MinStay:           restriction.MinStay,
+				MaxStay:           restriction.MaxStay,
+				ClosedToArrival:   restriction.ClosedToArrival,
+				ClosedToDeparture: restriction.ClosedToDeparture,
+				ReleaseDays:       restriction.ReleaseDays,
 			})
 		}
 
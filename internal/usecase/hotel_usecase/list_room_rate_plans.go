@@ -0,0 +1,42 @@
+package hotel_usecase
+
+import (
+	"context"
+	"time"
+	"wtm-backend/internal/dto/hoteldto"
+	"wtm-backend/pkg/logger"
+)
+
+func (hu *HotelUsecase) ListRoomRatePlans(ctx context.Context, req *hoteldto.ListRoomRatePlanRequest) (*hoteldto.ListRoomRatePlanResponse, error) {
+	ratePlans, err := hu.hotelRepo.GetRoomRatePlansByRoomTypeID(ctx, req.RoomTypeID)
+	if err != nil {
+		logger.Error(ctx, "Error getting room rate plans", "roomTypeID", req.RoomTypeID, err.Error())
+		return nil, err
+	}
+
+	resp := &hoteldto.ListRoomRatePlanResponse{
+		RatePlans: make([]hoteldto.RoomRatePlan, 0, len(ratePlans)),
+	}
+	for _, rp := range ratePlans {
+		daysOfWeek := rp.DaysOfWeek
+		if daysOfWeek == nil {
+			daysOfWeek = []int{}
+		}
+		resp.RatePlans = append(resp.RatePlans, hoteldto.RoomRatePlan{
+			ID:          rp.ID,
+			RoomPriceID: rp.RoomPriceID,
+			IsBreakfast: rp.IsBreakfast,
+			Name:        rp.Name,
+			StartDate:   rp.StartDate.Format(time.DateOnly),
+			EndDate:     rp.EndDate.Format(time.DateOnly),
+			DaysOfWeek:  daysOfWeek,
+			Prices:      rp.Prices,
+			Priority:    rp.Priority,
+			IsActive:    rp.IsActive,
+
+			CancellationPolicyID: rp.CancellationPolicyID,
+		})
+	}
+
+	return resp, nil
+}
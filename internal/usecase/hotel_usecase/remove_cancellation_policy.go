@@ -0,0 +1,16 @@
+package hotel_usecase
+
+import (
+	"context"
+	"wtm-backend/pkg/logger"
+)
+
+func (hu *HotelUsecase) RemoveCancellationPolicy(ctx context.Context, policyID uint) error {
+	return hu.dbTransaction.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := hu.hotelRepo.DeleteCancellationPolicy(txCtx, policyID); err != nil {
+			logger.Error(ctx, "Error deleting cancellation policy by Id", "cancellationPolicyID", policyID, "err", err.Error())
+			return err
+		}
+		return nil
+	})
+}
@@ -0,0 +1,15 @@
+package hotel_usecase
+
+import (
+	"context"
+	"wtm-backend/pkg/logger"
+)
+
+func (hu *HotelUsecase) RemoveRoomRatePlan(ctx context.Context, ratePlanID uint) error {
+	if err := hu.hotelRepo.DeleteRoomRatePlan(ctx, ratePlanID); err != nil {
+		logger.Error(ctx, "Error deleting room rate plan by Id", "ratePlanID", ratePlanID, "err", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -58,6 +58,7 @@ func (hu *HotelUsecase) UpdateHotel(ctx context.Context, req *hoteldto.UpdateHot
 		hotel.Description = req.Description
 		hotel.Rating = req.Rating
 		hotel.Email = req.Email
+		hotel.Language = req.Language
 
 		socialMediasMap := hotel.SocialMedia
 		if socialMediasMap == nil {
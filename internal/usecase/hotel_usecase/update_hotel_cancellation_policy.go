@@ -0,0 +1,24 @@
+package hotel_usecase
+
+import (
+	"context"
+	"fmt"
+	"wtm-backend/internal/dto/hoteldto"
+	"wtm-backend/pkg/logger"
+)
+
+func (hu *HotelUsecase) UpdateHotelCancellationPolicy(ctx context.Context, req *hoteldto.UpdateHotelCancellationPolicyRequest) error {
+	if req.CancellationPolicyID != nil {
+		if _, err := hu.hotelRepo.GetCancellationPolicyByID(ctx, *req.CancellationPolicyID); err != nil {
+			logger.Error(ctx, "Error getting cancellation policy by Id", "cancellationPolicyID", *req.CancellationPolicyID, err.Error())
+			return fmt.Errorf("cancellation policy not found: %s", err.Error())
+		}
+	}
+
+	if err := hu.hotelRepo.UpdateHotelCancellationPolicy(ctx, req.HotelID, req.CancellationPolicyID); err != nil {
+		logger.Error(ctx, "Error updating hotel cancellation policy", "hotelID", req.HotelID, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package hotel_usecase
+
+import (
+	"context"
+	"wtm-backend/internal/dto/hoteldto"
+	"wtm-backend/pkg/logger"
+)
+
+func (hu *HotelUsecase) UpdateHotelDepositRule(ctx context.Context, req *hoteldto.UpdateHotelDepositRuleRequest) error {
+	if err := hu.hotelRepo.UpdateHotelDepositRule(ctx, req.HotelID, req.DepositPercent, req.BalanceDueDays); err != nil {
+		logger.Error(ctx, "Error updating hotel deposit rule", "hotelID", req.HotelID, err.Error())
+		return err
+	}
+
+	return nil
+}
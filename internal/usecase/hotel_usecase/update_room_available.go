@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"time"
+	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/hoteldto"
 	"wtm-backend/pkg/logger"
 )
@@ -34,6 +35,16 @@ func (hu *HotelUsecase) UpdateRoomAvailable(ctx context.Context, req *hoteldto.U
 					return fmt.Errorf("room_type_id %d: insert error: %s", data.RoomTypeID, err.Error())
 				}
 			}
+
+			if err := hu.hotelRepo.DeleteRoomRestrictions(txCtx, data.RoomTypeID, monthTime); err != nil {
+				logger.Error(ctx, "Failed to delete room restrictions", "roomTypeID", data.RoomTypeID, err.Error())
+				return fmt.Errorf("room_type_id %d: delete restriction error: %s", data.RoomTypeID, err.Error())
+			}
+
+			if err := hu.hotelRepo.InsertRoomRestrictions(txCtx, buildRoomRestrictions(monthTime, data.RoomTypeID, data.RoomAvailable)); err != nil {
+				logger.Error(ctx, "Failed to insert room restrictions", "roomTypeID", data.RoomTypeID, err.Error())
+				return fmt.Errorf("room_type_id %d: insert restriction error: %s", data.RoomTypeID, err.Error())
+			}
 		}
 
 		return nil
@@ -50,3 +61,22 @@ func buildUnavailableDates(month time.Time, days []hoteldto.DataAvailable) ([]ti
 	}
 	return unavailable, nil
 }
+
+func buildRoomRestrictions(month time.Time, roomTypeID uint, days []hoteldto.DataAvailable) []entity.RoomRestriction {
+	var restrictions []entity.RoomRestriction
+	for _, day := range days {
+		restriction := entity.RoomRestriction{
+			RoomTypeID:        roomTypeID,
+			Date:              time.Date(month.Year(), month.Month(), day.Day, 0, 0, 0, 0, time.UTC),
+			MinStay:           day.MinStay,
+			MaxStay:           day.MaxStay,
+			ClosedToArrival:   day.ClosedToArrival,
+			ClosedToDeparture: day.ClosedToDeparture,
+			ReleaseDays:       day.ReleaseDays,
+		}
+		if !restriction.IsEmpty() {
+			restrictions = append(restrictions, restriction)
+		}
+	}
+	return restrictions
+}
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/hoteldto"
 	"wtm-backend/pkg/constant"
 	"wtm-backend/pkg/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation"
 )
 
 func (hu *HotelUsecase) UpdateRoomType(ctx context.Context, req *hoteldto.UpdateRoomTypeRequest) error {
@@ -44,6 +47,10 @@ func (hu *HotelUsecase) UpdateRoomType(ctx context.Context, req *hoteldto.Update
 		roomType.RoomSize = req.RoomSize
 		roomType.Description = req.Description
 		roomType.BookingLimitPerBooking = req.BookingLimitPerBooking
+		totalUnitChanged := req.TotalUnit > 0 && req.TotalUnit != roomType.TotalUnit
+		if totalUnitChanged {
+			roomType.TotalUnit = req.TotalUnit
+		}
 
 		var unchangedAdditions []entity.CustomRoomAdditionalWithID
 		for _, id := range req.UnchangedAdditionsIDs {
@@ -183,6 +190,19 @@ func (hu *HotelUsecase) UpdateRoomType(ctx context.Context, req *hoteldto.Update
 			return err
 		}
 
+		if totalUnitChanged {
+			overbooked, err := hu.hotelRepo.UpdateRoomInventoryTotalUnit(txCtx, roomType.ID, roomType.TotalUnit)
+			if err != nil {
+				logger.Error(txCtx, "Failed to update room inventory total unit", err.Error())
+				return err
+			}
+			if overbooked != nil {
+				return validation.Errors{
+					"total_unit": fmt.Errorf("%d units are already booked on %s", overbooked.BookedUnit, overbooked.Date.Format(time.DateOnly)),
+				}
+			}
+		}
+
 		if err := hu.hotelRepo.AttachBedTypesToRoomType(txCtx, roomType.ID, req.BedTypes); err != nil {
 			logger.Error(txCtx, "Failed to attach bed types", err.Error())
 			return err
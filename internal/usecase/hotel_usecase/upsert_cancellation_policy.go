@@ -0,0 +1,48 @@
+package hotel_usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/dto/hoteldto"
+	"wtm-backend/pkg/logger"
+)
+
+func (hu *HotelUsecase) UpsertCancellationPolicy(ctx context.Context, req *hoteldto.UpsertCancellationPolicyRequest, policyID uint) error {
+	policy := &entity.CancellationPolicy{
+		ID:          policyID,
+		Name:        req.Name,
+		Description: req.Description,
+	}
+	for _, tier := range req.Tiers {
+		policy.Tiers = append(policy.Tiers, entity.CancellationTier{
+			DaysBeforeCheckIn: tier.DaysBeforeCheckIn,
+			PenaltyPercent:    tier.PenaltyPercent,
+		})
+	}
+	// Earliest tier first, the order they apply in
+	sort.Slice(policy.Tiers, func(i, j int) bool {
+		return policy.Tiers[i].DaysBeforeCheckIn > policy.Tiers[j].DaysBeforeCheckIn
+	})
+
+	if policyID == 0 {
+		if err := hu.hotelRepo.CreateCancellationPolicy(ctx, policy); err != nil {
+			logger.Error(ctx, "Error creating cancellation policy", err.Error())
+			return err
+		}
+		return nil
+	}
+
+	if _, err := hu.hotelRepo.GetCancellationPolicyByID(ctx, policyID); err != nil {
+		logger.Error(ctx, "Error getting cancellation policy by Id", "cancellationPolicyID", policyID, err.Error())
+		return fmt.Errorf("cancellation policy not found: %s", err.Error())
+	}
+
+	if err := hu.hotelRepo.UpdateCancellationPolicy(ctx, policy); err != nil {
+		logger.Error(ctx, "Error updating cancellation policy", err.Error())
+		return err
+	}
+
+	return nil
+}
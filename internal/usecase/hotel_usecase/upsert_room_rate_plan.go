@@ -0,0 +1,89 @@
+package hotel_usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/dto/hoteldto"
+	"wtm-backend/pkg/currency"
+	"wtm-backend/pkg/logger"
+)
+
+func (hu *HotelUsecase) UpsertRoomRatePlan(ctx context.Context, req *hoteldto.UpsertRoomRatePlanRequest, ratePlanID uint) error {
+	startDate, err := time.Parse(time.DateOnly, req.StartDate)
+	if err != nil {
+		logger.Error(ctx, "Error parsing start date", err.Error())
+		return err
+	}
+
+	endDate, err := time.Parse(time.DateOnly, req.EndDate)
+	if err != nil {
+		logger.Error(ctx, "Error parsing end date", err.Error())
+		return err
+	}
+
+	prices := make(map[string]float64, len(req.Prices))
+	for code, price := range req.Prices {
+		prices[currency.NormalizeCurrencyCode(code)] = price
+	}
+	if err := currency.ValidatePrices(prices); err != nil {
+		logger.Error(ctx, "Error validating rate plan prices", err.Error())
+		return fmt.Errorf("invalid prices: %w", err)
+	}
+
+	roomPrice, err := hu.hotelRepo.GetRoomPriceByID(ctx, req.RoomPriceID)
+	if err != nil {
+		logger.Error(ctx, "Error getting room price by Id", "roomPriceID", req.RoomPriceID, err.Error())
+		return fmt.Errorf("room price not found: %s", err.Error())
+	}
+	if roomPrice == nil {
+		return fmt.Errorf("room price not found")
+	}
+
+	if req.CancellationPolicyID != nil {
+		if _, err := hu.hotelRepo.GetCancellationPolicyByID(ctx, *req.CancellationPolicyID); err != nil {
+			logger.Error(ctx, "Error getting cancellation policy by Id", "cancellationPolicyID", *req.CancellationPolicyID, err.Error())
+			return fmt.Errorf("cancellation policy not found: %s", err.Error())
+		}
+	}
+
+	daysOfWeek := slices.Clone(req.DaysOfWeek)
+	slices.Sort(daysOfWeek)
+	daysOfWeek = slices.Compact(daysOfWeek)
+
+	ratePlan := &entity.RoomRatePlan{
+		ID:          ratePlanID,
+		RoomPriceID: req.RoomPriceID,
+		Name:        req.Name,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		DaysOfWeek:  daysOfWeek,
+		Prices:      prices,
+		Priority:    req.Priority,
+		IsActive:    req.IsActive,
+
+		CancellationPolicyID: req.CancellationPolicyID,
+	}
+
+	if ratePlanID == 0 {
+		if err := hu.hotelRepo.CreateRoomRatePlan(ctx, ratePlan); err != nil {
+			logger.Error(ctx, "Error creating room rate plan", err.Error())
+			return err
+		}
+		return nil
+	}
+
+	if _, err := hu.hotelRepo.GetRoomRatePlanByID(ctx, ratePlanID); err != nil {
+		logger.Error(ctx, "Error getting room rate plan by Id", "ratePlanID", ratePlanID, err.Error())
+		return fmt.Errorf("rate plan not found: %s", err.Error())
+	}
+
+	if err := hu.hotelRepo.UpdateRoomRatePlan(ctx, ratePlan); err != nil {
+		logger.Error(ctx, "Error updating room rate plan", err.Error())
+		return err
+	}
+
+	return nil
+}
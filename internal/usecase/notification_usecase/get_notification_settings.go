@@ -0,0 +1,62 @@
+package notification_usecase
+
+import (
+	"context"
+	"fmt"
+	"wtm-backend/internal/dto/notifdto"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+)
+
+func (nu *NotificationUsecase) GetNotificationSettings(ctx context.Context) (*notifdto.NotificationSettingsResponse, error) {
+	userCtx, err := nu.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to get user from context", err.Error())
+		return nil, fmt.Errorf("failed to get user from context: %s", err.Error())
+	}
+
+	if userCtx == nil {
+		logger.Error(ctx, "user context is nil")
+		return nil, fmt.Errorf("user context is nil")
+	}
+
+	settings, err := nu.userRepo.GetNotificationSettings(ctx, userCtx.ID)
+	if err != nil {
+		logger.Error(ctx, "failed to get notification settings", err.Error())
+		return nil, err
+	}
+
+	preference, err := nu.notifRepo.GetNotificationPreference(ctx, userCtx.ID)
+	if err != nil {
+		logger.Error(ctx, "failed to get notification preference", err.Error())
+		return nil, err
+	}
+	preference = preferenceOrDefault(preference, userCtx.ID)
+
+	channels := []string{constant.ConstWeb, constant.ConstEmail}
+	types := constant.NotificationTypesOf(userCtx.RoleID)
+
+	// Every channel and type of the role, a missing row is disabled
+	resp := &notifdto.NotificationSettingsResponse{
+		Channels:   channels,
+		Types:      types,
+		DigestMode: preference.DigestMode,
+		DigestHour: preference.DigestHour,
+		QuietHours: notifdto.QuietHours{
+			Enabled: preference.QuietHoursEnabled,
+			Start:   preference.QuietHoursStart,
+			End:     preference.QuietHoursEnd,
+		},
+		Timezone: preference.Timezone,
+	}
+	for _, typeNotif := range types {
+		for _, channel := range channels {
+			setting := notifdto.NotificationSetting{Channel: channel, Type: typeNotif}
+			webNotif, emailNotif := notificationChannels(settings, typeNotif)
+			setting.IsEnabled = (channel == constant.ConstWeb && webNotif) || (channel == constant.ConstEmail && emailNotif)
+			resp.Settings = append(resp.Settings, setting)
+		}
+	}
+
+	return resp, nil
+}
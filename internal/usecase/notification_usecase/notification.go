@@ -1,17 +1,197 @@
 package notification_usecase
 
-import "wtm-backend/internal/domain"
+import (
+	"context"
+	"time"
+	"wtm-backend/config"
+	"wtm-backend/internal/domain"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/notifpref"
+	"wtm-backend/pkg/utils"
+)
 
 type NotificationUsecase struct {
-	notifRepo  domain.NotificationRepository
-	middleware domain.Middleware
-	dbTrx      domain.DatabaseTransaction
+	notifRepo   domain.NotificationRepository
+	userRepo    domain.UserRepository
+	authRepo    domain.AuthRepository
+	emailRepo   domain.EmailRepository
+	middleware  domain.Middleware
+	dbTrx       domain.DatabaseTransaction
+	notifStream domain.NotificationStream
+	config      *config.Config
 }
 
-func NewNotificationUsecase(notifRepo domain.NotificationRepository, middleware domain.Middleware, dbTrx domain.DatabaseTransaction) *NotificationUsecase {
+func NewNotificationUsecase(notifRepo domain.NotificationRepository, userRepo domain.UserRepository, authRepo domain.AuthRepository, emailRepo domain.EmailRepository, middleware domain.Middleware, dbTrx domain.DatabaseTransaction, notifStream domain.NotificationStream, config *config.Config) *NotificationUsecase {
 	return &NotificationUsecase{
-		notifRepo:  notifRepo,
-		middleware: middleware,
-		dbTrx:      dbTrx,
+		notifRepo:   notifRepo,
+		userRepo:    userRepo,
+		authRepo:    authRepo,
+		emailRepo:   emailRepo,
+		middleware:  middleware,
+		dbTrx:       dbTrx,
+		notifStream: notifStream,
+		config:      config,
 	}
 }
+
+// deliver sends the notification to the user on the channels enabled for its type: into the pending items of the digest
+// in daily digest mode, else as a web notification and an email held until the end of the quiet hours
+func (nu *NotificationUsecase) deliver(ctx context.Context, user entity.User, notification entity.Notification, email *entity.EmailLog) error {
+	webNotif, emailNotif := notificationChannels(user.UserNotificationSettings, notification.Type)
+	emailNotif = emailNotif && user.Email != ""
+	if !webNotif && !emailNotif {
+		return nil
+	}
+
+	preference, err := nu.notifRepo.GetNotificationPreference(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	if preference != nil && preference.DigestMode == constant.DigestModeDaily {
+		return nu.notifRepo.CreateDigestItem(ctx, &entity.NotificationDigestItem{
+			UserID:      user.ID,
+			Title:       notification.Title,
+			Message:     notification.Message,
+			RedirectURL: notification.RedirectURL,
+			Type:        notification.Type,
+			Web:         webNotif,
+			Email:       emailNotif,
+		})
+	}
+
+	if webNotif {
+		notification.UserID = user.ID
+		if err := nu.createNotification(ctx, &notification); err != nil {
+			return err
+		}
+	}
+
+	if !emailNotif {
+		return nil
+	}
+	if email == nil {
+		if email, err = nu.notificationEmail(ctx, user, notification); err != nil || email == nil {
+			return err
+		}
+	}
+	return nu.queueEmail(ctx, email, quietHoursUntil(preference, time.Now()))
+}
+
+// createNotification stores the notification and pushes it to the connected clients of the user once the transaction of
+// ctx is committed, a client that misses the push still gets it from the list
+func (nu *NotificationUsecase) createNotification(ctx context.Context, notification *entity.Notification) error {
+	if err := nu.notifRepo.CreateNotification(ctx, notification); err != nil {
+		return err
+	}
+
+	created := *notification
+	nu.dbTrx.AfterCommit(ctx, func(ctx context.Context) {
+		if err := nu.notifRepo.PublishNotificationEvent(ctx, created.UserID, &created); err != nil {
+			logger.Warn(ctx, "Failed to publish notification", err.Error())
+		}
+	})
+
+	return nil
+}
+
+// preferenceOrDefault returns the preference of the user, or the defaults when it never saved one
+func preferenceOrDefault(preference *entity.UserNotificationPreference, userID uint) *entity.UserNotificationPreference {
+	if preference != nil {
+		return preference
+	}
+	return &entity.UserNotificationPreference{
+		UserID:          userID,
+		DigestMode:      constant.DigestModeOff,
+		DigestHour:      constant.DefaultDigestHour,
+		QuietHoursStart: constant.DefaultQuietHoursStart,
+		QuietHoursEnd:   constant.DefaultQuietHoursEnd,
+		Timezone:        constant.DefaultTimezone,
+	}
+}
+
+// notificationChannels returns the channels the user enabled for the type, a channel without a setting row is disabled
+func notificationChannels(settings []entity.UserNotificationSetting, typeNotif string) (webNotif, emailNotif bool) {
+	for _, setting := range settings {
+		if !setting.IsEnabled || setting.Type != typeNotif {
+			continue
+		}
+		switch setting.Channel {
+		case constant.ConstWeb:
+			webNotif = true
+		case constant.ConstEmail:
+			emailNotif = true
+		}
+	}
+	return webNotif, emailNotif
+}
+
+// quietHoursUntil returns the end of the quiet hours of the preference when now is within them, else the zero time
+func quietHoursUntil(preference *entity.UserNotificationPreference, now time.Time) time.Time {
+	if preference == nil || !preference.QuietHoursEnabled {
+		return time.Time{}
+	}
+	quietHours := notifpref.QuietHours{
+		Start:    preference.QuietHoursStart,
+		End:      preference.QuietHoursEnd,
+		Location: constant.LoadTimezone(preference.Timezone),
+	}
+	return quietHours.Until(now)
+}
+
+// notificationEmail renders the generic notification email of the user, nil when the template is missing
+func (nu *NotificationUsecase) notificationEmail(ctx context.Context, user entity.User, notification entity.Notification) (*entity.EmailLog, error) {
+	emailTemplate, err := nu.emailRepo.GetEmailTemplateByName(ctx, constant.EmailNotification, user.Language)
+	if err != nil || emailTemplate == nil {
+		logger.Error(ctx, "Failed to get email template:", err)
+		return nil, nil
+	}
+
+	data := NotificationEmailData{
+		FullName: user.FullName,
+		Title:    notification.Title,
+		Message:  notification.Message,
+		Link:     notification.RedirectURL,
+	}
+
+	subjectParsed, err := utils.ParseTemplate(emailTemplate.Subject, data)
+	if err != nil {
+		logger.Error(ctx, "Failed to parse email subject:", err.Error())
+		return nil, nil
+	}
+	bodyHTML, err := utils.ParseTemplate(emailTemplate.Body, data)
+	if err != nil {
+		logger.Error(ctx, "Failed to parse email body:", err.Error())
+		return nil, nil
+	}
+
+	return &entity.EmailLog{
+		To:              user.Email,
+		Subject:         subjectParsed,
+		Body:            bodyHTML,
+		EmailTemplateID: uint(emailTemplate.ID),
+		TemplateName:    emailTemplate.Name,
+		Scope:           string(constant.ScopeAgent),
+	}, nil
+}
+
+// queueEmail logs the email as pending and writes it to the outbox, to be sent at sendAt or now when zero
+func (nu *NotificationUsecase) queueEmail(ctx context.Context, emailLog *entity.EmailLog, sendAt time.Time) error {
+	if emailLog.Scope == "" {
+		emailLog.Scope = string(constant.ScopeAgent)
+	}
+	if err := nu.emailRepo.QueueEmail(ctx, emailLog, sendAt); err != nil {
+		logger.Error(ctx, "Failed to queue email:", err.Error())
+		return err
+	}
+	return nil
+}
+
+type NotificationEmailData struct {
+	FullName string
+	Title    string
+	Message  string
+	Link     string
+}
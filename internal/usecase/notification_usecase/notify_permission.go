@@ -0,0 +1,24 @@
+package notification_usecase
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/logger"
+)
+
+func (nu *NotificationUsecase) NotifyPermission(ctx context.Context, permission string, notification entity.Notification) error {
+	users, err := nu.userRepo.GetUsersByPermission(ctx, permission)
+	if err != nil {
+		logger.Error(ctx, "Failed to get users by permission:", err.Error())
+		return err
+	}
+
+	for _, user := range users {
+		if err := nu.deliver(ctx, user, notification, nil); err != nil {
+			logger.Error(ctx, "Failed to deliver notification:", user.ID, err.Error())
+			return err
+		}
+	}
+	logger.Info(ctx, "Notified users by permission:", permission, notification.Type, len(users))
+	return nil
+}
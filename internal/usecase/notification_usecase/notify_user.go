@@ -0,0 +1,25 @@
+package notification_usecase
+
+import (
+	"context"
+	"fmt"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/logger"
+)
+
+func (nu *NotificationUsecase) NotifyUser(ctx context.Context, notification entity.Notification, email *entity.EmailLog) error {
+	user, err := nu.userRepo.GetUserByID(ctx, notification.UserID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get user:", err.Error())
+		return err
+	}
+	if user == nil {
+		return fmt.Errorf("user %d not found", notification.UserID)
+	}
+
+	if err := nu.deliver(ctx, *user, notification, email); err != nil {
+		logger.Error(ctx, "Failed to deliver notification:", user.ID, err.Error())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package notification_usecase
+
+import (
+	"context"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/logger"
+)
+
+func (nu *NotificationUsecase) NotifyUsers(ctx context.Context, userIDs []uint, notification entity.Notification) error {
+	for _, userID := range userIDs {
+		userNotification := notification
+		userNotification.UserID = userID
+		if err := nu.NotifyUser(ctx, userNotification, nil); err != nil {
+			return err
+		}
+	}
+	if len(userIDs) > 0 {
+		logger.Info(ctx, "Notified users:", notification.Type, len(userIDs))
+	}
+	return nil
+}
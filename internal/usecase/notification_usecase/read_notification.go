@@ -56,5 +56,10 @@ func (nu *NotificationUsecase) ReadNotification(ctx context.Context, req *notifd
 		return err
 	}
 
+	// Other tabs and devices of the user update their unread count
+	if err := nu.notifRepo.PublishNotificationEvent(ctx, agentID, nil); err != nil {
+		logger.Warn(ctx, "publish unread count fail", err.Error())
+	}
+
 	return nil
 }
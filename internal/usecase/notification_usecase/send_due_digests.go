@@ -0,0 +1,170 @@
+package notification_usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/logger"
+	"wtm-backend/pkg/notifpref"
+	"wtm-backend/pkg/utils"
+)
+
+// digestTitlesShown is how many titles the digest web notification lists before "and N more"
+const digestTitlesShown = 3
+
+func (nu *NotificationUsecase) SendDueDigests(ctx context.Context) (int, error) {
+	preferences, err := nu.notifRepo.GetDigestPreferences(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to get digest preferences", err.Error())
+		return 0, err
+	}
+
+	now := time.Now()
+	sent := 0
+	for _, preference := range preferences {
+		loc := constant.LoadTimezone(preference.Timezone)
+		var lastDigestAt time.Time
+		if preference.LastDigestAt != nil {
+			lastDigestAt = *preference.LastDigestAt
+		}
+		if !notifpref.DigestDue(now, loc, preference.DigestHour, lastDigestAt) {
+			continue
+		}
+
+		claimed := false
+		err := nu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+			// Another replica may have sent this digest since the preferences were read
+			ok, err := nu.notifRepo.ClaimDigest(txCtx, preference.UserID, notifpref.LastDigest(now, loc, preference.DigestHour), now)
+			if err != nil || !ok {
+				return err
+			}
+			claimed = true
+			return nu.sendDigest(txCtx, preference.UserID, &preference, now)
+		})
+		if err != nil {
+			logger.Error(ctx, "failed to send notification digest", preference.UserID, err.Error())
+			continue
+		}
+		if claimed {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+// sendDigest summarizes the pending digest items of the user in one web notification and one email,
+// for the items that were enabled on each channel
+func (nu *NotificationUsecase) sendDigest(ctx context.Context, userID uint, preference *entity.UserNotificationPreference, now time.Time) error {
+	items, err := nu.notifRepo.GetPendingDigestItems(ctx, userID)
+	if err != nil || len(items) == 0 {
+		return err
+	}
+
+	user, err := nu.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return fmt.Errorf("user %d not found", userID)
+	}
+
+	var webItems, emailItems []entity.NotificationDigestItem
+	ids := make([]uint, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+		if item.Web {
+			webItems = append(webItems, item)
+		}
+		if item.Email {
+			emailItems = append(emailItems, item)
+		}
+	}
+
+	if len(webItems) > 0 {
+		var titles []string
+		for i, item := range webItems {
+			if i == digestTitlesShown {
+				titles = append(titles, fmt.Sprintf("and %d more", len(webItems)-digestTitlesShown))
+				break
+			}
+			titles = append(titles, item.Title)
+		}
+		notification := entity.Notification{
+			UserID:  userID,
+			Title:   "Daily Summary",
+			Message: fmt.Sprintf("You have %d new notification(s): %s", len(webItems), strings.Join(titles, ", ")),
+			Type:    constant.ConstDigest,
+		}
+		if err := nu.createNotification(ctx, &notification); err != nil {
+			return err
+		}
+	}
+
+	if len(emailItems) > 0 && user.Email != "" {
+		if err := nu.queueDigestEmail(ctx, *user, emailItems, constant.LoadTimezone(preference.Timezone), now); err != nil {
+			return err
+		}
+	}
+
+	return nu.notifRepo.MarkDigestItemsDigested(ctx, ids, now)
+}
+
+func (nu *NotificationUsecase) queueDigestEmail(ctx context.Context, user entity.User, items []entity.NotificationDigestItem, loc *time.Location, now time.Time) error {
+	emailTemplate, err := nu.emailRepo.GetEmailTemplateByName(ctx, constant.EmailNotificationDigest, user.Language)
+	if err != nil || emailTemplate == nil {
+		logger.Error(ctx, "Failed to get email template:", err)
+		return nil
+	}
+
+	data := DigestEmailData{
+		FullName: user.FullName,
+		Date:     now.In(loc).Format("02 Jan 2006"),
+		Count:    len(items),
+	}
+	for _, item := range items {
+		data.Items = append(data.Items, DigestEmailItem{
+			Title:   item.Title,
+			Message: item.Message,
+			Link:    item.RedirectURL,
+			Time:    item.CreatedAt.In(loc).Format("02 Jan 15:04"),
+		})
+	}
+
+	subjectParsed, err := utils.ParseTemplate(emailTemplate.Subject, data)
+	if err != nil {
+		logger.Error(ctx, "Failed to parse email subject:", err.Error())
+		return nil
+	}
+	bodyHTML, err := utils.ParseTemplate(emailTemplate.Body, data)
+	if err != nil {
+		logger.Error(ctx, "Failed to parse email body:", err.Error())
+		return nil
+	}
+
+	return nu.queueEmail(ctx, &entity.EmailLog{
+		To:              user.Email,
+		Subject:         subjectParsed,
+		Body:            bodyHTML,
+		EmailTemplateID: uint(emailTemplate.ID),
+		TemplateName:    emailTemplate.Name,
+		Scope:           string(constant.ScopeAgent),
+	}, time.Time{})
+}
+
+type DigestEmailData struct {
+	FullName string
+	Date     string
+	Count    int
+	Items    []DigestEmailItem
+}
+
+type DigestEmailItem struct {
+	Title   string
+	Message string
+	Link    string
+	Time    string
+}
@@ -0,0 +1,54 @@
+package notification_usecase
+
+import (
+	"context"
+	"fmt"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/internal/dto/notifdto"
+	"wtm-backend/pkg/logger"
+)
+
+func (nu *NotificationUsecase) StreamNotifications(ctx context.Context) (*notifdto.NotificationStream, error) {
+	userCtx, err := nu.middleware.GenerateUserFromContext(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to get user from context", err.Error())
+		return nil, fmt.Errorf("failed to get user from context: %s", err.Error())
+	}
+
+	if userCtx == nil {
+		logger.Error(ctx, "user context is nil")
+		return nil, fmt.Errorf("user context is nil")
+	}
+
+	// Subscribe before counting, so a notification created in between is not missed
+	events, unsubscribe := nu.notifStream.Subscribe(userCtx.ID)
+
+	unreadCount, err := nu.notifRepo.CountUnreadNotifications(ctx, userCtx.ID)
+	if err != nil {
+		unsubscribe()
+		logger.Error(ctx, "failed to count unread notifications", err.Error())
+		return nil, err
+	}
+
+	return &notifdto.NotificationStream{
+		UnreadCount: unreadCount,
+		Events:      events,
+		Close:       unsubscribe,
+		SessionActive: func(ctx context.Context) bool {
+			return nu.sessionActive(ctx, userCtx)
+		},
+	}, nil
+}
+
+// sessionActive reports whether the session of the user is neither revoked nor expired, a stream stays open past the
+// auth middleware so a logout or a forced logout is only seen here
+func (nu *NotificationUsecase) sessionActive(ctx context.Context, user *entity.User) bool {
+	session, err := nu.authRepo.GetActiveSession(ctx, user.SessionID)
+	if err != nil {
+		// Gangguan Redis/DB sesaat tidak memutus stream, heartbeat berikutnya memeriksa lagi
+		logger.Warn(ctx, "Failed to check session of notification stream", err.Error())
+		return true
+	}
+
+	return session != nil && session.UserID == user.ID
+}
@@ -3,9 +3,14 @@ package notification_usecase
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
+	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/notifdto"
 	"wtm-backend/pkg/constant"
 	"wtm-backend/pkg/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation"
 )
 
 func (nu *NotificationUsecase) UpdateNotificationSetting(ctx context.Context, req *notifdto.UpdateNotificationSettingRequest) error {
@@ -17,35 +22,113 @@ func (nu *NotificationUsecase) UpdateNotificationSetting(ctx context.Context, re
 
 	if user == nil {
 		logger.Error(ctx, "User not found in context")
-		return err
+		return fmt.Errorf("user not found in context")
+	}
+
+	notifTypes := constant.NotificationTypesOf(user.RoleID)
+
+	var settings []entity.UserNotificationSetting
+	if req.Channel != "" {
+		// The former update replaces the enabled types of one channel
+		var enabledTypes []string
+		if req.IsEnable {
+			switch req.Type {
+			case constant.ConstAll:
+				enabledTypes = notifTypes
+			case constant.ConstBooking:
+				enabledTypes = []string{constant.ConstBookingConfirmed}
+			case constant.ConstReject:
+				enabledTypes = []string{constant.ConstBookingRejected}
+			default:
+				enabledTypes = []string{req.Type}
+			}
+		}
+		for _, typeNotif := range enabledTypes {
+			if !slices.Contains(notifTypes, typeNotif) {
+				return validation.Errors{"type": validation.NewInternalError(fmt.Errorf("notification type %s is not available for your role", typeNotif))}
+			}
+		}
+		for _, typeNotif := range notifTypes {
+			settings = append(settings, entity.UserNotificationSetting{
+				UserID:    user.ID,
+				Channel:   req.Channel,
+				Type:      typeNotif,
+				IsEnabled: slices.Contains(enabledTypes, typeNotif),
+			})
+		}
+	}
+
+	for _, setting := range req.Settings {
+		if !slices.Contains(notifTypes, setting.Type) {
+			return validation.Errors{"settings": validation.NewInternalError(fmt.Errorf("notification type %s is not available for your role", setting.Type))}
+		}
+		settings = append(settings, entity.UserNotificationSetting{
+			UserID:    user.ID,
+			Channel:   setting.Channel,
+			Type:      setting.Type,
+			IsEnabled: setting.IsEnabled,
+		})
 	}
 
 	return nu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
-		if err := nu.notifRepo.DisableNotificationSettingsByChannel(txCtx, user.ID, req.Channel); err != nil {
-			logger.Error(ctx, "Error disabling notification settings by channel", err.Error())
-			return err
+		if len(settings) > 0 {
+			if err := nu.notifRepo.UpsertNotificationSettings(txCtx, user.ID, settings); err != nil {
+				logger.Error(ctx, "Error updating notification settings", err.Error())
+				return err
+			}
 		}
 
-		if !req.IsEnable {
-			logger.Info(ctx, "Disabled notification settings by channel")
+		if req.DigestMode == nil && req.DigestHour == nil && req.QuietHours == nil && req.Timezone == nil {
 			return nil
 		}
 
-		var typesToEnable []string
-		switch req.Type {
-		case constant.ConstBooking, constant.ConstReject:
-			typesToEnable = []string{req.Type}
-		case constant.ConstAll:
-			typesToEnable = []string{constant.ConstBooking, constant.ConstReject}
-		default:
-			return fmt.Errorf("invalid type: %s", req.Type)
+		current, err := nu.notifRepo.GetNotificationPreference(txCtx, user.ID)
+		if err != nil {
+			logger.Error(ctx, "Error getting notification preference", err.Error())
+			return err
 		}
+		preference := *preferenceOrDefault(current, user.ID)
+		wasDigest := preference.DigestMode == constant.DigestModeDaily
 
-		if err := nu.notifRepo.EnableNotificationSettings(txCtx, user.ID, req.Channel, typesToEnable); err != nil {
-			logger.Error(ctx, "Error enabling notification settings", err.Error())
+		if req.DigestMode != nil {
+			preference.DigestMode = *req.DigestMode
+		}
+		if req.DigestHour != nil {
+			preference.DigestHour = *req.DigestHour
+		}
+		if req.QuietHours != nil {
+			preference.QuietHoursEnabled = req.QuietHours.Enabled
+			if req.QuietHours.Start != "" {
+				preference.QuietHoursStart = req.QuietHours.Start
+			}
+			if req.QuietHours.End != "" {
+				preference.QuietHoursEnd = req.QuietHours.End
+			}
+		}
+		if req.Timezone != nil {
+			preference.Timezone = *req.Timezone
+		}
+
+		isDigest := preference.DigestMode == constant.DigestModeDaily
+		now := time.Now()
+		if isDigest && !wasDigest {
+			// The first digest is the next one at the digest hour, not the one of today already past
+			preference.LastDigestAt = &now
+		}
+
+		if err := nu.notifRepo.UpsertNotificationPreference(txCtx, &preference); err != nil {
+			logger.Error(ctx, "Error updating notification preference", err.Error())
 			return err
 		}
 
+		// Leaving digest mode sends the notifications still held right away
+		if wasDigest && !isDigest {
+			if err := nu.sendDigest(txCtx, user.ID, &preference, now); err != nil {
+				logger.Error(ctx, "Error sending pending digest", err.Error())
+				return err
+			}
+		}
+
 		return nil
 	})
 }
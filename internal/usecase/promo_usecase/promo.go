@@ -1,6 +1,7 @@
 package promo_usecase
 
 import (
+	"wtm-backend/config"
 	"wtm-backend/internal/domain"
 )
 
@@ -8,12 +9,16 @@ type PromoUsecase struct {
 	promoRepo  domain.PromoRepository
 	dbTrx      domain.DatabaseTransaction
 	middleware domain.Middleware
+	notifier   domain.Notifier
+	config     *config.Config
 }
 
-func NewPromoUsecase(promoRepo domain.PromoRepository, dbTrx domain.DatabaseTransaction, middleware domain.Middleware) *PromoUsecase {
+func NewPromoUsecase(promoRepo domain.PromoRepository, dbTrx domain.DatabaseTransaction, middleware domain.Middleware, notifier domain.Notifier, config *config.Config) *PromoUsecase {
 	return &PromoUsecase{
 		promoRepo:  promoRepo,
 		dbTrx:      dbTrx,
 		middleware: middleware,
+		notifier:   notifier,
+		config:     config,
 	}
 }
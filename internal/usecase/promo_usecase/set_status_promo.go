@@ -3,7 +3,9 @@ package promo_usecase
 import (
 	"context"
 	"fmt"
+	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/promodto"
+	"wtm-backend/pkg/constant"
 	"wtm-backend/pkg/logger"
 )
 
@@ -33,11 +35,31 @@ func (pu *PromoUsecase) SetStatusPromo(ctx context.Context, req *promodto.SetSta
 		return nil
 	}
 
-	if err := pu.promoRepo.UpdatePromoStatus(ctx, promo.ID, req.IsActive); err != nil {
-		logger.Error(ctx, "Error updating promo status", "error", err, "promoID", req.PromoID, "isActive", req.IsActive)
+	return pu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := pu.promoRepo.UpdatePromoStatus(txCtx, promo.ID, req.IsActive); err != nil {
+			logger.Error(ctx, "Error updating promo status", "error", err, "promoID", req.PromoID, "isActive", req.IsActive)
+			return err
+		}
+
+		if !req.IsActive {
+			return nil
+		}
+		return pu.notifyPromoPublished(txCtx, promoEntity)
+	})
+}
+
+// notifyPromoPublished notifies the agents in the promo groups of the promo
+func (pu *PromoUsecase) notifyPromoPublished(ctx context.Context, promo *entity.Promo) error {
+	agentIDs, err := pu.promoRepo.GetPromoAgentIDs(ctx, promo.ID)
+	if err != nil {
+		logger.Error(ctx, "Error getting agents of promo", "error", err, "promoID", promo.ID)
 		return err
 	}
 
-	return nil
-
+	return pu.notifier.NotifyUsers(ctx, agentIDs, entity.Notification{
+		Title:       "New Promo Available",
+		Message:     fmt.Sprintf("Promo %s is now available for your bookings", promo.Name),
+		RedirectURL: fmt.Sprintf("%s/promo", pu.config.URLFEAgent),
+		Type:        constant.ConstPromoPublished,
+	})
 }
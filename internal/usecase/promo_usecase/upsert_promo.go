@@ -60,16 +60,16 @@ func (pu *PromoUsecase) UpsertPromo(ctx context.Context, req *promodto.UpsertPro
 				// Only save Prices, do not set FixedPrice
 				// Agents will use the currency-specific price from Prices map
 				detail = entity.PromoDetail{
-					Prices: req.Prices,
+					Prices: currency.RoundPrices(req.Prices),
 				}
 			} else if req.Detail != "" {
 				// Backward compatibility: parse from Detail string
-				fixedPrice, err := strconv.ParseFloat(req.Detail, 64)
+				fixedPriceIDR, err := currency.ParseMoney(req.Detail, "IDR")
 				if err != nil {
 					logger.Error(ctx, "Error parsing fixed price", err.Error())
 					return fmt.Errorf("invalid fixed price: %w", err)
 				}
-				if fixedPrice > 0 {
+				if fixedPrice := fixedPriceIDR.Float64(); fixedPrice > 0 {
 					// Convert single price to Prices map with IDR
 					detail = entity.PromoDetail{
 						FixedPrice: fixedPrice,
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/userdto"
 	"wtm-backend/pkg/constant"
@@ -33,6 +34,7 @@ func (uu *UserUsecase) CreateUserByAdmin(ctx context.Context, userReq *userdto.C
 			StatusID:    constant.StatusUserActiveID,
 			RoleID:      getRoleID(userReq.Role),
 			KakaoTalkID: userReq.KakaoTalkID,
+			Language:    userReq.Language,
 		}
 
 		if newUser.RoleID == constant.RoleAgentID {
@@ -100,28 +102,22 @@ func (uu *UserUsecase) CreateUserByAdmin(ctx context.Context, userReq *userdto.C
 			return err
 		}
 
-		go func() {
-			newCtx, cancel := context.WithTimeout(context.Background(), uu.config.DurationCtxTOSlow)
-			defer cancel()
-			uu.sendEmail(newCtx, userDB.FullName, userDB.Email, randomString, userDB.RoleID)
-		}()
-
-		return nil
+		return uu.queueEmail(txCtx, userDB.FullName, userDB.Email, userDB.Language, randomString, userDB.RoleID)
 	})
 }
 
-func (uu *UserUsecase) sendEmail(ctx context.Context, name, email, tempPassword string, roleID uint) {
+func (uu *UserUsecase) queueEmail(ctx context.Context, name, email, locale, tempPassword string, roleID uint) error {
 	var statusEmail = constant.EmailAccountActivated
 
-	emailTemplate, err := uu.emailRepo.GetEmailTemplateByName(ctx, statusEmail)
+	emailTemplate, err := uu.emailRepo.GetEmailTemplateByName(ctx, statusEmail, locale)
 	if err != nil {
 		logger.Error(ctx, "Error getting email template by name:", err.Error())
-		return
+		return nil
 	}
 
 	if emailTemplate == nil {
 		logger.Error(ctx, "Email template not found for status:", statusEmail)
-		return
+		return nil
 	}
 
 	var baseURL string
@@ -143,11 +139,9 @@ func (uu *UserUsecase) sendEmail(ctx context.Context, name, email, tempPassword
 	bodyHTML, err := utils.ParseTemplate(emailTemplate.Body, data)
 	if err != nil {
 		logger.Error(ctx, "Error parsing body HTML:", err.Error())
-		return
+		return nil
 	}
 
-	bodyText := "Please view this email in HTML format." // Optional fallback ini doang kan?
-
 	subjectParsed := emailTemplate.Subject
 
 	emailTo := email
@@ -157,34 +151,17 @@ func (uu *UserUsecase) sendEmail(ctx context.Context, name, email, tempPassword
 		Subject:         subjectParsed,
 		Body:            bodyHTML,
 		EmailTemplateID: uint(emailTemplate.ID),
+		TemplateName:    emailTemplate.Name,
+		Scope:           string(constant.ScopeAgent),
 	}
 	metadataLog := entity.MetadataEmailLog{AgentName: name}
 	emailLog.Meta = &metadataLog
 
-	var dataEmail bool
-	statusEmailID := constant.StatusEmailSuccessID
-	if err = uu.emailRepo.CreateEmailLog(ctx, &emailLog); err != nil {
-		logger.Error(ctx, "Failed to create email log:", err)
-		dataEmail = false
-	} else {
-		dataEmail = true
-	}
-
-	err = uu.emailSender.Send(ctx, constant.ScopeAgent, emailTo, subjectParsed, bodyHTML, bodyText)
-	if err != nil {
-		logger.Error(ctx, "Failed to send email:", err.Error())
-		statusEmailID = constant.StatusEmailFailedID
-		metadataLog.Notes = fmt.Sprintf("Failed to send email: %s", err.Error())
-		emailLog.Meta = &metadataLog
+	if err = uu.emailRepo.QueueEmail(ctx, &emailLog, time.Time{}); err != nil {
+		logger.Error(ctx, "Failed to queue email:", err.Error())
+		return err
 	}
-
-	if dataEmail {
-		emailLog.StatusID = uint(statusEmailID)
-		if err := uu.emailRepo.UpdateStatusEmailLog(ctx, &emailLog); err != nil {
-			logger.Error(ctx, "Failed to update email log:", err.Error())
-		}
-	}
-
+	return nil
 }
 
 type AccountActivatedEmailData struct {
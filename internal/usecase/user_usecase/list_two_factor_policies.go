@@ -0,0 +1,25 @@
+package user_usecase
+
+import (
+	"context"
+	"strings"
+	"wtm-backend/internal/dto/userdto"
+	"wtm-backend/pkg/constant"
+)
+
+func (uu *UserUsecase) ListTwoFactorPolicies(ctx context.Context) ([]userdto.TwoFactorPolicyResponse, error) {
+	roles, err := uu.userRepo.GetRolesByIDs(ctx, constant.TwoFactorPolicyRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]userdto.TwoFactorPolicyResponse, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, userdto.TwoFactorPolicyResponse{
+			Role:     strings.ReplaceAll(strings.ToLower(strings.TrimSpace(role.Role)), " ", "_"),
+			Required: role.TwoFactorRequired,
+		})
+	}
+
+	return result, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 	"wtm-backend/internal/dto/userdto"
 	"wtm-backend/internal/repository/filter"
 	"wtm-backend/pkg/constant"
@@ -99,10 +100,14 @@ func (uu *UserUsecase) ListUsers(ctx context.Context, req *userdto.ListUsersRequ
 			NameCard:         nameCardURL,
 			IdCard:           idCardURL,
 			Currency:         currencyValue,
+			Language:         u.Language,
 		}
 		if u.PromoGroupID != nil {
 			data.PromoGroupID = u.PromoGroupID
 		}
+		if u.LockedUntil != nil && u.LockedUntil.After(time.Now()) {
+			data.LockedUntil = u.LockedUntil.Format(time.RFC3339)
+		}
 		resp.Users = append(resp.Users, data)
 	}
 
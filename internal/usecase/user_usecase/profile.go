@@ -80,14 +80,16 @@ func (uu *UserUsecase) Profile(ctx context.Context) (*dtouser.ProfileResponse, e
 		Status:              user.StatusName,
 		AgentCompany:        user.AgentCompanyName,
 		Currency:            user.Currency,
-		NotificationSetting: summarizeNotificationSettings(user.UserNotificationSettings),
+		Language:            user.Language,
+		NotificationSetting: summarizeNotificationSettings(user.UserNotificationSettings, user.RoleID),
 	}
 
 	return profileResponse, nil
 }
 
-func summarizeNotificationSettings(settings []entity.UserNotificationSetting) []dtouser.NotificationSetting {
-	allTypes := []string{constant.ConstBooking, constant.ConstReject}
+func summarizeNotificationSettings(settings []entity.UserNotificationSetting, roleID uint) []dtouser.NotificationSetting {
+	allTypes := append([]string(nil), constant.NotificationTypesOf(roleID)...)
+	sort.Strings(allTypes)
 	grouped := map[string][]string{}
 	channelSet := map[string]bool{}
 
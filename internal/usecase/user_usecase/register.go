@@ -3,6 +3,8 @@ package user_usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/url"
 	"strings"
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/userdto"
@@ -29,7 +31,7 @@ func (uu *UserUsecase) Register(ctx context.Context, userReq *userdto.RegisterRe
 		KakaoTalkID: userReq.KakaoTalkID,
 	}
 
-	return uu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+	err = uu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
 		if strings.TrimSpace(userReq.AgentCompany) != "" {
 
 			agentCompany, err := uu.userRepo.CreateAgentCompany(txCtx, userReq.AgentCompany)
@@ -75,6 +77,20 @@ func (uu *UserUsecase) Register(ctx context.Context, userReq *userdto.RegisterRe
 			return err
 		}
 
-		return nil
+		message := fmt.Sprintf("%s registered as an agent and is waiting for approval", user.FullName)
+		if strings.TrimSpace(userReq.AgentCompany) != "" {
+			message = fmt.Sprintf("%s of %s registered as an agent and is waiting for approval", user.FullName, userReq.AgentCompany)
+		}
+		return uu.notifier.NotifyPermission(txCtx, constant.PermissionAccountEdit, entity.Notification{
+			Title:       "New Agent Registration",
+			Message:     message,
+			RedirectURL: fmt.Sprintf("%s/account?search=%s", uu.config.URLFEAdmin, url.QueryEscape(user.FullName)),
+			Type:        constant.ConstRegistration,
+		})
 	})
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
@@ -33,7 +33,7 @@ func (uu *UserUsecase) UpdateProfile(ctx context.Context, user *userdto.UpdatePr
 		return errors.New("user not found in database")
 	}
 
-	if user.FullName == userDB.FullName && user.Phone == userDB.Phone && user.Email == userDB.Email && user.KakaoTalkID == userDB.KakaoTalkID {
+	if user.FullName == userDB.FullName && user.Phone == userDB.Phone && user.Email == userDB.Email && user.KakaoTalkID == userDB.KakaoTalkID && user.Language == userDB.Language {
 		logger.Info(ctx, "No changes detected in user profile", nil)
 		return errors.New("no changes detected in user profile")
 	}
@@ -50,6 +50,7 @@ func (uu *UserUsecase) UpdateProfile(ctx context.Context, user *userdto.UpdatePr
 	userDB.FullName = user.FullName
 	userDB.Email = user.Email
 	userDB.Phone = user.Phone
+	userDB.Language = user.Language
 
 	_, err = uu.userRepo.UpdateUser(ctx, userDB)
 	if err != nil {
@@ -81,9 +81,10 @@ func (uu *UserUsecase) UpdateSetting(ctx context.Context, req *userdto.UpdateSet
 		return err
 	}
 
-	if err := uu.authRepo.DeleteAccessToken(ctx, dataUser.ID); err != nil {
-		logger.Error(ctx, "Error to delete access token", err.Error())
-		return errors.New("failed to delete access token")
+	// Semua sesi berakhir setelah username atau password berubah
+	if _, err := uu.authRepo.RevokeSessionsByUserID(ctx, dataUser.ID, ""); err != nil {
+		logger.Error(ctx, "Error to revoke sessions", err.Error())
+		return errors.New("failed to revoke sessions")
 	}
 
 	return nil
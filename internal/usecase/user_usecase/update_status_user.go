@@ -3,6 +3,7 @@ package user_usecase
 import (
 	"context"
 	"fmt"
+	"time"
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/internal/dto/userdto"
 	"wtm-backend/pkg/constant"
@@ -18,22 +19,18 @@ func (uu *UserUsecase) UpdateStatusUser(ctx context.Context, req *userdto.Update
 		statusUser = constant.StatusUserRejectID
 	}
 
-	user, err := uu.userRepo.UpdateStatusUser(ctx, req.ID, statusUser)
-	if err != nil {
-		logger.Error(ctx, "Error updating user status:", err.Error())
-		return err
-	}
-
-	go func() {
-		newCtx, cancel := context.WithTimeout(context.Background(), uu.config.DurationCtxTOSlow)
-		defer cancel()
-		uu.sendEmailNotification(newCtx, req, user.FullName, user.Email)
-	}()
+	return uu.dbTrx.WithTransaction(ctx, func(txCtx context.Context) error {
+		user, err := uu.userRepo.UpdateStatusUser(txCtx, req.ID, statusUser)
+		if err != nil {
+			logger.Error(ctx, "Error updating user status:", err.Error())
+			return err
+		}
 
-	return nil
+		return uu.queueEmailNotification(txCtx, req, user.FullName, user.Email, user.Language)
+	})
 }
 
-func (uu *UserUsecase) sendEmailNotification(ctx context.Context, req *userdto.UpdateStatusUserRequest, name, email string) {
+func (uu *UserUsecase) queueEmailNotification(ctx context.Context, req *userdto.UpdateStatusUserRequest, name, email, locale string) error {
 	var statusEmail string
 	var loginLink = fmt.Sprintf("%s/login", uu.config.URLFEAgent)
 	var reRegisterLink = fmt.Sprintf("%s/register", uu.config.URLFEAgent)
@@ -44,15 +41,15 @@ func (uu *UserUsecase) sendEmailNotification(ctx context.Context, req *userdto.U
 		statusEmail = constant.EmailAgentRejected
 	}
 
-	emailTemplate, err := uu.emailRepo.GetEmailTemplateByName(ctx, statusEmail)
+	emailTemplate, err := uu.emailRepo.GetEmailTemplateByName(ctx, statusEmail, locale)
 	if err != nil {
 		logger.Error(ctx, "Error getting email template by name:", err.Error())
-		return
+		return nil
 	}
 
 	if emailTemplate == nil {
 		logger.Error(ctx, "Email template not found for status:", statusEmail)
-		return
+		return nil
 	}
 
 	// Inject data
@@ -66,11 +63,9 @@ func (uu *UserUsecase) sendEmailNotification(ctx context.Context, req *userdto.U
 	bodyHTML, err := utils.ParseTemplate(emailTemplate.Body, data)
 	if err != nil {
 		logger.Error(ctx, "Error parsing body HTML:", err.Error())
-		return
+		return nil
 	}
 
-	bodyText := "Please view this email in HTML format." // Optional fallback
-
 	subjectParsed := emailTemplate.Subject
 
 	emailTo := email
@@ -80,34 +75,17 @@ func (uu *UserUsecase) sendEmailNotification(ctx context.Context, req *userdto.U
 		Subject:         subjectParsed,
 		Body:            bodyHTML,
 		EmailTemplateID: uint(emailTemplate.ID),
+		TemplateName:    emailTemplate.Name,
+		Scope:           string(constant.ScopeAgent),
 	}
 	metadataLog := entity.MetadataEmailLog{AgentName: name}
 	emailLog.Meta = &metadataLog
 
-	var dataEmail bool
-	statusEmailID := constant.StatusEmailSuccessID
-	if err = uu.emailRepo.CreateEmailLog(ctx, &emailLog); err != nil {
-		logger.Error(ctx, "Failed to create email log:", err)
-		dataEmail = false
-	} else {
-		dataEmail = true
-	}
-
-	err = uu.emailSender.Send(ctx, constant.ScopeAgent, emailTo, subjectParsed, bodyHTML, bodyText)
-	if err != nil {
-		logger.Error(ctx, "Failed to sending email:", err.Error())
-		statusEmailID = constant.StatusEmailFailedID
-		metadataLog.Notes = fmt.Sprintf("Failed to sending email: %s", err.Error())
-		emailLog.Meta = &metadataLog
-	}
-
-	if dataEmail {
-		emailLog.StatusID = uint(statusEmailID)
-		if err := uu.emailRepo.UpdateStatusEmailLog(ctx, &emailLog); err != nil {
-			logger.Error(ctx, "Failed to update email log:", err.Error())
-		}
+	if err = uu.emailRepo.QueueEmail(ctx, &emailLog, time.Time{}); err != nil {
+		logger.Error(ctx, "Failed to queue email:", err.Error())
+		return err
 	}
-
+	return nil
 }
 
 type EmailData struct {
@@ -0,0 +1,12 @@
+package user_usecase
+
+import (
+	"context"
+	"wtm-backend/internal/dto/userdto"
+)
+
+// UpdateTwoFactorPolicy makes two-factor authentication mandatory or optional for the users of a role.
+// Users of the role without an authenticator enroll one at their next login.
+func (uu *UserUsecase) UpdateTwoFactorPolicy(ctx context.Context, req *userdto.UpdateTwoFactorPolicyRequest) error {
+	return uu.userRepo.UpdateRoleTwoFactorRequired(ctx, getRoleID(req.Role), req.Required)
+}
@@ -126,6 +126,7 @@ func (uu *UserUsecase) UpdateUserByAdmin(ctx context.Context, req *userdto.Updat
 		userDB.Username = req.Username
 		userDB.KakaoTalkID = req.KakaoTalkID
 		userDB.Currency = req.Currency
+		userDB.Language = req.Language
 		userDB.StatusID = getStatusID(req.IsActive)
 		if userDB.StatusID == constant.StatusUserInactiveID {
 			isNeedLogout = true
@@ -138,9 +139,9 @@ func (uu *UserUsecase) UpdateUserByAdmin(ctx context.Context, req *userdto.Updat
 		}
 
 		if isNeedLogout {
-			if err := uu.authRepo.DeleteAccessToken(txCtx, userDB.ID); err != nil {
-				logger.Error(txCtx, "Error to delete access token", err.Error())
-				return errors.New("failed to delete access token")
+			if _, err := uu.authRepo.RevokeSessionsByUserID(txCtx, userDB.ID, ""); err != nil {
+				logger.Error(txCtx, "Error to revoke sessions", err.Error())
+				return errors.New("failed to revoke sessions")
 			}
 		}
 		return nil
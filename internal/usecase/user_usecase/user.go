@@ -24,9 +24,10 @@ type UserUsecase struct {
 	middleware     domain.Middleware
 	dbTrx          domain.DatabaseTransaction
 	emailSender    domain.EmailSender
+	notifier       domain.Notifier
 }
 
-func NewUserUsecase(userRepo domain.UserRepository, authRepo domain.AuthRepository, promoGroupRepo domain.PromoGroupRepository, emailRepo domain.EmailRepository, config *config.Config, minio domain.StorageClient, middleware domain.Middleware, dbTrx domain.DatabaseTransaction, emailSender domain.EmailSender) *UserUsecase {
+func NewUserUsecase(userRepo domain.UserRepository, authRepo domain.AuthRepository, promoGroupRepo domain.PromoGroupRepository, emailRepo domain.EmailRepository, config *config.Config, minio domain.StorageClient, middleware domain.Middleware, dbTrx domain.DatabaseTransaction, emailSender domain.EmailSender, notifier domain.Notifier) *UserUsecase {
 	return &UserUsecase{
 		userRepo:       userRepo,
 		authRepo:       authRepo,
@@ -37,6 +38,7 @@ func NewUserUsecase(userRepo domain.UserRepository, authRepo domain.AuthReposito
 		middleware:     middleware,
 		dbTrx:          dbTrx,
 		emailSender:    emailSender,
+		notifier:       notifier,
 	}
 }
 
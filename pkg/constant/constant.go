@@ -19,6 +19,15 @@ const (
 	ConstAll        = "all"
 )
 
+// Notification types of the admin events, fanned out to the users whose role holds the permission of the event
+const (
+	ConstNewBooking   = "new_booking"  // An agent checked out a cart
+	ConstReceipt      = "receipt"      // An agent uploaded a payment receipt
+	ConstCancel       = "cancel"       // An agent cancelled a sub-booking
+	ConstRegistration = "registration" // An agent registered and waits for approval
+	ConstHotelReply   = "hotel_reply"  // A hotel confirmed or rejected a booking by email
+)
+
 const (
 	DefaultStatusSign     = 1
 	DefaultRoleAgent      = 3
@@ -45,6 +54,43 @@ const (
 	RoleAgentCap      = "Agent"
 )
 
+// Notification types of the agent events
+const (
+	ConstBookingConfirmed = "booking_confirmed"
+	ConstBookingRejected  = "booking_rejected"
+	ConstBookingCancelled = "booking_cancelled"
+	ConstPaymentReceived  = "payment_received"
+	ConstPromoPublished   = "promo_published"
+	ConstCheckInReminder  = "check_in_reminder"
+	ConstDigest           = "digest" // The daily summary of the notifications held in digest mode
+)
+
+// Digest modes of the notification preferences
+const (
+	DigestModeOff   = "off"   // Every notification is sent when it happens
+	DigestModeDaily = "daily" // Notifications are batched into one summary a day
+
+	DefaultDigestHour      = 8
+	DefaultQuietHoursStart = "22:00"
+	DefaultQuietHoursEnd   = "07:00"
+)
+
+// Permissions the admin events are fanned out on
+const (
+	PermissionBookingEdit = "booking:edit"
+	PermissionAccountEdit = "account:edit"
+)
+
+// Permissions the API key scopes of the agent routes are checked against
+const (
+	PermissionBookingView   = "booking:view"
+	PermissionBookingCreate = "booking:create"
+	PermissionHotelView     = "hotel:view"
+)
+
+// APIKeyPrefix starts every API key, so a key can be told apart from an access token
+const APIKeyPrefix = "wtm_"
+
 const (
 	StatusBookingInCart            = "In Cart"
 	StatusBookingWaitingApproval   = "Waiting Approval"
@@ -59,10 +105,19 @@ const (
 )
 
 const (
-	StatusPaymentUnpaid   = "Unpaid"
-	StatusPaymentPaid     = "Paid"
-	StatusPaymentUnpaidID = 1
-	StatusPaymentPaidID   = 2
+	StatusPaymentUnpaid          = "Unpaid"
+	StatusPaymentPaid            = "Paid"
+	StatusPaymentPartiallyPaid   = "Partially Paid"
+	StatusPaymentUnpaidID        = 1
+	StatusPaymentPaidID          = 2
+	StatusPaymentPartiallyPaidID = 3
+)
+
+const (
+	PaymentMethodBankTransfer = "bank_transfer"
+	PaymentMethodCreditCard   = "credit_card"
+	PaymentMethodCash         = "cash"
+	PaymentMethodOther        = "other"
 )
 
 const (
@@ -92,14 +147,19 @@ const (
 	EmailBookingRejected     = "booking_rejected"
 	EmailHotelBookingRequest = "hotel_booking_request"
 	EmailHotelBookingCancel  = "hotel_booking_cancel"
+	EmailHotelBookingAmend   = "hotel_booking_amend"
 	EmailContactUsGeneral    = "contact_us_general"
 	EmailContactUsBooking    = "contact_us_booking"
 	EmailForgotPassword      = "forgot_password"
 	EmailAccountActivated    = "account_activated"
+	EmailNotification        = "notification"
+	EmailNotificationDigest  = "notification_digest"
+	EmailAccountLocked       = "account_locked"
 )
 const (
 	BookingRequest = "Booking Request"
 	BookingCancel  = "Booking Cancel"
+	BookingAmend   = "Booking Amend"
 )
 
 const (
@@ -131,6 +191,27 @@ const (
 	StatusEmailFailedID  = 3
 )
 
+const (
+	EmailOutboxPending    = "pending"    // Waiting for its next attempt
+	EmailOutboxProcessing = "processing" // Claimed by a worker until locked_until
+	EmailOutboxSent       = "sent"
+	EmailOutboxDead       = "dead" // Gave up after the maximum attempts
+)
+
+// Notification events are published on NotificationChannelPrefix + user ID and pushed to the connected clients of the user
+const (
+	NotificationChannelPrefix    = "notification:user:"
+	NotificationEventCreated     = "notification"
+	NotificationEventUnreadCount = "unread_count"
+)
+
+const (
+	InboundEmailUnmatched = "unmatched" // No booking code of ours in the subject
+	InboundEmailReceived  = "received"  // Matched a booking, nothing to apply or not a reply of the hotel about the booking in the subject
+	InboundEmailAttached  = "attached"  // Confirms or rejects the booking, left to the admins
+	InboundEmailApplied   = "applied"   // The status of the booking was changed from the reply
+)
+
 const (
 	RoomPrice = "Room Price"
 	UnitNight = "night"
@@ -153,3 +234,11 @@ const (
 	ScopeHotel Scope = "hotel" // untuk email terkait akun (verifikasi, reset password)
 	ScopeAgent Scope = "agent" // untuk email transaksi (invoice, receipt, status order)
 )
+
+// Languages of email templates and of the preferred language of users and hotels
+const (
+	LocaleEnglish    = "en"
+	LocaleIndonesian = "id"
+	LocaleKorean     = "ko"
+	DefaultLocale    = LocaleEnglish // Fallback when a template has no variant in the recipient's language
+)
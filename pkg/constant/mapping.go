@@ -28,19 +28,98 @@ var StatusBookingOrder = []int{
 }
 
 var MapStatusPayment = map[int]string{
-	StatusPaymentUnpaidID: StatusPaymentUnpaid,
-	StatusPaymentPaidID:   StatusPaymentPaid,
+	StatusPaymentUnpaidID:        StatusPaymentUnpaid,
+	StatusPaymentPaidID:          StatusPaymentPaid,
+	StatusPaymentPartiallyPaidID: StatusPaymentPartiallyPaid,
 }
 
 // slice untuk urutan
 var StatusPaymentOrder = []int{
 	StatusPaymentUnpaidID,
+	StatusPaymentPartiallyPaidID,
 	StatusPaymentPaidID,
 }
 
 var MapEmailType = map[string]string{
 	EmailHotelBookingRequest: BookingRequest,
 	EmailHotelBookingCancel:  BookingCancel,
+	EmailHotelBookingAmend:   BookingAmend,
+}
+
+// MapEmailTemplateType maps the template type of the email template endpoints to its template name
+var MapEmailTemplateType = map[string]string{
+	"confirm":               EmailHotelBookingRequest,
+	"cancel":                EmailHotelBookingCancel,
+	"amend":                 EmailHotelBookingAmend,
+	EmailBookingConfirmed:   EmailBookingConfirmed,
+	EmailBookingRejected:    EmailBookingRejected,
+	EmailAgentApproved:      EmailAgentApproved,
+	EmailAgentRejected:      EmailAgentRejected,
+	EmailContactUsGeneral:   EmailContactUsGeneral,
+	EmailContactUsBooking:   EmailContactUsBooking,
+	EmailForgotPassword:     EmailForgotPassword,
+	EmailAccountActivated:   EmailAccountActivated,
+	EmailNotification:       EmailNotification,
+	EmailNotificationDigest: EmailNotificationDigest,
+	EmailAccountLocked:      EmailAccountLocked,
+}
+
+// EmailTemplateTypes contains all valid template types of the email template endpoints
+var EmailTemplateTypes = []string{
+	"confirm",
+	"cancel",
+	"amend",
+	EmailBookingConfirmed,
+	EmailBookingRejected,
+	EmailAgentApproved,
+	EmailAgentRejected,
+	EmailContactUsGeneral,
+	EmailContactUsBooking,
+	EmailForgotPassword,
+	EmailAccountActivated,
+	EmailNotification,
+	EmailNotificationDigest,
+	EmailAccountLocked,
+}
+
+// AgentNotificationTypes contains the notification types of the agent events, enabled by default for every agent
+var AgentNotificationTypes = []string{
+	ConstBookingConfirmed,
+	ConstBookingRejected,
+	ConstBookingCancelled,
+	ConstPaymentReceived,
+	ConstPromoPublished,
+	ConstCheckInReminder,
+}
+
+// AdminNotificationTypes contains the notification types of the admin events, enabled by default for every non-agent user
+var AdminNotificationTypes = []string{
+	ConstNewBooking,
+	ConstReceipt,
+	ConstCancel,
+	ConstRegistration,
+	ConstHotelReply,
+}
+
+// RoleImplicitPermissions are permissions a role holds through its own pages rather than its role access, e.g. agents
+// book through the cart without booking:create. They can be granted to API keys of the role but never pass
+// RequirePermission.
+var RoleImplicitPermissions = map[uint][]string{
+	RoleAgentID: {PermissionBookingCreate},
+}
+
+// TwoFactorPolicyRoles contains the roles whose users the super admin can require to log in with two-factor authentication
+var TwoFactorPolicyRoles = []uint{
+	RoleSuperAdminID,
+	RoleAdminID,
+}
+
+// NotificationTypesOf returns the notification types of the role, agents get the agent events and the other roles the admin events
+func NotificationTypesOf(roleID uint) []string {
+	if roleID == RoleAgentID {
+		return AgentNotificationTypes
+	}
+	return AdminNotificationTypes
 }
 
 // AdditionalServiceCategories contains all valid category values for additional services
@@ -49,8 +128,23 @@ var AdditionalServiceCategories = []string{
 	AdditionalServiceCategoryPax,
 }
 
+// PaymentMethods contains all valid methods of a payment ledger entry
+var PaymentMethods = []string{
+	PaymentMethodBankTransfer,
+	PaymentMethodCreditCard,
+	PaymentMethodCash,
+	PaymentMethodOther,
+}
+
 // GuestCategories contains all valid category values for guests
 var GuestCategories = []string{
 	GuestCategoryAdult,
 	GuestCategoryChild,
 }
+
+// Locales contains all supported languages of email templates
+var Locales = []string{
+	LocaleEnglish,
+	LocaleIndonesian,
+	LocaleKorean,
+}
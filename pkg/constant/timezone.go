@@ -4,6 +4,19 @@ import "time"
 
 var AsiaJakarta = mustLoadLocation("Asia/Jakarta")
 
+// DefaultTimezone is the timezone of users who did not choose one
+const DefaultTimezone = "Asia/Jakarta"
+
+// Timezones users can choose for their quiet hours and digest, the regions the agents and hotels are in
+var Timezones = []string{
+	"Asia/Jakarta",
+	"Asia/Makassar",
+	"Asia/Jayapura",
+	"Asia/Seoul",
+	"Asia/Singapore",
+	"UTC",
+}
+
 func mustLoadLocation(name string) *time.Location {
 	loc, err := time.LoadLocation(name)
 	if err != nil {
@@ -11,3 +24,18 @@ func mustLoadLocation(name string) *time.Location {
 	}
 	return loc
 }
+
+// LoadTimezone returns the location of a timezone of Timezones, Asia/Jakarta for an empty or unknown name
+func LoadTimezone(name string) *time.Location {
+	if name == "" || name == DefaultTimezone {
+		return AsiaJakarta
+	}
+	for _, timezone := range Timezones {
+		if timezone == name {
+			if loc, err := time.LoadLocation(name); err == nil {
+				return loc
+			}
+		}
+	}
+	return AsiaJakarta
+}
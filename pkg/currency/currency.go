@@ -3,6 +3,7 @@ package currency
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -103,15 +104,39 @@ func JSONToPrices(data []byte) (map[string]float64, error) {
 	return prices, nil
 }
 
+// Currencies without minor units
+var zeroDecimalCurrencies = map[string]bool{
+	"JPY": true, "KRW": true, "IDR": true, "VND": true,
+}
+
 // GetDecimalPlaces returns the number of decimal places for a currency
 // Most currencies use 2 decimal places, but some use 0 (JPY, KRW, IDR)
 func GetDecimalPlaces(currency string) int {
 	normalized := NormalizeCurrencyCode(currency)
-	zeroDecimalCurrencies := map[string]bool{
-		"JPY": true, "KRW": true, "IDR": true, "VND": true,
-	}
 	if zeroDecimalCurrencies[normalized] {
 		return 0
 	}
 	return 2
 }
+
+// ZeroDecimalCurrencies returns the codes of currencies without minor units
+func ZeroDecimalCurrencies() []string {
+	codes := make([]string, 0, len(zeroDecimalCurrencies))
+	for code := range zeroDecimalCurrencies {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// RoundPrices returns a copy of the prices map with every price rounded to the decimal places of its currency
+func RoundPrices(prices map[string]float64) map[string]float64 {
+	if prices == nil {
+		return nil
+	}
+	rounded := make(map[string]float64, len(prices))
+	for code, price := range prices {
+		rounded[code] = RoundAmount(price, code)
+	}
+	return rounded
+}
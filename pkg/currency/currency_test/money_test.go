@@ -0,0 +1,64 @@
+package currency_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"wtm-backend/pkg/currency"
+)
+
+func TestMoney(t *testing.T) {
+	money := func(amount float64, code string) currency.Money {
+		m, err := currency.NewMoney(amount, code)
+		assert.NoError(t, err)
+		return m
+	}
+
+	t.Run("rounds to currency decimal places", func(t *testing.T) {
+		assert.Equal(t, "1250001", money(1250000.5, "IDR").String())
+		assert.Equal(t, "1.01", money(1.005, "usd").String())
+		assert.Equal(t, "USD", money(1, "usd").Currency())
+	})
+
+	t.Run("rejects amounts it can't hold", func(t *testing.T) {
+		for _, amount := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), 1e20} {
+			_, err := currency.NewMoney(amount, "USD")
+			assert.Error(t, err, amount)
+		}
+	})
+
+	t.Run("sums without float drift", func(t *testing.T) {
+		total := currency.ZeroMoney("USD")
+		for i := 0; i < 10; i++ {
+			total = total.Add(money(0.1, "USD"))
+		}
+		assert.Equal(t, 1.0, total.Float64())
+	})
+
+	t.Run("percentage discount", func(t *testing.T) {
+		price := money(1333333, "IDR")
+		assert.Equal(t, "1133333", price.Discount(15).String())
+		assert.Equal(t, "8.75", money(10, "USD").Discount(12.5).String())
+	})
+
+	t.Run("average nightly rate", func(t *testing.T) {
+		assert.Equal(t, "333333", money(1000000, "IDR").Div(3).String())
+		assert.Equal(t, "3.33", money(10, "USD").Div(3).String())
+	})
+
+	t.Run("json round trip", func(t *testing.T) {
+		data, err := json.Marshal(struct {
+			Total currency.Money `json:"total"`
+		}{Total: money(99.9, "USD")})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"total": 99.90}`, string(data))
+
+		var decoded struct {
+			Total currency.Money `json:"total"`
+		}
+		assert.NoError(t, json.Unmarshal([]byte(`{"total": 100.00000000000001}`), &decoded))
+		assert.Equal(t, "100.00", decoded.Total.WithCurrency("USD").String())
+	})
+}
@@ -0,0 +1,42 @@
+package currency
+
+// ConvertFromIDR converts an IDR amount with an IDR-based exchange rate (IDR per 1 unit of currency),
+// adds the markup percentage and rounds to the decimal places of the currency.
+func ConvertFromIDR(amountIDR, rate, markupPercent float64, currency string) float64 {
+	if rate <= 0 {
+		return 0
+	}
+	converted := amountIDR / rate * (1 + markupPercent/100)
+	return RoundAmount(converted, currency)
+}
+
+// RoundAmount rounds an amount to the decimal places of the currency.
+// Amounts Money can't hold (NaN, infinite, out of range) are returned as is for validation to reject.
+func RoundAmount(amount float64, currency string) float64 {
+	money, err := NewMoney(amount, currency)
+	if err != nil {
+		return amount
+	}
+	return money.Float64()
+}
+
+// DerivePrice adds the price for currency to a copy of the prices map when it was not entered,
+// converting the IDR price with the exchange rate. The map is returned unchanged when the currency
+// is already present, when there is no IDR price or when rate is not positive.
+func DerivePrice(prices map[string]float64, currency string, rate, markupPercent float64) map[string]float64 {
+	normalizedCurrency := NormalizeCurrencyCode(currency)
+	if _, exists := prices[normalizedCurrency]; exists || rate <= 0 {
+		return prices
+	}
+	priceIDR, exists := prices["IDR"]
+	if !exists {
+		return prices
+	}
+
+	derived := make(map[string]float64, len(prices)+1)
+	for code, price := range prices {
+		derived[code] = price
+	}
+	derived[normalizedCurrency] = ConvertFromIDR(priceIDR, rate, markupPercent, normalizedCurrency)
+	return derived
+}
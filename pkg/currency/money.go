@@ -0,0 +1,268 @@
+package currency
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// moneyScale is the number of hundredths in one unit, every currency uses at most 2 decimal places
+const moneyScale = 100
+
+// maxMoneyUnits keeps the hundredths and their products with percentages inside int64
+const maxMoneyUnits = math.MaxInt64 / moneyScale / (100 * moneyScale)
+
+// Money is an amount paired with its currency, held as an integer number of hundredths so sums of
+// invoice lines and percentage discounts don't accumulate floating point errors.
+// Amounts are rounded half away from zero to the decimal places of the currency (see GetDecimalPlaces).
+// It is encoded as a plain decimal number in JSON and SQL, the currency travels in its own field.
+type Money struct {
+	hundredths int64
+	currency   string
+}
+
+// NewMoney converts a float amount into Money, rounded to the decimal places of the currency.
+// NaN, infinite and out of range amounts are rejected.
+func NewMoney(amount float64, currency string) (Money, error) {
+	hundredths, err := parseHundredths(strconv.FormatFloat(amount, 'f', 6, 64))
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid money amount %v: %w", amount, err)
+	}
+	return Money{hundredths: hundredths, currency: NormalizeCurrencyCode(currency)}.round(), nil
+}
+
+// ParseMoney parses a decimal string such as "1250000" or "12.50" into Money
+func ParseMoney(amount string, currency string) (Money, error) {
+	hundredths, err := parseDecimalOrFloat(strings.TrimSpace(amount))
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{hundredths: hundredths, currency: NormalizeCurrencyCode(currency)}.round(), nil
+}
+
+// ZeroMoney returns a zero amount in the currency
+func ZeroMoney(currency string) Money {
+	return Money{currency: NormalizeCurrencyCode(currency)}
+}
+
+// SumMoney adds up amounts of the same currency
+func SumMoney(currency string, amounts ...Money) Money {
+	total := ZeroMoney(currency)
+	for _, amount := range amounts {
+		total = total.Add(amount)
+	}
+	return total
+}
+
+// Currency returns the currency code of the amount
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// WithCurrency returns the amount in the given currency, rounded to its decimal places.
+// It is used to restore the currency of amounts decoded from JSON or SQL.
+func (m Money) WithCurrency(currency string) Money {
+	m.currency = NormalizeCurrencyCode(currency)
+	return m.round()
+}
+
+// Float64 returns the amount as a float, for DTOs and columns that are still float based
+func (m Money) Float64() float64 {
+	return float64(m.hundredths) / moneyScale
+}
+
+func (m Money) IsZero() bool {
+	return m.hundredths == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.hundredths < 0
+}
+
+// Add returns m + other, both amounts are expected in the same currency
+func (m Money) Add(other Money) Money {
+	return Money{hundredths: m.hundredths + other.hundredths, currency: m.pickCurrency(other)}
+}
+
+// Sub returns m - other, both amounts are expected in the same currency
+func (m Money) Sub(other Money) Money {
+	return Money{hundredths: m.hundredths - other.hundredths, currency: m.pickCurrency(other)}
+}
+
+// Mul returns the amount multiplied by a quantity (nights, rooms, pax)
+func (m Money) Mul(quantity int) Money {
+	return Money{hundredths: m.hundredths * int64(quantity), currency: m.currency}
+}
+
+// Div splits the amount in n parts, e.g. the average nightly rate of a stay, rounded to the currency
+func (m Money) Div(n int) Money {
+	if n == 0 {
+		return m
+	}
+	return Money{hundredths: divRound(m.hundredths, int64(n)), currency: m.currency}.round()
+}
+
+// Percent returns percent % of the amount, rounded to the currency.
+// The percentage is taken with 2 decimal places, e.g. 12.5 or 7.25.
+func (m Money) Percent(percent float64) Money {
+	percentHundredths, _ := parseHundredths(strconv.FormatFloat(percent, 'f', 6, 64))
+	return Money{hundredths: divRound(m.hundredths*percentHundredths, 100*moneyScale), currency: m.currency}.round()
+}
+
+// Discount returns the amount after a percentage discount
+func (m Money) Discount(percent float64) Money {
+	return m.Sub(m.Percent(percent))
+}
+
+// String formats the amount with the decimal places of the currency, without symbol
+func (m Money) String() string {
+	places := GetDecimalPlaces(m.currency)
+	sign := ""
+	hundredths := m.hundredths
+	if hundredths < 0 {
+		sign = "-"
+		hundredths = -hundredths
+	}
+	units := hundredths / moneyScale
+	if places == 0 {
+		return fmt.Sprintf("%s%d", sign, units)
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, units, hundredths%moneyScale)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON accepts a JSON number or a quoted decimal string. Float values from older
+// snapshots are rounded to hundredths, the currency is restored with WithCurrency.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	value := strings.Trim(strings.TrimSpace(string(data)), `"`)
+	if value == "" || value == "null" {
+		m.hundredths = 0
+		return nil
+	}
+	hundredths, err := parseDecimalOrFloat(value)
+	if err != nil {
+		return fmt.Errorf("invalid money amount %q: %w", value, err)
+	}
+	m.hundredths = hundredths
+	return nil
+}
+
+// Value stores the amount in a numeric column
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// Scan reads the amount from a numeric or float column
+func (m *Money) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		m.hundredths = 0
+		return nil
+	case int64:
+		m.hundredths = v * moneyScale
+		return nil
+	case float64:
+		hundredths, err := parseHundredths(strconv.FormatFloat(v, 'f', 6, 64))
+		if err != nil {
+			return fmt.Errorf("invalid money amount %v: %w", v, err)
+		}
+		m.hundredths = hundredths
+		return nil
+	case []byte:
+		return m.UnmarshalJSON(v)
+	case string:
+		return m.UnmarshalJSON([]byte(v))
+	default:
+		return fmt.Errorf("cannot scan %T into Money", src)
+	}
+}
+
+func (m Money) pickCurrency(other Money) string {
+	if m.currency == "" {
+		return other.currency
+	}
+	return m.currency
+}
+
+// round rounds to the decimal places of the currency
+func (m Money) round() Money {
+	if GetDecimalPlaces(m.currency) == 0 {
+		m.hundredths = divRound(m.hundredths, moneyScale) * moneyScale
+	}
+	return m
+}
+
+// divRound divides rounding half away from zero
+func divRound(numerator, denominator int64) int64 {
+	if denominator < 0 {
+		numerator, denominator = -numerator, -denominator
+	}
+	quotient, remainder := numerator/denominator, numerator%denominator
+	if remainder < 0 {
+		remainder = -remainder
+	}
+	if remainder*2 >= denominator {
+		if numerator < 0 {
+			quotient--
+		} else {
+			quotient++
+		}
+	}
+	return quotient
+}
+
+// parseDecimalOrFloat parses plain decimals exactly and falls back to float parsing for exponents
+func parseDecimalOrFloat(value string) (int64, error) {
+	if strings.ContainsAny(value, "eE") {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, err
+		}
+		return parseHundredths(strconv.FormatFloat(f, 'f', 6, 64))
+	}
+	return parseHundredths(value)
+}
+
+// parseHundredths parses a plain decimal string into hundredths, rounding half away from zero
+func parseHundredths(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimLeft(value, "+-")
+
+	intPart, fracPart, _ := strings.Cut(value, ".")
+	if intPart == "" {
+		intPart = "0"
+	}
+	units, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if units > maxMoneyUnits {
+		return 0, fmt.Errorf("amount out of range")
+	}
+
+	fracPart += "000"
+	cents, err := strconv.ParseInt(fracPart[:2], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	for _, digit := range fracPart[2:] {
+		if digit < '0' || digit > '9' {
+			return 0, fmt.Errorf("invalid digit %q", digit)
+		}
+	}
+
+	hundredths := units*moneyScale + cents
+	if fracPart[2] >= '5' {
+		hundredths++
+	}
+	if negative {
+		hundredths = -hundredths
+	}
+	return hundredths, nil
+}
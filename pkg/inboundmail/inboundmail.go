@@ -0,0 +1,286 @@
+// Package inboundmail parses the replies of hotels to booking emails, received as raw MIME messages by the inbound email webhook.
+package inboundmail
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Actions a hotel reply asks for
+const (
+	ActionConfirm = "confirm"
+	ActionReject  = "reject"
+	ActionNone    = "none" // No confirmation number nor rejection keyword, or both
+)
+
+// Message is an inbound email reduced to what the booking matching needs
+type Message struct {
+	MessageID string
+	From      string
+	To        string
+	Subject   string
+	Text      string // Plain text body, HTML bodies are stripped of their tags
+	Reply     string // Text without the quoted original email
+}
+
+var (
+	// Booking codes are BK-yyMMdd-xxxx and sub-booking IDs SBK-yyMMdd-xxxx, see BookingRepository.GenerateCode
+	bookingCodePattern = regexp.MustCompile(`(?:^|[^A-Za-z0-9])(S?BK-\d{6}-[A-Za-z0-9_-]{4})`)
+
+	confirmationPattern = regexp.MustCompile(`(?i)(?:confirmation|conf|konfirmasi|확인|예약)\s*(?:number|num|no|nomor|code|kode|번호|#)?\.?\s*(?:is|adalah|:|=|#)?\s*#?\s*([A-Za-z0-9][A-Za-z0-9/-]{2,29})`)
+
+	// The first line of the quoted original email in English, Indonesian and Korean mail clients
+	quoteMarkers = []*regexp.Regexp{
+		regexp.MustCompile(`(?m)^On .+wrote:\s*$`),
+		regexp.MustCompile(`(?m)^Pada .+menulis:\s*$`),
+		regexp.MustCompile(`(?m)^.+님이 작성:\s*$`),
+		regexp.MustCompile(`(?mi)^-{2,}\s*Original Message\s*-{2,}`),
+		regexp.MustCompile(`(?m)^From: .+$`),
+		regexp.MustCompile(`(?m)^>`),
+	}
+
+	rejectionKeywords = []string{
+		"reject", "decline", "fully booked", "not available", "unavailable", "no availability", "no vacancy", "cannot accommodate", "can't accommodate",
+		"tolak", "penuh", "tidak tersedia",
+		"거절", "만실", "불가",
+	}
+
+	htmlBreakPattern = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</div>|</tr>`)
+	htmlTagPattern   = regexp.MustCompile(`<[^>]*>`)
+)
+
+// Parse reads a raw RFC 5322 message. The text is taken from the first text/plain part, else from the first text/html part.
+func Parse(raw []byte) (*Message, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("read message: %w", err)
+	}
+
+	decoder := new(mime.WordDecoder)
+	subject, err := decoder.DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		subject = msg.Header.Get("Subject")
+	}
+
+	plain, htmlBody, err := readBody(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), msg.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	text := plain
+	if strings.TrimSpace(text) == "" {
+		text = stripHTML(htmlBody)
+	}
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+
+	return &Message{
+		MessageID: strings.Trim(strings.TrimSpace(msg.Header.Get("Message-Id")), "<>"),
+		From:      address(msg.Header.Get("From")),
+		To:        address(msg.Header.Get("To")),
+		Subject:   strings.TrimSpace(subject),
+		Text:      strings.TrimSpace(text),
+		Reply:     StripQuoted(text),
+	}, nil
+}
+
+// readBody returns the first text/plain and text/html content of a body, walking nested multiparts
+func readBody(contentType, transferEncoding string, body io.Reader) (string, string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = "text/plain"
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		var plain, htmlBody string
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return plain, htmlBody, fmt.Errorf("read multipart: %w", err)
+			}
+			if strings.HasPrefix(part.Header.Get("Content-Disposition"), "attachment") {
+				continue
+			}
+			// multipart.Part already decodes quoted-printable
+			p, h, err := readBody(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part)
+			if err != nil {
+				return plain, htmlBody, err
+			}
+			if plain == "" {
+				plain = p
+			}
+			if htmlBody == "" {
+				htmlBody = h
+			}
+		}
+		return plain, htmlBody, nil
+	}
+
+	content, err := io.ReadAll(decodeTransfer(transferEncoding, body))
+	if err != nil {
+		return "", "", fmt.Errorf("read body: %w", err)
+	}
+
+	switch mediaType {
+	case "text/plain":
+		return string(content), "", nil
+	case "text/html":
+		return "", string(content), nil
+	default:
+		return "", "", nil
+	}
+}
+
+func decodeTransfer(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, newlineStripper{body})
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+// newlineStripper drops the line breaks of base64 content, which the standard decoder does not accept
+type newlineStripper struct{ r io.Reader }
+
+func (n newlineStripper) Read(p []byte) (int, error) {
+	count, err := n.r.Read(p)
+	kept := 0
+	for _, b := range p[:count] {
+		if b != '\r' && b != '\n' {
+			p[kept] = b
+			kept++
+		}
+	}
+	return kept, err
+}
+
+func stripHTML(s string) string {
+	s = htmlBreakPattern.ReplaceAllString(s, "\n")
+	s = htmlTagPattern.ReplaceAllString(s, "")
+	return html.UnescapeString(s)
+}
+
+func address(header string) string {
+	if addr, err := mail.ParseAddress(header); err == nil {
+		return addr.Address
+	}
+	return strings.TrimSpace(header)
+}
+
+// StripQuoted cuts the quoted original email off a reply
+func StripQuoted(text string) string {
+	end := len(text)
+	for _, marker := range quoteMarkers {
+		if loc := marker.FindStringIndex(text); loc != nil && loc[0] < end {
+			end = loc[0]
+		}
+	}
+	return strings.TrimSpace(text[:end])
+}
+
+// BookingCodes returns the distinct booking codes and sub-booking IDs found in s, in order of appearance
+func BookingCodes(s string) (bookingCodes []string, subBookingIDs []string) {
+	seen := make(map[string]bool)
+	for _, match := range bookingCodePattern.FindAllStringSubmatch(s, -1) {
+		code := match[1]
+		if seen[code] {
+			continue
+		}
+		seen[code] = true
+		if strings.HasPrefix(code, "SBK-") {
+			subBookingIDs = append(subBookingIDs, code)
+		} else {
+			bookingCodes = append(bookingCodes, code)
+		}
+	}
+	return bookingCodes, subBookingIDs
+}
+
+// Classify reads the action of a reply: a confirmation number confirms, a rejection keyword rejects, both or neither leave it to the admins
+func Classify(reply string) (action string, confirmationNumber string) {
+	confirmationNumber = ConfirmationNumber(reply)
+
+	lower := strings.ToLower(reply)
+	rejected := false
+	for _, keyword := range rejectionKeywords {
+		if strings.Contains(lower, keyword) {
+			rejected = true
+			break
+		}
+	}
+
+	switch {
+	case rejected && confirmationNumber == "":
+		return ActionReject, ""
+	case !rejected && confirmationNumber != "":
+		return ActionConfirm, confirmationNumber
+	default:
+		return ActionNone, confirmationNumber
+	}
+}
+
+// ConfirmationNumber returns the first confirmation number of the reply, which must contain a digit and must not be one of our codes
+func ConfirmationNumber(reply string) string {
+	for _, match := range confirmationPattern.FindAllStringSubmatch(reply, -1) {
+		number := strings.TrimRight(match[1], "-/")
+		if !strings.ContainsAny(number, "0123456789") {
+			continue
+		}
+		if codes, subCodes := BookingCodes(" " + number); len(codes) > 0 || len(subCodes) > 0 {
+			continue
+		}
+		return number
+	}
+	return ""
+}
+
+// Sign returns the hex HMAC-SHA256 of "timestamp.body", the signature the webhook expects
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of body and that timestamp, in unix seconds, is within tolerance of now
+func Verify(secret, timestamp, signature string, body []byte, tolerance time.Duration, now time.Time) error {
+	if secret == "" {
+		return errors.New("inbound email secret is not configured")
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.New("invalid signature timestamp")
+	}
+	if age := now.Sub(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+		return errors.New("signature timestamp outside tolerance")
+	}
+
+	expected := Sign(secret, timestamp, body)
+	if !hmac.Equal([]byte(expected), []byte(strings.TrimPrefix(signature, "sha256="))) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
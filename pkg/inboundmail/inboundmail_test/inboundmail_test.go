@@ -0,0 +1,102 @@
+package inboundmail_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"wtm-backend/pkg/inboundmail"
+)
+
+func TestParsePlainReply(t *testing.T) {
+	raw := "From: Grand Hotel <reservations@grandhotel.com>\r\n" +
+		"To: hotel@wtm.com\r\n" +
+		"Subject: Re: New Booking Request =?UTF-8?Q?=E2=80=93?= BK-250201-a1B2\r\n" +
+		"Message-ID: <abc123@grandhotel.com>\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n" +
+		"\r\n" +
+		"Dear team,\r\n" +
+		"Confirmation number: GH-77812\r\n" +
+		"\r\n" +
+		"On Sat, Feb 1, 2025 at 10:00 AM WTM <hotel@wtm.com> wrote:\r\n" +
+		"> Please confirm sub-booking SBK-250201-x9Y8\r\n"
+
+	msg, err := inboundmail.Parse([]byte(raw))
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123@grandhotel.com", msg.MessageID)
+	assert.Equal(t, "reservations@grandhotel.com", msg.From)
+	assert.Equal(t, "Re: New Booking Request – BK-250201-a1B2", msg.Subject)
+	assert.Equal(t, "Dear team,\nConfirmation number: GH-77812", msg.Reply)
+	assert.Contains(t, msg.Text, "SBK-250201-x9Y8")
+}
+
+func TestParseMultipartHTMLOnly(t *testing.T) {
+	raw := "From: hotel@example.com\r\n" +
+		"Subject: Re: Booking Amendment - BK-250201-a1B2\r\n" +
+		"Content-Type: multipart/alternative; boundary=\"b1\"\r\n" +
+		"\r\n" +
+		"--b1\r\n" +
+		"Content-Type: text/html; charset=UTF-8\r\n" +
+		"Content-Transfer-Encoding: base64\r\n" +
+		"\r\n" +
+		"PHA+TWFhZiwga2FtYXIgc3VkYWggPGI+cGVudWg8L2I+LjwvcD4=\r\n" +
+		"--b1--\r\n"
+
+	msg, err := inboundmail.Parse([]byte(raw))
+	assert.NoError(t, err)
+	assert.Equal(t, "Maaf, kamar sudah penuh.", msg.Reply)
+
+	action, number := inboundmail.Classify(msg.Reply)
+	assert.Equal(t, inboundmail.ActionReject, action)
+	assert.Empty(t, number)
+}
+
+func TestBookingCodes(t *testing.T) {
+	codes, subCodes := inboundmail.BookingCodes("Re: BK-250201-a1B2 SBK-250201-x9Y8, SBK-250201-x9Y8 and SBK-250201-Zz_1")
+	assert.Equal(t, []string{"BK-250201-a1B2"}, codes)
+	assert.Equal(t, []string{"SBK-250201-x9Y8", "SBK-250201-Zz_1"}, subCodes)
+
+	codes, subCodes = inboundmail.BookingCodes("no codes here")
+	assert.Empty(t, codes)
+	assert.Empty(t, subCodes)
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name   string
+		reply  string
+		action string
+		number string
+	}{
+		{"confirmation number", "Confirmed, conf no. 889123", inboundmail.ActionConfirm, "889123"},
+		{"indonesian confirmation", "Nomor konfirmasi: HTL-2291", inboundmail.ActionConfirm, "HTL-2291"},
+		{"korean confirmation", "예약번호: 55120", inboundmail.ActionConfirm, "55120"},
+		{"rejection", "Sorry, we are fully booked on those dates", inboundmail.ActionReject, ""},
+		{"our own code is not a confirmation number", "Confirmation for BK-250201-a1B2 follows", inboundmail.ActionNone, ""},
+		{"number without digits", "Confirmation: pending", inboundmail.ActionNone, ""},
+		{"rejection and number", "Confirmation 12345 is not available", inboundmail.ActionNone, "12345"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, number := inboundmail.Classify(tt.reply)
+			assert.Equal(t, tt.action, action)
+			assert.Equal(t, tt.number, number)
+		})
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	now := time.Unix(1738400000, 0)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	body := []byte("raw message")
+	signature := inboundmail.Sign("secret", timestamp, body)
+
+	assert.NoError(t, inboundmail.Verify("secret", timestamp, signature, body, 5*time.Minute, now))
+	assert.NoError(t, inboundmail.Verify("secret", timestamp, "sha256="+signature, body, 5*time.Minute, now))
+	assert.Error(t, inboundmail.Verify("secret", timestamp, signature, []byte("tampered"), 5*time.Minute, now))
+	assert.Error(t, inboundmail.Verify("other", timestamp, signature, body, 5*time.Minute, now))
+	assert.Error(t, inboundmail.Verify("secret", timestamp, signature, body, 5*time.Minute, now.Add(10*time.Minute)))
+	assert.Error(t, inboundmail.Verify("", timestamp, signature, body, 5*time.Minute, now))
+}
@@ -4,25 +4,27 @@ import (
 	"context"
 	"fmt"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"time"
 	"wtm-backend/internal/domain/entity"
 	"wtm-backend/pkg/logger"
 )
 
 type JwtClaims struct {
-	User *entity.UserMin `json:"user"`
-	Type string          `json:"type"` // "access" or "refresh"
+	User      *entity.UserMin `json:"user"`
+	Type      string          `json:"type"` // "access" or "refresh"
+	SessionID string          `json:"sid"`  // Session the token belongs to
 	jwt.RegisteredClaims
 }
 
 // Untuk akses token
-func GenerateAccessToken(user *entity.User, secret string, expiry time.Duration) (string, error) {
-	return generateJWT(user, "access", secret, expiry)
+func GenerateAccessToken(user *entity.User, sessionID string, secret string, expiry time.Duration) (string, error) {
+	return generateJWT(user, "access", sessionID, secret, expiry)
 }
 
 // Untuk refresh token
-func GenerateRefreshToken(user *entity.User, secret string, expiry time.Duration) (string, error) {
-	return generateJWT(user, "refresh", secret, expiry)
+func GenerateRefreshToken(user *entity.User, sessionID string, secret string, expiry time.Duration) (string, error) {
+	return generateJWT(user, "refresh", sessionID, secret, expiry)
 }
 
 // ParseToken memverifikasi token dan mengembalikan klaim jika valid
@@ -58,7 +60,7 @@ func ParseToken(ctx context.Context, tokenStr, secret string) (*JwtClaims, error
 }
 
 // generateJWT adalah fungsi umum untuk membuat token JWT
-func generateJWT(user *entity.User, tokenType string, secret string, expiry time.Duration) (string, error) {
+func generateJWT(user *entity.User, tokenType string, sessionID string, secret string, expiry time.Duration) (string, error) {
 	claims := JwtClaims{
 		User: &entity.UserMin{
 			ID:          user.ID,
@@ -69,8 +71,10 @@ func generateJWT(user *entity.User, tokenType string, secret string, expiry time
 			PhotoURL:    user.PhotoSelfie,
 			FullName:    user.FullName,
 		},
-		Type: tokenType,
+		Type:      tokenType,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(), // Tokens signed in the same second stay distinct
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiry)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
@@ -0,0 +1,78 @@
+// Package notifpref decides when the notifications of a user are sent, from the quiet hours and daily digest of its preferences.
+package notifpref
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParseClock parses a "15:04" time of day into minutes since midnight
+func ParseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// QuietHours is a daily window in the timezone of the user, crossing midnight when End is before Start
+type QuietHours struct {
+	Start    string // "22:00"
+	End      string // "07:00"
+	Location *time.Location
+}
+
+// Until returns the end of the quiet hours when t is within them, else the zero time.
+// An invalid or empty window is never quiet.
+func (q QuietHours) Until(t time.Time) time.Time {
+	start, err := ParseClock(q.Start)
+	if err != nil {
+		return time.Time{}
+	}
+	end, err := ParseClock(q.End)
+	if err != nil || start == end {
+		return time.Time{}
+	}
+
+	loc := q.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	local := t.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	endOn := func(days int) time.Time {
+		return time.Date(local.Year(), local.Month(), local.Day()+days, end/60, end%60, 0, 0, loc)
+	}
+
+	if start < end {
+		if minute >= start && minute < end {
+			return endOn(0)
+		}
+		return time.Time{}
+	}
+
+	// The window crosses midnight
+	switch {
+	case minute >= start:
+		return endOn(1)
+	case minute < end:
+		return endOn(0)
+	default:
+		return time.Time{}
+	}
+}
+
+// LastDigest returns the latest daily digest time at hour, in loc, that is not after now
+func LastDigest(now time.Time, loc *time.Location, hour int) time.Time {
+	local := now.In(loc)
+	scheduled := time.Date(local.Year(), local.Month(), local.Day(), hour, 0, 0, 0, loc)
+	if local.Before(scheduled) {
+		scheduled = time.Date(local.Year(), local.Month(), local.Day()-1, hour, 0, 0, 0, loc)
+	}
+	return scheduled
+}
+
+// DigestDue reports whether a daily digest at hour, in loc, is due at now when the previous one was sent at last
+func DigestDue(now time.Time, loc *time.Location, hour int, last time.Time) bool {
+	return last.Before(LastDigest(now, loc, hour))
+}
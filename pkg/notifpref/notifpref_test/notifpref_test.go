@@ -0,0 +1,71 @@
+package notifpref_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/notifpref"
+)
+
+func TestParseClock(t *testing.T) {
+	minutes, err := notifpref.ParseClock("07:30")
+	assert.NoError(t, err)
+	assert.Equal(t, 450, minutes)
+
+	_, err = notifpref.ParseClock("25:00")
+	assert.Error(t, err)
+	_, err = notifpref.ParseClock("7pm")
+	assert.Error(t, err)
+}
+
+func TestQuietHoursAcrossMidnight(t *testing.T) {
+	seoul := constant.LoadTimezone("Asia/Seoul")
+	quiet := notifpref.QuietHours{Start: "22:00", End: "07:00", Location: seoul}
+
+	// 23:30 in Seoul is quiet until 07:00 the next day
+	until := quiet.Until(time.Date(2025, 2, 1, 23, 30, 0, 0, seoul))
+	assert.Equal(t, time.Date(2025, 2, 2, 7, 0, 0, 0, seoul), until)
+
+	// 06:59 in Seoul is quiet until 07:00 the same day
+	until = quiet.Until(time.Date(2025, 2, 2, 6, 59, 0, 0, seoul))
+	assert.Equal(t, time.Date(2025, 2, 2, 7, 0, 0, 0, seoul), until)
+
+	// 07:00 is not quiet anymore
+	assert.True(t, quiet.Until(time.Date(2025, 2, 2, 7, 0, 0, 0, seoul)).IsZero())
+
+	// The same instant is read in the timezone of the user: 14:30 UTC is 23:30 in Seoul
+	until = quiet.Until(time.Date(2025, 2, 1, 14, 30, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2025, 2, 2, 7, 0, 0, 0, seoul), until)
+}
+
+func TestQuietHoursWithinDay(t *testing.T) {
+	quiet := notifpref.QuietHours{Start: "12:00", End: "13:00", Location: constant.AsiaJakarta}
+
+	until := quiet.Until(time.Date(2025, 2, 1, 12, 15, 0, 0, constant.AsiaJakarta))
+	assert.Equal(t, time.Date(2025, 2, 1, 13, 0, 0, 0, constant.AsiaJakarta), until)
+	assert.True(t, quiet.Until(time.Date(2025, 2, 1, 13, 15, 0, 0, constant.AsiaJakarta)).IsZero())
+
+	// Empty and invalid windows are never quiet
+	assert.True(t, notifpref.QuietHours{Start: "08:00", End: "08:00"}.Until(time.Now()).IsZero())
+	assert.True(t, notifpref.QuietHours{Start: "", End: "07:00"}.Until(time.Now()).IsZero())
+}
+
+func TestDigestDue(t *testing.T) {
+	jakarta := constant.AsiaJakarta
+	now := time.Date(2025, 2, 2, 9, 0, 0, 0, jakarta)
+
+	assert.Equal(t, time.Date(2025, 2, 2, 8, 0, 0, 0, jakarta), notifpref.LastDigest(now, jakarta, 8))
+	assert.Equal(t, time.Date(2025, 2, 1, 10, 0, 0, 0, jakarta), notifpref.LastDigest(now, jakarta, 10))
+
+	assert.True(t, notifpref.DigestDue(now, jakarta, 8, time.Date(2025, 2, 1, 8, 0, 5, 0, jakarta)))
+	assert.False(t, notifpref.DigestDue(now, jakarta, 8, time.Date(2025, 2, 2, 8, 0, 5, 0, jakarta)))
+	assert.False(t, notifpref.DigestDue(now, jakarta, 10, time.Date(2025, 2, 1, 10, 0, 5, 0, jakarta)))
+}
+
+func TestLoadTimezone(t *testing.T) {
+	assert.Equal(t, constant.AsiaJakarta, constant.LoadTimezone(""))
+	assert.Equal(t, constant.AsiaJakarta, constant.LoadTimezone("Mars/Olympus"))
+	assert.Equal(t, "Asia/Seoul", constant.LoadTimezone("Asia/Seoul").String())
+}
@@ -0,0 +1,91 @@
+package pricing
+
+import (
+	"time"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/currency"
+)
+
+// DepositRule is the share of a stay paid up front and when the rest is due.
+type DepositRule struct {
+	Percent        float64 // 0 = the full amount is due at once
+	BalanceDueDays int     // days before check-in the balance is due
+}
+
+// BalanceInput is what a sub-booking owes and the payments recorded against it directly.
+type BalanceInput struct {
+	ID      uint           // booking detail ID
+	Due     currency.Money // booking total, the penalty once cancelled, zero once rejected
+	Paid    currency.Money
+	CheckIn time.Time
+	Deposit DepositRule
+}
+
+// Balance is the payment position of a sub-booking.
+type Balance struct {
+	ID                 uint
+	Due                currency.Money
+	Paid               currency.Money
+	Outstanding        currency.Money // never negative, overpayments are returned as credit by AllocatePayments
+	Deposit            currency.Money // zero without a deposit rule
+	DepositOutstanding currency.Money
+	BalanceDueDate     time.Time
+	StatusPaymentID    int
+}
+
+// AllocatePayments settles the sub-bookings with their own payments first, then spreads the payments
+// recorded on the whole booking over what is still outstanding, in the order of inputs.
+// credit is the part of the booking payments left after every sub-booking is settled.
+func AllocatePayments(inputs []BalanceInput, bookingPaid currency.Money) (balances []Balance, credit currency.Money) {
+	credit = bookingPaid
+	for _, in := range inputs {
+		paid := in.Paid
+		if outstanding := in.Due.Sub(paid); !outstanding.IsNegative() && !outstanding.IsZero() && !credit.IsZero() {
+			allocated := outstanding
+			if credit.Sub(outstanding).IsNegative() {
+				allocated = credit
+			}
+			paid = paid.Add(allocated)
+			credit = credit.Sub(allocated)
+		}
+		balances = append(balances, NewBalance(in, paid))
+	}
+	return balances, credit
+}
+
+// NewBalance computes the balance of a sub-booking once paid is known.
+func NewBalance(in BalanceInput, paid currency.Money) Balance {
+	b := Balance{
+		ID:              in.ID,
+		Due:             in.Due,
+		Paid:            paid,
+		Outstanding:     nonNegative(in.Due.Sub(paid)),
+		Deposit:         currency.ZeroMoney(in.Due.Currency()),
+		BalanceDueDate:  in.CheckIn.AddDate(0, 0, -in.Deposit.BalanceDueDays),
+		StatusPaymentID: PaymentStatusID(in.Due, paid),
+	}
+	if in.Deposit.Percent > 0 {
+		b.Deposit = in.Due.Percent(in.Deposit.Percent)
+	}
+	b.DepositOutstanding = nonNegative(b.Deposit.Sub(paid))
+	return b
+}
+
+// PaymentStatusID is Paid once the amount due is covered, Partially Paid after any payment, Unpaid otherwise.
+func PaymentStatusID(due, paid currency.Money) int {
+	switch {
+	case paid.IsZero() || paid.IsNegative():
+		return constant.StatusPaymentUnpaidID
+	case due.Sub(paid).IsNegative() || due.Sub(paid).IsZero():
+		return constant.StatusPaymentPaidID
+	default:
+		return constant.StatusPaymentPartiallyPaidID
+	}
+}
+
+func nonNegative(m currency.Money) currency.Money {
+	if m.IsNegative() {
+		return currency.ZeroMoney(m.Currency())
+	}
+	return m
+}
@@ -0,0 +1,68 @@
+package pricing
+
+import (
+	"fmt"
+	"sort"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/currency"
+)
+
+// LegacyCancellationPolicy turns the hotel CancellationPeriod into a policy:
+// free until period days before check-in, non-refundable after.
+func LegacyCancellationPolicy(period int) entity.CancellationPolicy {
+	return entity.CancellationPolicy{
+		Name:  fmt.Sprintf("Free cancellation until %d days before check-in", period),
+		Tiers: []entity.CancellationTier{{DaysBeforeCheckIn: period, PenaltyPercent: 0}},
+	}
+}
+
+// DaysBeforeCheckIn counts the calendar days in Asia/Jakarta from cancelAt to the check-in date,
+// negative once the stay has started.
+func DaysBeforeCheckIn(checkIn, cancelAt time.Time) int {
+	at := cancelAt.In(constant.AsiaJakarta)
+	cancelDate := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+	checkInDate := time.Date(checkIn.Year(), checkIn.Month(), checkIn.Day(), 0, 0, 0, 0, time.UTC)
+	return int(checkInDate.Sub(cancelDate).Hours() / 24)
+}
+
+// PenaltyPercent returns the penalty percentage of cancelling daysBefore days before check-in.
+func PenaltyPercent(policy entity.CancellationPolicy, daysBefore int) float64 {
+	percent := 100.0 // non-refundable after every tier
+	best := -1 << 31
+	for _, tier := range policy.Tiers {
+		if daysBefore >= tier.DaysBeforeCheckIn && tier.DaysBeforeCheckIn > best {
+			best = tier.DaysBeforeCheckIn
+			percent = tier.PenaltyPercent
+		}
+	}
+	return percent
+}
+
+// CancellationPenalty returns the penalty of cancelling at cancelAt a booking worth total, and its percentage.
+func CancellationPenalty(policy entity.CancellationPolicy, total currency.Money, checkIn, cancelAt time.Time) (currency.Money, float64) {
+	percent := PenaltyPercent(policy, DaysBeforeCheckIn(checkIn, cancelAt))
+	return total.Percent(percent), percent
+}
+
+// FreeCancellationUntil returns the last date a booking can be cancelled without penalty,
+// ok is false when the policy has no free cancellation.
+func FreeCancellationUntil(policy entity.CancellationPolicy, checkIn time.Time) (deadline time.Time, ok bool) {
+	tiers := make([]entity.CancellationTier, len(policy.Tiers))
+	copy(tiers, policy.Tiers)
+	sort.Slice(tiers, func(i, j int) bool { return tiers[i].DaysBeforeCheckIn > tiers[j].DaysBeforeCheckIn })
+
+	// Free from the earliest tier down to the first tier with a penalty
+	var lastFree *entity.CancellationTier
+	for i := range tiers {
+		if tiers[i].PenaltyPercent > 0 {
+			break
+		}
+		lastFree = &tiers[i]
+	}
+	if lastFree == nil {
+		return time.Time{}, false
+	}
+	return checkIn.AddDate(0, 0, -lastFree.DaysBeforeCheckIn), true
+}
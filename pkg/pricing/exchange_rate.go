@@ -0,0 +1,43 @@
+package pricing
+
+import (
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/currency"
+)
+
+// DerivePrices adds the price in currencyCode converted from IDR with the exchange rate when it was not entered.
+// The prices are returned unchanged when rate is nil.
+func DerivePrices(prices map[string]float64, currencyCode string, rate *entity.ExchangeRate) map[string]float64 {
+	if rate == nil {
+		return prices
+	}
+	return currency.DerivePrice(prices, currencyCode, rate.Rate, rate.MarkupPercent)
+}
+
+// IsDerived reports whether DerivePrices converts the price in currencyCode from IDR, i.e. it was not entered
+// in currencyCode and the exchange rate is used.
+func IsDerived(prices map[string]float64, currencyCode string, rate *entity.ExchangeRate) bool {
+	if rate == nil || rate.Rate <= 0 {
+		return false
+	}
+	if _, exists := prices[currency.NormalizeCurrencyCode(currencyCode)]; exists {
+		return false
+	}
+	_, exists := prices["IDR"]
+	return exists
+}
+
+// DeriveRoomPrices applies DerivePrices to a room price and its rate plans.
+func DeriveRoomPrices(roomPrice entity.RoomPrice, plans []entity.RoomRatePlan, currencyCode string, rate *entity.ExchangeRate) (entity.RoomPrice, []entity.RoomRatePlan) {
+	if rate == nil {
+		return roomPrice, plans
+	}
+
+	roomPrice.Prices = DerivePrices(roomPrice.Prices, currencyCode, rate)
+	derivedPlans := make([]entity.RoomRatePlan, len(plans))
+	for i, plan := range plans {
+		plan.Prices = DerivePrices(plan.Prices, currencyCode, rate)
+		derivedPlans[i] = plan
+	}
+	return roomPrice, derivedPlans
+}
@@ -0,0 +1,58 @@
+package pricing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/currency"
+	"wtm-backend/pkg/pricing"
+)
+
+func TestAllocatePayments(t *testing.T) {
+	idr := func(amount float64) currency.Money {
+		m, err := currency.NewMoney(amount, "IDR")
+		assert.NoError(t, err)
+		return m
+	}
+	checkIn := time.Date(2026, 8, 10, 0, 0, 0, 0, time.UTC)
+	deposit := pricing.DepositRule{Percent: 30, BalanceDueDays: 14}
+
+	inputs := []pricing.BalanceInput{
+		{ID: 1, Due: idr(1000000), Paid: idr(200000), CheckIn: checkIn, Deposit: deposit},
+		{ID: 2, Due: idr(500000), Paid: idr(0), CheckIn: checkIn, Deposit: deposit},
+		{ID: 3, Due: idr(0), Paid: idr(0), CheckIn: checkIn},
+	}
+
+	t.Run("booking payments settle sub-bookings in order", func(t *testing.T) {
+		balances, credit := pricing.AllocatePayments(inputs, idr(900000))
+		assert.True(t, credit.IsZero())
+
+		assert.Equal(t, "1000000", balances[0].Paid.String())
+		assert.True(t, balances[0].Outstanding.IsZero())
+		assert.Equal(t, constant.StatusPaymentPaidID, balances[0].StatusPaymentID)
+
+		assert.Equal(t, "100000", balances[1].Paid.String())
+		assert.Equal(t, "400000", balances[1].Outstanding.String())
+		assert.Equal(t, "150000", balances[1].Deposit.String())
+		assert.Equal(t, "50000", balances[1].DepositOutstanding.String())
+		assert.Equal(t, constant.StatusPaymentPartiallyPaidID, balances[1].StatusPaymentID)
+		assert.Equal(t, "2026-07-27", balances[1].BalanceDueDate.Format(time.DateOnly))
+
+		assert.Equal(t, constant.StatusPaymentUnpaidID, balances[2].StatusPaymentID)
+	})
+
+	t.Run("overpayment is returned as credit", func(t *testing.T) {
+		balances, credit := pricing.AllocatePayments(inputs, idr(1500000))
+		assert.Equal(t, "200000", credit.String())
+		assert.True(t, balances[1].Outstanding.IsZero())
+	})
+
+	t.Run("no booking payments", func(t *testing.T) {
+		balances, _ := pricing.AllocatePayments(inputs, idr(0))
+		assert.Equal(t, "800000", balances[0].Outstanding.String())
+		assert.Equal(t, "100000", balances[0].DepositOutstanding.String())
+		assert.Equal(t, constant.StatusPaymentUnpaidID, balances[1].StatusPaymentID)
+	})
+}
@@ -0,0 +1,59 @@
+package pricing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/currency"
+	"wtm-backend/pkg/pricing"
+)
+
+func TestCancellationPenalty(t *testing.T) {
+	checkIn := time.Date(2026, 5, 20, 0, 0, 0, 0, time.UTC)
+	total, err := currency.NewMoney(2000000, "IDR")
+	assert.NoError(t, err)
+	policy := entity.CancellationPolicy{
+		Name: "Flexible",
+		Tiers: []entity.CancellationTier{
+			{DaysBeforeCheckIn: 2, PenaltyPercent: 50},
+			{DaysBeforeCheckIn: 7, PenaltyPercent: 0},
+		},
+	}
+	cancelAt := func(daysBefore int) time.Time {
+		return time.Date(2026, 5, 20-daysBefore, 10, 0, 0, 0, constant.AsiaJakarta)
+	}
+
+	t.Run("free before the first tier", func(t *testing.T) {
+		penalty, percent := pricing.CancellationPenalty(policy, total, checkIn, cancelAt(10))
+		assert.True(t, penalty.IsZero())
+		assert.Equal(t, 0.0, percent)
+	})
+
+	t.Run("tier with the most days that applies", func(t *testing.T) {
+		penalty, percent := pricing.CancellationPenalty(policy, total, checkIn, cancelAt(5))
+		assert.Equal(t, "1000000", penalty.String())
+		assert.Equal(t, 50.0, percent)
+	})
+
+	t.Run("non-refundable after every tier", func(t *testing.T) {
+		penalty, percent := pricing.CancellationPenalty(policy, total, checkIn, cancelAt(1))
+		assert.Equal(t, "2000000", penalty.String())
+		assert.Equal(t, 100.0, percent)
+	})
+
+	t.Run("free cancellation deadline", func(t *testing.T) {
+		deadline, ok := pricing.FreeCancellationUntil(policy, checkIn)
+		assert.True(t, ok)
+		assert.Equal(t, "2026-05-13", deadline.Format(time.DateOnly))
+
+		deadline, ok = pricing.FreeCancellationUntil(pricing.LegacyCancellationPolicy(5), checkIn)
+		assert.True(t, ok)
+		assert.Equal(t, "2026-05-15", deadline.Format(time.DateOnly))
+
+		_, ok = pricing.FreeCancellationUntil(entity.CancellationPolicy{Tiers: []entity.CancellationTier{{DaysBeforeCheckIn: 3, PenaltyPercent: 20}}}, checkIn)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,125 @@
+package pricing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/pricing"
+)
+
+func TestCalculateQuote(t *testing.T) {
+	checkIn := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC) // Friday
+	checkOut := checkIn.AddDate(0, 0, 3)
+	roomPrice := entity.RoomPrice{
+		ID:     7,
+		Prices: map[string]float64{"IDR": 1000000},
+		RoomType: entity.RoomType{
+			Name: "Deluxe",
+		},
+	}
+	weekend := entity.RoomRatePlan{
+		ID:          1,
+		RoomPriceID: 7,
+		Name:        "Weekend",
+		StartDate:   checkIn,
+		EndDate:     checkOut,
+		DaysOfWeek:  []int{int(time.Saturday)},
+		Prices:      map[string]float64{"IDR": 1300000},
+		IsActive:    true,
+	}
+	breakfast := 150000.0
+
+	t.Run("nightly rates, additionals and quantity", func(t *testing.T) {
+		quote, err := pricing.CalculateQuote(pricing.QuoteInput{
+			RoomPrice: roomPrice,
+			RatePlans: []entity.RoomRatePlan{weekend},
+			CheckIn:   checkIn,
+			CheckOut:  checkOut,
+			Quantity:  2,
+			Additionals: []pricing.QuoteAdditional{
+				{Name: "Breakfast", Category: constant.AdditionalServiceCategoryPrice, Price: &breakfast},
+			},
+			Currency: "IDR",
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, 3, quote.Nights)
+		assert.Len(t, quote.NightlyRates, 3)
+		assert.Equal(t, "Weekend", quote.NightlyRates[1].RatePlanName)
+		assert.Equal(t, "3300000", quote.RoomTotal.String())
+		assert.Equal(t, "1100000", quote.AverageNightlyRate.String())
+		assert.Equal(t, "3450000", quote.Total.String())
+		assert.Equal(t, "6900000", quote.GrandTotal.String())
+		assert.Len(t, quote.Items, 2)
+		assert.Equal(t, "Deluxe", quote.Items[0].Description)
+	})
+
+	t.Run("discount promo applies to the nightly rates", func(t *testing.T) {
+		quote, err := pricing.CalculateQuote(pricing.QuoteInput{
+			RoomPrice: roomPrice,
+			RatePlans: []entity.RoomRatePlan{weekend},
+			CheckIn:   checkIn,
+			CheckOut:  checkOut,
+			Promo: &entity.Promo{
+				PromoTypeID: constant.PromoTypeDiscountID,
+				Detail:      entity.PromoDetail{DiscountPercentage: 10},
+			},
+			Currency: "IDR",
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, "3300000", quote.RoomTotalBeforePromo.String())
+		assert.Equal(t, "2970000", quote.RoomTotal.String())
+	})
+
+	t.Run("fixed price promo longer than the stay", func(t *testing.T) {
+		quote, err := pricing.CalculateQuote(pricing.QuoteInput{
+			RoomPrice: roomPrice,
+			CheckIn:   checkIn,
+			CheckOut:  checkOut,
+			Promo: &entity.Promo{
+				PromoTypeID: constant.PromoTypeFixedPriceID,
+				Duration:    4,
+				Detail:      entity.PromoDetail{Prices: map[string]float64{"IDR": 3500000}},
+			},
+			Currency: "IDR",
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, "2500000", quote.RoomTotal.String())
+	})
+
+	t.Run("derives missing currency from the exchange rate", func(t *testing.T) {
+		quote, err := pricing.CalculateQuote(pricing.QuoteInput{
+			RoomPrice:    roomPrice,
+			CheckIn:      checkIn,
+			CheckOut:     checkIn.AddDate(0, 0, 1),
+			Currency:     "usd",
+			ExchangeRate: &entity.ExchangeRate{CurrencyCode: "USD", Rate: 16000},
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, "USD", quote.Currency)
+		assert.Equal(t, "62.50", quote.RoomTotal.String())
+		assert.NotNil(t, quote.ExchangeRate)
+	})
+
+	t.Run("keeps no exchange rate when every price is entered in the currency", func(t *testing.T) {
+		usdRoomPrice := roomPrice
+		usdRoomPrice.Prices = map[string]float64{"IDR": 1000000, "USD": 60}
+		quote, err := pricing.CalculateQuote(pricing.QuoteInput{
+			RoomPrice:    usdRoomPrice,
+			CheckIn:      checkIn,
+			CheckOut:     checkIn.AddDate(0, 0, 1),
+			Currency:     "USD",
+			ExchangeRate: &entity.ExchangeRate{CurrencyCode: "USD", Rate: 16000},
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, "60.00", quote.RoomTotal.String())
+		assert.Nil(t, quote.ExchangeRate)
+	})
+}
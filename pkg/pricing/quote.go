@@ -0,0 +1,231 @@
+package pricing
+
+import (
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/constant"
+	"wtm-backend/pkg/currency"
+)
+
+// QuoteAdditional is an additional service of a quote, Price is already in the quote currency.
+type QuoteAdditional struct {
+	Name       string
+	Category   string   // "price" or "pax"
+	Price      *float64 // used when category="price"
+	Pax        *int     // used when category="pax"
+	IsRequired bool
+	Derived    bool // Price was converted from IDR with the exchange rate
+}
+
+// QuoteInput is everything needed to price one room of a stay.
+// RatePlans and Promo prices are derived from IDR with ExchangeRate when it is set.
+type QuoteInput struct {
+	RoomPrice    entity.RoomPrice // ID must be set to match the rate plans
+	RatePlans    []entity.RoomRatePlan
+	CheckIn      time.Time
+	CheckOut     time.Time
+	Quantity     int
+	Promo        *entity.Promo
+	Additionals  []QuoteAdditional
+	Currency     string
+	ExchangeRate *entity.ExchangeRate
+}
+
+// Quote is the price breakdown of a stay. Amounts are per room unless stated otherwise.
+type Quote struct {
+	Currency string
+	Nights   int
+	Quantity int
+
+	NightlyRates         []entity.NightlyRate
+	AverageNightlyRate   currency.Money // base of the promo adjustments
+	RoomTotalBeforePromo currency.Money
+	RoomTotal            currency.Money
+	AdditionalTotal      currency.Money
+	Total                currency.Money // RoomTotal + AdditionalTotal
+	GrandTotal           currency.Money // Total for every room of Quantity
+
+	// ExchangeRate is the rate a price of the quote was converted with, nil when every price was entered in Currency
+	ExchangeRate *entity.ExchangeRate
+
+	// Items are the invoice lines of one room, the room line first
+	Items []entity.DescriptionInvoice
+}
+
+// CalculateQuote prices a stay: nightly rates with seasonal plans, promo and additional services.
+// Cart, checkout, hotel emails and the quote endpoint all price through it so the numbers match.
+func CalculateQuote(in QuoteInput) (Quote, error) {
+	code := currency.NormalizeCurrencyCode(in.Currency)
+	if code == "" {
+		code = "IDR"
+	}
+	quantity := in.Quantity
+	if quantity <= 0 {
+		quantity = 1
+	}
+	nights := int(in.CheckOut.Sub(in.CheckIn).Hours() / 24)
+
+	roomPrice, plans := DeriveRoomPrices(in.RoomPrice, in.RatePlans, code, in.ExchangeRate)
+	rates, err := NightlyRates(roomPrice, plans, code, in.CheckIn, in.CheckOut)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	q := Quote{
+		Currency:             code,
+		Nights:               nights,
+		Quantity:             quantity,
+		NightlyRates:         rates,
+		RoomTotalBeforePromo: Total(rates).WithCurrency(code),
+	}
+	if nights > 0 {
+		// Average nightly rate, so promos apply to the effective rate
+		q.AverageNightlyRate = q.RoomTotalBeforePromo.Div(nights)
+	} else if q.AverageNightlyRate, err = currency.NewMoney(BasePrice(roomPrice, code), code); err != nil {
+		return Quote{}, err
+	}
+	if q.RoomTotal, err = q.applyPromo(in.Promo, in.ExchangeRate); err != nil {
+		return Quote{}, err
+	}
+
+	totalBeforePromo := q.RoomTotalBeforePromo
+	q.Items = append(q.Items, entity.DescriptionInvoice{
+		Description:      in.RoomPrice.RoomType.Name,
+		Quantity:         nights,
+		Unit:             constant.UnitNight,
+		Price:            q.AverageNightlyRate,
+		TotalBeforePromo: &totalBeforePromo,
+		Total:            q.RoomTotal,
+		NightlyRates:     rates,
+	})
+
+	q.AdditionalTotal = currency.ZeroMoney(code)
+	for _, additional := range in.Additionals {
+		item := entity.DescriptionInvoice{
+			Description: additional.Name,
+			Category:    additional.Category,
+			IsRequired:  additional.IsRequired,
+		}
+		if additional.Category == constant.AdditionalServiceCategoryPrice && additional.Price != nil {
+			price, err := currency.NewMoney(*additional.Price, code)
+			if err != nil {
+				return Quote{}, err
+			}
+			item.Quantity = 1
+			item.Unit = constant.UnitPax
+			item.Price = price
+			item.Total = price.Mul(item.Quantity)
+			q.AdditionalTotal = q.AdditionalTotal.Add(item.Total)
+		} else if additional.Category == constant.AdditionalServiceCategoryPax && additional.Pax != nil {
+			// Pax-based additionals are informational only, no charge
+			item.Quantity = *additional.Pax
+			item.Unit = constant.UnitPax
+			item.Price = currency.ZeroMoney(code)
+			item.Total = currency.ZeroMoney(code)
+			item.Pax = additional.Pax
+		}
+		q.Items = append(q.Items, item)
+	}
+
+	q.Total = q.RoomTotal.Add(q.AdditionalTotal)
+	q.GrandTotal = q.Total.Mul(quantity)
+	if usesExchangeRate(in, code, rates) {
+		q.ExchangeRate = in.ExchangeRate
+	}
+	return q, nil
+}
+
+// usesExchangeRate reports whether a price the quote was calculated from had to be converted from IDR:
+// the nightly base price or rate plan of a night, the fixed price of the promo or an additional.
+func usesExchangeRate(in QuoteInput, code string, rates []entity.NightlyRate) bool {
+	if in.ExchangeRate == nil {
+		return false
+	}
+	for _, rate := range rates {
+		prices := in.RoomPrice.Prices
+		for _, plan := range in.RatePlans {
+			if rate.RatePlanID != 0 && plan.ID == rate.RatePlanID {
+				prices = plan.Prices
+				break
+			}
+		}
+		if IsDerived(prices, code, in.ExchangeRate) {
+			return true
+		}
+	}
+	if in.Promo != nil && in.Promo.PromoTypeID == constant.PromoTypeFixedPriceID && IsDerived(in.Promo.Detail.Prices, code, in.ExchangeRate) {
+		return true
+	}
+	for _, additional := range in.Additionals {
+		if additional.Derived {
+			return true
+		}
+	}
+	return false
+}
+
+// applyPromo returns the room total after the promo. A promo lasting longer than the stay
+// is reduced by the nights not stayed.
+func (q Quote) applyPromo(promo *entity.Promo, rate *entity.ExchangeRate) (currency.Money, error) {
+	if promo == nil {
+		return q.RoomTotalBeforePromo, nil
+	}
+
+	var roomTotal currency.Money
+	switch promo.PromoTypeID {
+	case constant.PromoTypeFixedPriceID:
+		fixedPrice, ok, err := PromoFixedPrice(promo, q.Currency, rate)
+		if err != nil {
+			return currency.Money{}, err
+		}
+		if !ok {
+			// Promo without a price keeps the average nightly rate, as checkout always did
+			fixedPrice = q.AverageNightlyRate
+		}
+		roomTotal = fixedPrice
+	case constant.PromoTypeDiscountID:
+		roomTotal = q.RoomTotalBeforePromo.Discount(promo.Detail.DiscountPercentage)
+	default:
+		return q.RoomTotalBeforePromo, nil
+	}
+	if promo.Duration > q.Nights {
+		roomTotal = roomTotal.Add(q.AverageNightlyRate.Mul(q.Nights - promo.Duration))
+	}
+	return roomTotal, nil
+}
+
+// PromoFixedPrice returns the fixed price of a promo in the currency, from the Prices map
+// (derived with the exchange rate when missing) with fallback to the deprecated FixedPrice.
+// ok is false when the promo has no price for the currency.
+func PromoFixedPrice(promo *entity.Promo, currencyCode string, rate *entity.ExchangeRate) (price currency.Money, ok bool, err error) {
+	if len(promo.Detail.Prices) > 0 {
+		if fixedPrice, _, err := currency.GetPriceForCurrency(DerivePrices(promo.Detail.Prices, currencyCode, rate), currencyCode); err == nil {
+			price, err := currency.NewMoney(fixedPrice, currencyCode)
+			return price, err == nil, err
+		}
+	}
+	// Backward compatibility: use FixedPrice if Prices not set
+	if promo.Detail.FixedPrice > 0 {
+		price, err := currency.NewMoney(promo.Detail.FixedPrice, currencyCode)
+		return price, err == nil, err
+	}
+	return currency.ZeroMoney(currencyCode), false, nil
+}
+
+// AdditionalPrice returns the price of a price-based additional service in the currency,
+// from the Prices map (derived with the exchange rate when missing) with fallback to the deprecated Price.
+// It returns nil for pax-based additionals and additionals without a price.
+func AdditionalPrice(additional entity.RoomTypeAdditional, currencyCode string, rate *entity.ExchangeRate) *float64 {
+	if additional.Category != constant.AdditionalServiceCategoryPrice {
+		return nil
+	}
+	if len(additional.Prices) > 0 {
+		if price, _, _ := currency.GetPriceForCurrency(DerivePrices(additional.Prices, currencyCode, rate), currencyCode); price > 0 {
+			return &price
+		}
+	}
+	if additional.Price != nil && *additional.Price > 0 {
+		return additional.Price
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package pricing
+
+import (
+	"slices"
+	"time"
+	"wtm-backend/internal/domain/entity"
+	"wtm-backend/pkg/currency"
+)
+
+// BasePrice returns the nightly base price of a room price in the given currency,
+// falling back to the deprecated Price field when the currency is not in Prices.
+func BasePrice(roomPrice entity.RoomPrice, currencyCode string) float64 {
+	if price, ok := roomPrice.Prices[currency.NormalizeCurrencyCode(currencyCode)]; ok {
+		return price
+	}
+	return roomPrice.Price
+}
+
+// NightlyRates prices every night in [checkIn, checkOut) of a room price.
+// Plans of other room prices are ignored, so plans of a whole hotel can be passed at once.
+func NightlyRates(roomPrice entity.RoomPrice, plans []entity.RoomRatePlan, currencyCode string, checkIn, checkOut time.Time) ([]entity.NightlyRate, error) {
+	normalizedCurrency := currency.NormalizeCurrencyCode(currencyCode)
+	basePrice, err := currency.NewMoney(BasePrice(roomPrice, normalizedCurrency), normalizedCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	var rates []entity.NightlyRate
+	for night := checkIn; night.Before(checkOut); night = night.AddDate(0, 0, 1) {
+		rate := entity.NightlyRate{
+			Date:  night.Format(time.DateOnly),
+			Price: basePrice,
+		}
+		if plan := RatePlanFor(plans, roomPrice.ID, night); plan != nil {
+			// A plan without the currency keeps the base price for that currency
+			if price, ok := plan.Prices[normalizedCurrency]; ok {
+				if rate.Price, err = currency.NewMoney(price, normalizedCurrency); err != nil {
+					return nil, err
+				}
+				rate.RatePlanID = plan.ID
+				rate.RatePlanName = plan.Name
+			}
+		}
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
+
+// RatePlanFor returns the active plan of the room price that applies on the night, nil if none.
+// Higher priority wins, then a day-of-week plan over an every-day plan, then the newest plan.
+func RatePlanFor(plans []entity.RoomRatePlan, roomPriceID uint, night time.Time) *entity.RoomRatePlan {
+	date := night.Format(time.DateOnly)
+
+	var best *entity.RoomRatePlan
+	for i := range plans {
+		plan := &plans[i]
+		if plan.RoomPriceID != roomPriceID || !plan.IsActive {
+			continue
+		}
+		if date < plan.StartDate.Format(time.DateOnly) || date > plan.EndDate.Format(time.DateOnly) {
+			continue
+		}
+		if len(plan.DaysOfWeek) > 0 && !slices.Contains(plan.DaysOfWeek, int(night.Weekday())) {
+			continue
+		}
+		if best == nil || outranks(plan, best) {
+			best = plan
+		}
+	}
+
+	return best
+}
+
+// Total sums the nightly rates.
+func Total(rates []entity.NightlyRate) currency.Money {
+	var total currency.Money
+	for _, rate := range rates {
+		total = total.Add(rate.Price)
+	}
+	return total
+}
+
+func outranks(a, b *entity.RoomRatePlan) bool {
+	if a.Priority != b.Priority {
+		return a.Priority > b.Priority
+	}
+	if (len(a.DaysOfWeek) > 0) != (len(b.DaysOfWeek) > 0) {
+		return len(a.DaysOfWeek) > 0
+	}
+	return a.ID > b.ID
+}
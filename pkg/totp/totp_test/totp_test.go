@@ -0,0 +1,103 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"wtm-backend/pkg/totp"
+)
+
+// Secret of the SHA1 test vectors of RFC 6238
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCodeMatchesRFCVectors(t *testing.T) {
+	// RFC 6238 lists 8 digit codes, the last 6 digits are the 6 digit codes
+	tests := []struct {
+		unix     int64
+		expected string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tt := range tests {
+		code, err := totp.Code(rfcSecret, totp.Step(time.Unix(tt.unix, 0)))
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, code, "time %d", tt.unix)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	code, _ := totp.Code(rfcSecret, totp.Step(now))
+
+	step, ok := totp.Verify(rfcSecret, code, now)
+	assert.True(t, ok)
+	assert.Equal(t, totp.Step(now), step)
+
+	t.Run("accepts the previous period for clock drift", func(t *testing.T) {
+		step, ok := totp.Verify(rfcSecret, code, now.Add(totp.Period))
+		assert.True(t, ok)
+		assert.Equal(t, totp.Step(now), step)
+	})
+
+	t.Run("refuses codes older than the skew", func(t *testing.T) {
+		_, ok := totp.Verify(rfcSecret, code, now.Add(3*totp.Period))
+		assert.False(t, ok)
+	})
+
+	t.Run("ignores spaces", func(t *testing.T) {
+		_, ok := totp.Verify(rfcSecret, code[:3]+" "+code[3:], now)
+		assert.True(t, ok)
+	})
+
+	t.Run("refuses malformed codes", func(t *testing.T) {
+		_, ok := totp.Verify(rfcSecret, "12345", now)
+		assert.False(t, ok)
+		_, ok = totp.Verify("not base32!", "123456", now)
+		assert.False(t, ok)
+	})
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	assert.NoError(t, err)
+	assert.Len(t, secret, 32)
+
+	other, _ := totp.GenerateSecret()
+	assert.NotEqual(t, secret, other)
+
+	_, err = totp.Code(secret, 1)
+	assert.NoError(t, err)
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := totp.ProvisioningURI("The HotelBox", "admin@wtm.com", "JBSWY3DPEHPK3PXP")
+
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/The%20HotelBox:admin@wtm.com?"))
+	assert.Contains(t, uri, "secret=JBSWY3DPEHPK3PXP")
+	assert.Contains(t, uri, "issuer=The+HotelBox")
+	assert.Contains(t, uri, "digits=6")
+	assert.Contains(t, uri, "period=30")
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := totp.GenerateRecoveryCodes(10)
+	assert.NoError(t, err)
+	assert.Len(t, codes, 10)
+
+	seen := map[string]bool{}
+	for _, code := range codes {
+		assert.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, code)
+		seen[code] = true
+	}
+	assert.Len(t, seen, 10)
+
+	assert.Equal(t, "abcde12345", totp.NormalizeRecoveryCode(" ABCDE-12345 "))
+}
@@ -0,0 +1,15 @@
+package utils
+
+import "time"
+
+// Backoff returns the delay before retry number attempt (1 = first retry): base doubled on every attempt, capped at limit
+func Backoff(attempt int, base, limit time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempt; i++ {
+		if delay >= limit/2 {
+			return limit
+		}
+		delay *= 2
+	}
+	return min(delay, limit)
+}
@@ -7,11 +7,23 @@ import (
 	"time"
 )
 
+var emailFuncMap = template.FuncMap{
+	"add1": func(i int) int { return i + 1 },
+}
+
 func ParseTemplate(tmpl string, data interface{}) (string, error) {
-	funcMap := template.FuncMap{
-		"add1": func(i int) int { return i + 1 },
+	t, err := template.New("email").Funcs(emailFuncMap).Parse(tmpl)
+	if err != nil {
+		return "", err
 	}
-	t, err := template.New("email").Funcs(funcMap).Parse(tmpl)
+	var buf bytes.Buffer
+	err = t.Execute(&buf, data)
+	return buf.String(), err
+}
+
+// ParseTemplateStrict renders tmpl like ParseTemplate but fails on placeholders missing from map data
+func ParseTemplateStrict(tmpl string, data interface{}) (string, error) {
+	t, err := template.New("email").Funcs(emailFuncMap).Option("missingkey=error").Parse(tmpl)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,53 @@
+package utils
+
+import "strings"
+
+// DeviceFromUserAgent describes the browser and operating system of a User-Agent header, e.g. "Chrome on Windows".
+// Unknown parts are left out, an unrecognised header gives "Unknown device".
+func DeviceFromUserAgent(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+
+	var browser string
+	switch {
+	case strings.Contains(ua, "edg/"):
+		browser = "Edge"
+	case strings.Contains(ua, "opr/") || strings.Contains(ua, "opera"):
+		browser = "Opera"
+	case strings.Contains(ua, "samsungbrowser"):
+		browser = "Samsung Internet"
+	case strings.Contains(ua, "firefox/") || strings.Contains(ua, "fxios/"):
+		browser = "Firefox"
+	case strings.Contains(ua, "chrome/") || strings.Contains(ua, "crios/"):
+		browser = "Chrome"
+	case strings.Contains(ua, "safari/"):
+		browser = "Safari"
+	case strings.Contains(ua, "postman"):
+		browser = "Postman"
+	case strings.Contains(ua, "curl/"):
+		browser = "curl"
+	}
+
+	var os string
+	switch {
+	case strings.Contains(ua, "android"):
+		os = "Android"
+	case strings.Contains(ua, "iphone") || strings.Contains(ua, "ipad"):
+		os = "iOS"
+	case strings.Contains(ua, "windows"):
+		os = "Windows"
+	case strings.Contains(ua, "mac os x") || strings.Contains(ua, "macintosh"):
+		os = "macOS"
+	case strings.Contains(ua, "linux"):
+		os = "Linux"
+	}
+
+	switch {
+	case browser != "" && os != "":
+		return browser + " on " + os
+	case browser != "":
+		return browser
+	case os != "":
+		return os
+	}
+	return "Unknown device"
+}
@@ -0,0 +1,21 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"wtm-backend/pkg/utils"
+)
+
+func TestBackoff(t *testing.T) {
+	base, max := 30*time.Second, time.Hour
+
+	assert.Equal(t, 30*time.Second, utils.Backoff(0, base, max))
+	assert.Equal(t, 30*time.Second, utils.Backoff(1, base, max))
+	assert.Equal(t, time.Minute, utils.Backoff(2, base, max))
+	assert.Equal(t, 4*time.Minute, utils.Backoff(4, base, max))
+	assert.Equal(t, 32*time.Minute, utils.Backoff(7, base, max))
+	assert.Equal(t, time.Hour, utils.Backoff(8, base, max))
+	assert.Equal(t, time.Hour, utils.Backoff(1000, base, max))
+}
@@ -0,0 +1,33 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"wtm-backend/pkg/utils"
+)
+
+func TestEncryptDecryptString(t *testing.T) {
+	encrypted, err := utils.EncryptString("JBSWY3DPEHPK3PXP", "secret-key")
+	assert.NoError(t, err)
+	assert.NotContains(t, encrypted, "JBSWY3DPEHPK3PXP")
+
+	decrypted, err := utils.DecryptString(encrypted, "secret-key")
+	assert.NoError(t, err)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", decrypted)
+
+	t.Run("random nonce per encryption", func(t *testing.T) {
+		other, _ := utils.EncryptString("JBSWY3DPEHPK3PXP", "secret-key")
+		assert.NotEqual(t, encrypted, other)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		_, err := utils.DecryptString(encrypted, "other-key")
+		assert.Error(t, err)
+	})
+
+	t.Run("malformed value", func(t *testing.T) {
+		_, err := utils.DecryptString("abc", "secret-key")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,40 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"wtm-backend/pkg/utils"
+)
+
+func TestParseTemplateStrict(t *testing.T) {
+	data := map[string]interface{}{
+		"GuestName": "John Doe",
+		"Guests": []map[string]interface{}{
+			{"Name": "John Doe"},
+			{"Name": "Jane Doe"},
+		},
+	}
+
+	t.Run("known placeholders", func(t *testing.T) {
+		out, err := utils.ParseTemplateStrict(`Dear {{.GuestName}}{{range $i, $g := .Guests}}, {{add1 $i}}. {{$g.Name}}{{end}}`, data)
+		assert.NoError(t, err)
+		assert.Equal(t, "Dear John Doe, 1. John Doe, 2. Jane Doe", out)
+	})
+
+	t.Run("unknown placeholder", func(t *testing.T) {
+		_, err := utils.ParseTemplateStrict(`Dear {{.GuestNme}}`, data)
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown placeholder in range", func(t *testing.T) {
+		_, err := utils.ParseTemplateStrict(`{{range .Guests}}{{.Age}}{{end}}`, data)
+		assert.Error(t, err)
+	})
+
+	t.Run("lenient parse ignores unknown placeholder", func(t *testing.T) {
+		out, err := utils.ParseTemplate(`Dear {{.GuestNme}}`, data)
+		assert.NoError(t, err)
+		assert.Equal(t, "Dear <no value>", out)
+	})
+}
@@ -30,6 +30,19 @@ func TestNotEmptyAfterTrim(t *testing.T) {
 	})
 }
 
+func TestIsLocale(t *testing.T) {
+	rule := utils.IsLocale()
+
+	assert.NoError(t, rule.Validate(""))
+	assert.NoError(t, rule.Validate("en"))
+	assert.NoError(t, rule.Validate("id"))
+	assert.NoError(t, rule.Validate("ko"))
+
+	err := rule.Validate("fr")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "language must be one of")
+}
+
 func TestParseValidationErrors(t *testing.T) {
 	t.Run("should return error map when validation.Errors is present", func(t *testing.T) {
 		err := validation.Errors{
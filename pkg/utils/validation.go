@@ -6,6 +6,7 @@ import (
 	validation "github.com/go-ozzo/ozzo-validation"
 	"strings"
 	"unicode"
+	"wtm-backend/pkg/constant"
 )
 
 func NotEmptyAfterTrim(fieldName string) validation.Rule {
@@ -18,6 +19,22 @@ func NotEmptyAfterTrim(fieldName string) validation.Rule {
 	})
 }
 
+// IsLocale accepts an empty value or one of constant.Locales
+func IsLocale() validation.Rule {
+	return validation.By(func(value interface{}) error {
+		s, _ := value.(string)
+		if s == "" {
+			return nil
+		}
+		for _, locale := range constant.Locales {
+			if s == locale {
+				return nil
+			}
+		}
+		return fmt.Errorf("language must be one of: %s", strings.Join(constant.Locales, ", "))
+	})
+}
+
 func ParseValidationErrors(err error) map[string]string {
 	var errs validation.Errors
 	if errors.As(err, &errs) {